@@ -9,7 +9,11 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
 )
@@ -21,6 +25,50 @@ const (
 	userAgent     = "User-Agent"
 )
 
+// defaultMaxResponseSize caps how much of a response body is read into
+// memory; it can be raised or lowered with BB_MAX_RESPONSE_SIZE (in bytes).
+const defaultMaxResponseSize int64 = 100 * 1024 * 1024
+
+func maxResponseSize() int64 {
+	if v := os.Getenv("BB_MAX_RESPONSE_SIZE"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultMaxResponseSize
+}
+
+// guardedBody limits reads of a response body to the configured maximum,
+// surfacing a descriptive error instead of silently truncating.
+func guardedBody(resp *http.Response) io.Reader {
+	limit := maxResponseSize()
+	return &limitedReader{r: resp.Body, remaining: limit, limit: limit, url: resp.Request.URL}
+}
+
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+	url       *url.URL
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for a trailing EOF so a body of exactly limit bytes still reads cleanly.
+		var probe [1]byte
+		if n, err := l.r.Read(probe[:]); n == 0 && err == io.EOF {
+			return 0, io.EOF
+		}
+		return 0, fmt.Errorf("response from %s exceeds the %d byte limit (set BB_MAX_RESPONSE_SIZE to raise it)", l.url, l.limit)
+	}
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
 // Client is a Bitbucket API client.
 type Client struct {
 	http *http.Client
@@ -88,7 +136,7 @@ func (c *Client) RESTWithURL(method string, url string, body io.Reader, data int
 		return nil
 	}
 
-	return json.NewDecoder(resp.Body).Decode(data)
+	return json.NewDecoder(guardedBody(resp)).Decode(data)
 }
 
 // RESTWithNext performs a REST request and returns the next page URL from the response.
@@ -125,26 +173,53 @@ func (c *Client) RESTWithNextURL(method string, url string, body io.Reader, data
 		return "", nil
 	}
 
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	if data != nil {
-		if err := json.Unmarshal(responseBody, data); err != nil {
+	// Decode straight off the wire rather than buffering the whole body,
+	// which matters for the multi-megabyte pages some list and log
+	// endpoints can return.
+	if data == nil {
+		var paginationInfo struct {
+			Next string `json:"next"`
+		}
+		if err := json.NewDecoder(guardedBody(resp)).Decode(&paginationInfo); err != nil {
 			return "", err
 		}
+		return paginationInfo.Next, nil
 	}
 
-	// Extract "next" URL from paginated response
-	var paginationInfo struct {
-		Next string `json:"next"`
+	if err := json.NewDecoder(guardedBody(resp)).Decode(data); err != nil {
+		return "", err
+	}
+
+	return nextPageURL(data), nil
+}
+
+// nextPageURL extracts the "next" field of a decoded paginated response.
+// The repository's list types all carry one; anything without it simply has
+// no further pages.
+func nextPageURL(data interface{}) string {
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
 	}
-	if err := json.Unmarshal(responseBody, &paginationInfo); err != nil {
-		return "", nil // Ignore pagination parsing errors
+	if v.Kind() != reflect.Struct {
+		return ""
 	}
 
-	return paginationInfo.Next, nil
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tag != "next" && !(tag == "" && field.Name == "Next") {
+			continue
+		}
+		if value := v.Field(i); value.Kind() == reflect.String {
+			return value.String()
+		}
+	}
+	return ""
 }
 
 // RESTWithContext performs a REST request with a context.
@@ -180,7 +255,7 @@ func (c *Client) RESTWithContext(ctx context.Context, hostname string, method st
 		return nil
 	}
 
-	return json.NewDecoder(resp.Body).Decode(data)
+	return json.NewDecoder(guardedBody(resp)).Decode(data)
 }
 
 // Delete performs a DELETE request.
@@ -255,12 +330,28 @@ func HandleHTTPError(resp *http.Response) error {
 
 	return HTTPError{
 		StatusCode: resp.StatusCode,
-		Message:    message,
+		Message:    legacyAPIMessage(resp.StatusCode, message),
 		RequestURL: resp.Request.URL,
 		Body:       string(body),
 	}
 }
 
+// legacyAPIMessage rewrites error messages for resources that Bitbucket
+// removed for good, most of which trace back to the 2020 Mercurial sunset.
+// The API reports these as a bare 410 Gone or a deletion notice, which reads
+// like a transient failure without the history.
+func legacyAPIMessage(statusCode int, message string) string {
+	if statusCode == http.StatusGone {
+		return "this resource has been permanently removed; Bitbucket deleted all Mercurial repositories in 2020, so if this repository was one of them it is gone or lives on as a Git migration under a different name"
+	}
+	lower := strings.ToLower(message)
+	if strings.Contains(lower, "mercurial") ||
+		(strings.Contains(lower, "repository") && (strings.Contains(lower, "deleted") || strings.Contains(lower, "migrated"))) {
+		return message + " (Bitbucket removed Mercurial support in 2020; the repository may have been deleted then or migrated to Git under a different name)"
+	}
+	return message
+}
+
 // IsNotFoundError checks if an error is a 404 Not Found error.
 func IsNotFoundError(err error) bool {
 	var httpErr HTTPError
@@ -305,6 +396,113 @@ func CurrentUser(client *Client, hostname string) (*User, error) {
 	return &user, nil
 }
 
+// currentUserCache caches the authenticated user per hostname so repeated
+// self-reference resolutions within one invocation hit /user at most once.
+var currentUserCache sync.Map
+
+// CurrentUserCached returns the authenticated user, fetching it from /user on
+// the first call for a hostname and serving it from memory afterwards.
+func CurrentUserCached(client *Client, hostname string) (*User, error) {
+	if cached, ok := currentUserCache.Load(hostname); ok {
+		return cached.(*User), nil
+	}
+	user, err := CurrentUser(client, hostname)
+	if err != nil {
+		return nil, err
+	}
+	currentUserCache.Store(hostname, user)
+	return user, nil
+}
+
+// IsSelfReference reports whether value is one of the tokens that stand in
+// for the authenticated user wherever a user filter is accepted.
+func IsSelfReference(value string) bool {
+	return value == "@me" || value == "me"
+}
+
+// ResolveUserNickname expands a self-reference token to the authenticated
+// user's nickname, suitable for nickname-based filter queries. Any other
+// value is returned unchanged.
+func ResolveUserNickname(client *Client, hostname, value string) (string, error) {
+	if !IsSelfReference(value) {
+		return value, nil
+	}
+	user, err := CurrentUserCached(client, hostname)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", value, err)
+	}
+	return user.Nickname, nil
+}
+
+// ResolveEnvironment resolves a deployment environment's display name to its
+// UUID (braces included), ready to interpolate into deployments_config paths.
+// Names are matched case-insensitively, so "Staging" finds an environment
+// named "staging"; a value that already looks like a UUID is returned as is.
+// Bitbucket API: GET /2.0/repositories/{workspace}/{repo_slug}/environments/
+func ResolveEnvironment(client *Client, hostname, workspace, repoSlug, name string) (string, error) {
+	if strings.HasPrefix(name, "{") && strings.HasSuffix(name, "}") {
+		return name, nil
+	}
+
+	type environment struct {
+		UUID string `json:"uuid"`
+		Name string `json:"name"`
+	}
+
+	var envs []environment
+	path := fmt.Sprintf("repositories/%s/%s/environments?pagelen=100", workspace, repoSlug)
+	nextURL := ""
+	for {
+		var page struct {
+			Values []environment `json:"values"`
+			Next   string        `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = client.Get(hostname, path, &page)
+		} else {
+			err = client.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to list deployment environments: %w", err)
+		}
+		envs = append(envs, page.Values...)
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	for _, env := range envs {
+		if strings.EqualFold(env.Name, name) {
+			return "{" + strings.Trim(env.UUID, "{}") + "}", nil
+		}
+	}
+
+	// No match: suggest environments whose names are close before falling
+	// back to listing everything that exists
+	var candidates []string
+	var names []string
+	for _, env := range envs {
+		names = append(names, env.Name)
+		lower := strings.ToLower(env.Name)
+		target := strings.ToLower(name)
+		if strings.Contains(lower, target) || strings.Contains(target, lower) {
+			candidates = append(candidates, env.Name)
+		}
+	}
+	if len(candidates) == 1 {
+		return "", fmt.Errorf("no deployment environment named %q; did you mean %q?", name, candidates[0])
+	}
+	if len(candidates) > 1 {
+		return "", fmt.Errorf("no deployment environment named %q; did you mean one of %s?", name, strings.Join(candidates, ", "))
+	}
+	if len(names) > 0 {
+		return "", fmt.Errorf("no deployment environment named %q; available environments: %s", name, strings.Join(names, ", "))
+	}
+	return "", fmt.Errorf("no deployment environment named %q; this repository has no deployment environments", name)
+}
+
 // RESTPrefix returns the REST API base URL for a hostname.
 // This is exported for use by other packages.
 func RESTPrefix(hostname string) string {
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// maxDedupBodySize caps how large a response body the deduplicating
+// transport will hold in memory. Larger bodies pass through uncached.
+const maxDedupBodySize = 1 << 20
+
+// dedupTripper serves repeated identical GET requests from an in-memory
+// cache scoped to one client. The factory memoizes the client, so the cache
+// lives for the whole command invocation; commands that poll in a loop flush
+// it between iterations with FlushDedupCache. Several helpers independently
+// look up the current user, repository info, or the default branch during a
+// single run (pr create and clone are the worst offenders); only the first
+// of those lookups hits the wire.
+type dedupTripper struct {
+	base http.RoundTripper
+
+	mu    sync.Mutex
+	cache map[string]*dedupEntry
+}
+
+type dedupEntry struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+func newDedupTripper(base http.RoundTripper) *dedupTripper {
+	return &dedupTripper{
+		base:  base,
+		cache: map[string]*dedupEntry{},
+	}
+}
+
+// FlushDedupCache drops the client's memoized GET responses so later requests
+// observe fresh server state. Long-running commands that refetch the same
+// URLs in a loop (such as bb status --watch) call this between iterations;
+// one-shot commands never need to.
+func FlushDedupCache(client *http.Client) {
+	rt := client.Transport
+	for rt != nil {
+		switch t := rt.(type) {
+		case *profilingTripper:
+			rt = t.base
+		case *dedupTripper:
+			t.mu.Lock()
+			t.cache = map[string]*dedupEntry{}
+			t.mu.Unlock()
+			return
+		default:
+			return
+		}
+	}
+}
+
+func (t *dedupTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// A mutation may invalidate anything read so far; drop the cache so
+		// a later GET observes the write.
+		t.mu.Lock()
+		t.cache = map[string]*dedupEntry{}
+		t.mu.Unlock()
+		return t.base.RoundTrip(req)
+	}
+
+	key := req.URL.String() + "\x00" + req.Header.Get(accept)
+
+	t.mu.Lock()
+	entry, ok := t.cache[key]
+	t.mu.Unlock()
+	if ok {
+		return entry.response(req), nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	// Only successful, reasonably small responses are worth replaying.
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	buffered, err := io.ReadAll(io.LimitReader(resp.Body, maxDedupBodySize+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(buffered) > maxDedupBodySize {
+		// Hand the buffered prefix back together with the unread remainder.
+		resp.Body = &stitchedBody{
+			Reader: io.MultiReader(bytes.NewReader(buffered), resp.Body),
+			closer: resp.Body,
+		}
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	entry = &dedupEntry{
+		status: resp.StatusCode,
+		header: resp.Header,
+		body:   buffered,
+	}
+	t.mu.Lock()
+	t.cache[key] = entry
+	t.mu.Unlock()
+
+	return entry.response(req), nil
+}
+
+func (e *dedupEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		StatusCode: e.status,
+		Status:     http.StatusText(e.status),
+		Header:     e.header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(e.body)),
+		Request:    req,
+	}
+}
+
+// stitchedBody rejoins a partially buffered body with its unread remainder
+// while keeping the original Close behavior.
+type stitchedBody struct {
+	io.Reader
+	closer io.Closer
+}
+
+func (b *stitchedBody) Close() error {
+	return b.closer.Close()
+}
@@ -0,0 +1,20 @@
+// Package api is the Bitbucket Cloud client used by bb, published for reuse
+// by other Go tools so they do not have to shell out to the bb binary.
+//
+// The exported surface — Client and its request methods, the typed resource
+// helpers, Paginate, HTTPError and the Is*Error predicates, and the resource
+// types in types.go — follows semantic versioning along with the module:
+// breaking changes to these identifiers only happen in a new major version.
+// Unexported internals, including the transport chain assembled by
+// NewHTTPClient, carry no such guarantee.
+//
+// A minimal consumer wires its own http.Client (with whatever authentication
+// it manages) into NewClientFromHTTP and issues requests by API path:
+//
+//	client := api.NewClientFromHTTP(httpClient)
+//	var repo api.Repository
+//	err := client.Get("bitbucket.org", "repositories/my-workspace/my-repo", &repo)
+//
+// Collection endpoints return pages; Paginate walks them so callers do not
+// need to follow next links by hand.
+package api
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	debugDumpMu      sync.Mutex
+	debugDumpEnabled bool
+)
+
+// EnableDebugDump turns on failure dumps; called when the --debug-dump flag
+// is seen.
+func EnableDebugDump() {
+	debugDumpMu.Lock()
+	defer debugDumpMu.Unlock()
+	debugDumpEnabled = true
+}
+
+func debugDumpOn() bool {
+	debugDumpMu.Lock()
+	defer debugDumpMu.Unlock()
+	return debugDumpEnabled
+}
+
+// dumpTripper writes the full request and response of failed API calls to a
+// temp file so users can attach complete context to bug reports. Credentials
+// are redacted before anything reaches disk.
+type dumpTripper struct {
+	base http.RoundTripper
+}
+
+func newDumpTripper(rt http.RoundTripper) *dumpTripper {
+	return &dumpTripper{base: rt}
+}
+
+func (t *dumpTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !debugDumpOn() {
+		return t.base.RoundTrip(req)
+	}
+
+	// Buffer the request body up front; by the time the response status is
+	// known the transport has already consumed it.
+	var reqBody []byte
+	if req.Body != nil {
+		reqBody, _ = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+
+	respBody, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if readErr != nil {
+		return resp, readErr
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// The dump is a debugging aid; failing to write it must not mask the
+	// real API error.
+	if path, werr := writeDump(req, reqBody, resp, respBody); werr == nil {
+		fmt.Fprintf(os.Stderr, "Full request and response written to %s\n", path)
+	}
+
+	return resp, nil
+}
+
+// writeDump renders the exchange in wire format, with sensitive headers
+// redacted, and returns the path of the temp file it was written to.
+func writeDump(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte) (string, error) {
+	f, err := os.CreateTemp("", "bb-dump-*.txt")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "%s %s %s\n", req.Method, req.URL, req.Proto)
+	writeDumpHeaders(f, req.Header)
+	fmt.Fprintln(f)
+	if len(reqBody) > 0 {
+		f.Write(reqBody)
+		fmt.Fprintln(f)
+	}
+
+	fmt.Fprintf(f, "\n%s %s\n", resp.Proto, resp.Status)
+	writeDumpHeaders(f, resp.Header)
+	fmt.Fprintln(f)
+	if len(respBody) > 0 {
+		f.Write(respBody)
+		fmt.Fprintln(f)
+	}
+
+	return f.Name(), nil
+}
+
+func writeDumpHeaders(w io.Writer, headers http.Header) {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if isSensitiveHeader(name) {
+			fmt.Fprintf(w, "%s: [REDACTED]\n", name)
+			continue
+		}
+		for _, value := range headers[name] {
+			fmt.Fprintf(w, "%s: %s\n", name, value)
+		}
+	}
+}
+
+func isSensitiveHeader(name string) bool {
+	switch strings.ToLower(name) {
+	case "authorization", "cookie", "set-cookie", "proxy-authorization":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Fixture capture and replay for reproducible bug reports and deterministic
+// tests. BB_RECORD=path appends sanitized request/response pairs to path as
+// JSON lines; BB_REPLAY=path serves the recorded responses back instead of
+// talking to the network.
+
+type fixture struct {
+	Request  fixtureRequest  `json:"request"`
+	Response fixtureResponse `json:"response"`
+}
+
+type fixtureRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+type fixtureResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+// sensitiveHeaders never make it into a recording.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie", "Proxy-Authorization"}
+
+func sanitizeHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string, len(h))
+	for key := range h {
+		headers[key] = h.Get(key)
+	}
+	for _, key := range sensitiveHeaders {
+		if _, ok := headers[key]; ok {
+			headers[key] = "REDACTED"
+		}
+	}
+	return headers
+}
+
+// recordingTripper captures every exchange that passes through it.
+type recordingTripper struct {
+	base http.RoundTripper
+	path string
+
+	mu sync.Mutex
+}
+
+func newRecordingTripper(base http.RoundTripper, path string) *recordingTripper {
+	return &recordingTripper{base: base, path: path}
+}
+
+func (t *recordingTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	entry := fixture{
+		Request: fixtureRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: sanitizeHeaders(req.Header),
+			Body:    string(reqBody),
+		},
+		Response: fixtureResponse{
+			Status:  resp.StatusCode,
+			Headers: sanitizeHeaders(resp.Header),
+			Body:    string(respBody),
+		},
+	}
+
+	if err := t.append(entry); err != nil {
+		return nil, fmt.Errorf("failed to record fixture: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (t *recordingTripper) append(entry fixture) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f, err := os.OpenFile(t.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewEncoder(f).Encode(entry)
+}
+
+// replayTripper serves recorded responses instead of performing requests.
+// Fixtures are matched by method and URL; repeated identical requests consume
+// recorded entries in order and the last one is reused once they run out.
+type replayTripper struct {
+	mu       sync.Mutex
+	fixtures []fixture
+	used     []bool
+}
+
+func newReplayTripper(path string) (*replayTripper, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open replay file: %w", err)
+	}
+	defer f.Close()
+
+	t := &replayTripper{}
+	dec := json.NewDecoder(f)
+	for {
+		var entry fixture
+		if err := dec.Decode(&entry); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse replay file %s: %w", path, err)
+		}
+		t.fixtures = append(t.fixtures, entry)
+	}
+	t.used = make([]bool, len(t.fixtures))
+
+	return t, nil
+}
+
+func (t *replayTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	match := -1
+	for i, entry := range t.fixtures {
+		if entry.Request.Method != req.Method || entry.Request.URL != req.URL.String() {
+			continue
+		}
+		match = i
+		if !t.used[i] {
+			break
+		}
+	}
+	if match == -1 {
+		return nil, fmt.Errorf("no recorded response for %s %s", req.Method, req.URL)
+	}
+	t.used[match] = true
+
+	recorded := t.fixtures[match].Response
+	header := make(http.Header, len(recorded.Headers))
+	for key, value := range recorded.Headers {
+		header.Set(key, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
@@ -1,14 +1,20 @@
 package api
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/profile"
 	"github.com/dlbroadfoot/bitbucket-cli/utils"
 )
 
@@ -16,16 +22,29 @@ type tokenGetter interface {
 	ActiveToken(string) (string, string)
 }
 
+// userTokenGetter is implemented by auth configs that can resolve tokens for
+// a specific stored account rather than the active one.
+type userTokenGetter interface {
+	TokenForUser(hostname, user string) (token string, source string, err error)
+}
+
 // HTTPClientOptions configures the HTTP client.
 type HTTPClientOptions struct {
 	AppVersion         string
+	CABundle           string
 	CacheTTL           time.Duration
+	ClientCert         string
+	ClientKey          string
 	Config             tokenGetter
 	EnableCache        bool
 	Log                io.Writer
 	LogColorize        bool
 	LogVerboseHTTP     bool
+	Proxy              string
 	SkipDefaultHeaders bool
+	TLSCipherSuites    string
+	TLSMinVersion      string
+	WorkspaceAccounts  map[string]string
 }
 
 // NewHTTPClient creates a new HTTP client configured for the Bitbucket API.
@@ -35,7 +54,22 @@ func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
 	}
 
 	// Build transport chain
-	var transport http.RoundTripper = http.DefaultTransport
+	transport, err := newBaseTransport(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fixture capture and replay sit at the bottom of the chain so recordings
+	// reflect the request as it would hit the wire (sanitized of credentials).
+	if replayPath := os.Getenv("BB_REPLAY"); replayPath != "" {
+		replay, err := newReplayTripper(replayPath)
+		if err != nil {
+			return nil, err
+		}
+		transport = replay
+	} else if recordPath := os.Getenv("BB_RECORD"); recordPath != "" {
+		transport = newRecordingTripper(transport, recordPath)
+	}
 
 	// Add default headers
 	if !opts.SkipDefaultHeaders {
@@ -65,17 +99,163 @@ func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
 
 	// Add authentication
 	if opts.Config != nil {
-		transport = AddBasicAuthHeader(transport, opts.Config)
+		transport = AddBasicAuthHeader(transport, opts.Config, opts.WorkspaceAccounts)
 	}
 
+	// Failure dumps sit directly above auth so they capture the request as
+	// it hit the wire, credentials redacted
+	transport = newDumpTripper(transport)
+
+	// Deduplicate identical GETs within this invocation. Sitting above auth
+	// and logging means a cache hit skips the whole chain and never shows up
+	// as a second request in debug output.
+	transport = newDedupTripper(transport)
+
+	// Timing sits at the top of the chain so --profile reports the full cost
+	// of each call, including header, logging, and auth overhead
+	transport = &profilingTripper{base: transport}
+
 	client.Transport = transport
 
 	return client, nil
 }
 
+// newBaseTransport returns the transport at the bottom of the chain: a clone
+// of the default transport — which already honors the HTTP_PROXY, HTTPS_PROXY,
+// and NO_PROXY environment variables — tuned for the CLI's traffic pattern,
+// with any explicit proxy, extra CA bundle, and client certificate applied on
+// top.
+func newBaseTransport(opts HTTPClientOptions) (http.RoundTripper, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	// Nearly all requests go to a single host, and bulk commands (foreach,
+	// backup, workspace reports) issue them from many goroutines at once. The
+	// default of 2 idle connections per host forces most of those goroutines
+	// through fresh TLS handshakes; keep enough warm connections around to
+	// match our usual fan-out instead. HTTP/2, which multiplexes them further,
+	// is already attempted by the cloned defaults.
+	transport.MaxIdleConnsPerHost = 16
+
+	if opts.CABundle == "" && opts.ClientCert == "" && opts.ClientKey == "" && opts.Proxy == "" &&
+		opts.TLSMinVersion == "" && opts.TLSCipherSuites == "" {
+		return transport, nil
+	}
+
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+
+	if opts.CABundle != "" {
+		pem, err := os.ReadFile(opts.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		// Extend the system roots rather than replacing them, so the
+		// bundle only needs the corporate proxy's certificates.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", opts.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCert != "" || opts.ClientKey != "" {
+		if opts.ClientCert == "" || opts.ClientKey == "" {
+			return nil, errors.New("client_cert and client_key must both be configured for mutual TLS")
+		}
+		cert, err := tls.LoadX509KeyPair(opts.ClientCert, opts.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if opts.TLSMinVersion != "" {
+		version, err := parseTLSVersion(opts.TLSMinVersion)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if opts.TLSCipherSuites != "" {
+		suites, err := parseCipherSuites(opts.TLSCipherSuites)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.CipherSuites = suites
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if opts.Proxy != "" {
+		proxyURL, err := url.Parse(opts.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %q: %w", opts.Proxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// parseTLSVersion maps the tls_min_version config value to the crypto/tls
+// version constant.
+func parseTLSVersion(value string) (uint16, error) {
+	switch value {
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("invalid tls_min_version %q: valid values are 1.0, 1.1, 1.2, 1.3", value)
+}
+
+// parseCipherSuites resolves a comma-separated list of cipher suite names to
+// their crypto/tls identifiers. Deprecated suites are accepted too, since
+// older self-hosted instances are the main reason to configure this at all.
+// Note that Go ignores the configured suites for TLS 1.3 connections.
+func parseCipherSuites(value string) ([]uint16, error) {
+	byName := map[string]uint16{}
+	for _, suite := range tls.CipherSuites() {
+		byName[suite.Name] = suite.ID
+	}
+	for _, suite := range tls.InsecureCipherSuites() {
+		byName[suite.Name] = suite.ID
+	}
+
+	var ids []uint16
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		id, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown TLS cipher suite %q in tls_ciphers", name)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("tls_ciphers does not name any cipher suites")
+	}
+
+	return ids, nil
+}
+
 // AddBasicAuthHeader adds Basic Auth header for Bitbucket API requests.
-// Bitbucket uses Basic Auth with email:api_token format.
-func AddBasicAuthHeader(rt http.RoundTripper, cfg tokenGetter) http.RoundTripper {
+// Bitbucket uses Basic Auth with email:api_token format. When the request
+// targets a workspace mapped in workspaceAccounts, that account's stored
+// token is used instead of the active one.
+func AddBasicAuthHeader(rt http.RoundTripper, cfg tokenGetter, workspaceAccounts map[string]string) http.RoundTripper {
 	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
 		// If the header is already set in the request, don't overwrite it.
 		if req.Header.Get(authorization) == "" {
@@ -87,7 +267,11 @@ func AddBasicAuthHeader(rt http.RoundTripper, cfg tokenGetter) http.RoundTripper
 			// If the host has changed during a redirect do not add the authentication token header.
 			if !redirectHostnameChange {
 				hostname := bbinstance.NormalizeHostname(getHost(req))
-				if token, _ := cfg.ActiveToken(hostname); token != "" {
+				token, _ := cfg.ActiveToken(hostname)
+				if routed := workspaceToken(cfg, hostname, workspaceAccounts, req.URL.Path); routed != "" {
+					token = routed
+				}
+				if token != "" {
 					// Bitbucket tokens are stored as "email:api_token"
 					// Use Basic Auth encoding
 					encoded := base64.StdEncoding.EncodeToString([]byte(token))
@@ -99,6 +283,45 @@ func AddBasicAuthHeader(rt http.RoundTripper, cfg tokenGetter) http.RoundTripper
 	}}
 }
 
+// workspaceToken resolves the token of the account mapped to the workspace
+// the request targets, or "" when no mapping applies.
+func workspaceToken(cfg tokenGetter, hostname string, workspaceAccounts map[string]string, path string) string {
+	if len(workspaceAccounts) == 0 {
+		return ""
+	}
+	workspace := workspaceFromPath(path)
+	if workspace == "" {
+		return ""
+	}
+	user, ok := workspaceAccounts[workspace]
+	if !ok {
+		return ""
+	}
+	utg, ok := cfg.(userTokenGetter)
+	if !ok {
+		return ""
+	}
+	token, _, err := utg.TokenForUser(hostname, user)
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// workspaceFromPath extracts the workspace from API paths such as
+// /2.0/repositories/{workspace}/... and /2.0/workspaces/{workspace}/....
+func workspaceFromPath(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 3 || parts[0] != "2.0" {
+		return ""
+	}
+	switch parts[1] {
+	case "repositories", "workspaces", "snippets":
+		return parts[2]
+	}
+	return ""
+}
+
 // headerTripper adds default headers to requests.
 type headerTripper struct {
 	base    http.RoundTripper
@@ -114,6 +337,16 @@ func (t *headerTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	return t.base.RoundTrip(req)
 }
 
+// profilingTripper records the duration of every request for --profile.
+type profilingTripper struct {
+	base http.RoundTripper
+}
+
+func (t *profilingTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	defer profile.Track(fmt.Sprintf("api %s %s", req.Method, req.URL.Path))()
+	return t.base.RoundTrip(req)
+}
+
 // loggingTripper logs HTTP requests and responses.
 type loggingTripper struct {
 	base     http.RoundTripper
@@ -0,0 +1,48 @@
+package api
+
+import "errors"
+
+// ErrStopPagination can be returned from a Paginate visit function to stop
+// walking pages without Paginate reporting an error.
+var ErrStopPagination = errors.New("stop pagination")
+
+// Page is the envelope every paginated 2.0 collection endpoint returns.
+type Page[T any] struct {
+	Values  []T    `json:"values"`
+	Pagelen int    `json:"pagelen"`
+	Size    int    `json:"size"`
+	Next    string `json:"next"`
+}
+
+// Paginate performs a GET against a paginated collection endpoint and calls
+// visit for each item, following next links until the collection is
+// exhausted, visit returns an error, or visit returns ErrStopPagination.
+func Paginate[T any](client *Client, hostname, path string, visit func(T) error) error {
+	nextURL := ""
+	for {
+		var page Page[T]
+		var err error
+		if nextURL == "" {
+			err = client.Get(hostname, path, &page)
+		} else {
+			err = client.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, value := range page.Values {
+			if err := visit(value); err != nil {
+				if errors.Is(err, ErrStopPagination) {
+					return nil
+				}
+				return err
+			}
+		}
+
+		if page.Next == "" {
+			return nil
+		}
+		nextURL = page.Next
+	}
+}
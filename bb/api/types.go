@@ -1,6 +1,9 @@
 package api
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Bitbucket API v2.0 Types
 // See: https://developer.atlassian.com/cloud/bitbucket/rest/intro/
@@ -76,6 +79,52 @@ type Repository struct {
 	HasWiki     bool        `json:"has_wiki"`
 }
 
+// InfoLabel summarizes repository visibility and fork status for display,
+// e.g. "private, fork".
+func (r Repository) InfoLabel() string {
+	var tags []string
+
+	if r.IsPrivate {
+		tags = append(tags, "private")
+	} else {
+		tags = append(tags, "public")
+	}
+
+	if r.Parent != nil {
+		tags = append(tags, "fork")
+	}
+
+	return strings.Join(tags, ", ")
+}
+
+// ExportData maps the repository into the fields requested with --json.
+// Field names match the column names used by repo list.
+func (r Repository) ExportData(fields []string) map[string]interface{} {
+	fullName := r.FullName
+	if fullName == "" {
+		fullName = r.Workspace.Slug + "/" + r.Slug
+	}
+
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "name":
+			data[f] = fullName
+		case "description":
+			data[f] = r.Description
+		case "info":
+			data[f] = r.InfoLabel()
+		case "language":
+			data[f] = r.Language
+		case "created":
+			data[f] = r.CreatedOn
+		case "updated":
+			data[f] = r.UpdatedOn
+		}
+	}
+	return data
+}
+
 // Branch represents a git branch.
 type Branch struct {
 	Name   string  `json:"name"`
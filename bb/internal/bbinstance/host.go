@@ -4,6 +4,7 @@ package bbinstance
 import (
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 )
 
@@ -42,7 +43,18 @@ func HostnameValidator(hostname string) error {
 
 // RESTPrefix returns the REST API base URL for the given hostname.
 // For Bitbucket Cloud, this is https://api.bitbucket.org/2.0/
+//
+// The BB_API_URL environment variable overrides the base URL entirely,
+// regardless of hostname. It accepts a full URL including scheme, e.g.
+// http://localhost:8080/2.0, so integration tests can point the CLI at a
+// local mock server without DNS tricks.
 func RESTPrefix(hostname string) string {
+	if override := os.Getenv("BB_API_URL"); override != "" {
+		if !strings.HasSuffix(override, "/") {
+			override += "/"
+		}
+		return override
+	}
 	if IsCloud(hostname) {
 		return "https://api.bitbucket.org/2.0/"
 	}
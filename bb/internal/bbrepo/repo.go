@@ -72,12 +72,17 @@ func FromURL(u *url.URL) (Interface, error) {
 		return nil, fmt.Errorf("no hostname detected")
 	}
 
-	parts := strings.SplitN(strings.Trim(u.Path, "/"), "/", 3)
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	// Self-hosted instances expose clone URLs as /scm/PROJECT/repo
+	if len(parts) == 3 && strings.EqualFold(parts[0], "scm") {
+		parts = parts[1:]
+	}
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("invalid path: %s", u.Path)
 	}
 
-	return NewWithHost(parts[0], strings.TrimSuffix(parts[1], ".git"), u.Hostname()), nil
+	return NewWithHost(parts[0], parts[1], u.Hostname()), nil
 }
 
 func normalizeHostname(h string) string {
@@ -0,0 +1,110 @@
+package bbrepo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+)
+
+func Test_repoFromURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		result string
+		host   string
+		err    error
+	}{
+		{
+			name:   "bitbucket.org URL",
+			input:  "https://bitbucket.org/atlassian/bitbucket-cli.git",
+			result: "atlassian/bitbucket-cli",
+			host:   "bitbucket.org",
+			err:    nil,
+		},
+		{
+			name:   "bitbucket.org URL with trailing slash",
+			input:  "https://bitbucket.org/atlassian/bitbucket-cli/",
+			result: "atlassian/bitbucket-cli",
+			host:   "bitbucket.org",
+			err:    nil,
+		},
+		{
+			name:   "trailing .git and slash",
+			input:  "https://bitbucket.org/atlassian/bitbucket-cli.git/",
+			result: "atlassian/bitbucket-cli",
+			host:   "bitbucket.org",
+			err:    nil,
+		},
+		{
+			name:   "scp-like SSH URL",
+			input:  "git@bitbucket.org:atlassian/bitbucket-cli.git",
+			result: "atlassian/bitbucket-cli",
+			host:   "bitbucket.org",
+			err:    nil,
+		},
+		{
+			name:   "SSH URL with port",
+			input:  "ssh://git@bitbucket.org:443/atlassian/bitbucket-cli",
+			result: "atlassian/bitbucket-cli",
+			host:   "bitbucket.org",
+			err:    nil,
+		},
+		{
+			name:   "self-hosted scm path",
+			input:  "https://bitbucket.example.com/scm/PROJ/repo.git",
+			result: "PROJ/repo",
+			host:   "bitbucket.example.com",
+			err:    nil,
+		},
+		{
+			name:   "self-hosted scm path over SSH",
+			input:  "ssh://git@bitbucket.example.com:7999/scm/PROJ/repo.git",
+			result: "PROJ/repo",
+			host:   "bitbucket.example.com",
+			err:    nil,
+		},
+		{
+			name:   "too many path components",
+			input:  "https://bitbucket.org/atlassian/bitbucket-cli/pull-requests",
+			result: "",
+			host:   "",
+			err:    errors.New("invalid path: /atlassian/bitbucket-cli/pull-requests"),
+		},
+		{
+			name:   "filesystem path",
+			input:  "/path/to/file",
+			result: "",
+			host:   "",
+			err:    errors.New("no hostname detected"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := git.ParseURL(tt.input)
+			if err != nil {
+				t.Fatalf("got error %q", err)
+			}
+
+			repo, err := FromURL(u)
+			if err != nil {
+				if tt.err == nil {
+					t.Fatalf("got error %q", err)
+				} else if tt.err.Error() == err.Error() {
+					return
+				}
+				t.Fatalf("got error %q", err)
+			}
+
+			got := fmt.Sprintf("%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
+			if tt.result != got {
+				t.Errorf("expected %q, got %q", tt.result, got)
+			}
+			if tt.host != repo.RepoHost() {
+				t.Errorf("expected host %q, got %q", tt.host, repo.RepoHost())
+			}
+		})
+	}
+}
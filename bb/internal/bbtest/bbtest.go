@@ -0,0 +1,207 @@
+// Package bbtest provides a fake Bitbucket API server for command tests.
+//
+// The server speaks just enough of the Bitbucket 2.0 API shape — JSON
+// envelopes, paginated collections with absolute next links, and the standard
+// error payload — that command run functions can be exercised end to end
+// against canned responses instead of stopping at flag parsing.
+package bbtest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// Server is a fake Bitbucket API server. Register canned responses with
+// Handle, JSON, Page, and Error, then point the code under test at it via
+// Client or Factory. Unregistered requests fail the test and return a 404 in
+// the Bitbucket error shape.
+type Server struct {
+	*httptest.Server
+
+	tb        testing.TB
+	mu        sync.Mutex
+	routes    map[string]http.HandlerFunc
+	requests  []*http.Request
+	authToken string
+}
+
+// NewServer starts a fake server that is shut down when the test ends.
+func NewServer(tb testing.TB) *Server {
+	s := &Server{
+		tb:     tb,
+		routes: map[string]http.HandlerFunc{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.dispatch))
+	tb.Cleanup(s.Close)
+	return s
+}
+
+func (s *Server) dispatch(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	handler, ok := s.routes[r.Method+" "+r.URL.Path]
+	token := s.authToken
+	s.mu.Unlock()
+
+	if token != "" {
+		want := "Basic " + base64.StdEncoding.EncodeToString([]byte(token))
+		if r.Header.Get("Authorization") != want {
+			writeError(w, http.StatusUnauthorized, "Token is invalid or not supported for this endpoint.")
+			return
+		}
+	}
+
+	if !ok {
+		s.tb.Errorf("bbtest: unexpected request %s %s", r.Method, r.URL.Path)
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no response registered for %s %s", r.Method, r.URL.Path))
+		return
+	}
+	handler(w, r)
+}
+
+// Handle registers a handler for the given method and API path. Paths are
+// relative to the 2.0 prefix, matching what commands pass to api.Client —
+// e.g. "repositories/ws/repo". A leading slash registers the path verbatim,
+// for endpoints outside the 2.0 API. Query strings are ignored when matching.
+func (s *Server) Handle(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" "+apiPath(path)] = handler
+}
+
+// JSON registers a canned 200 response with the given body.
+func (s *Server) JSON(method, path string, body any) {
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// Error registers a canned error response in the standard Bitbucket shape.
+func (s *Server) Error(method, path string, status int, message string) {
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, status, message)
+	})
+}
+
+// PageResponse describes one page of a paginated collection.
+type PageResponse struct {
+	// Values holds the page's items.
+	Values []any
+	// Next is the path of the following page, in Handle's path convention,
+	// or empty for the last page. It is served as an absolute URL, the way
+	// the real API links pages.
+	Next string
+	// Size is the total number of items across all pages; it defaults to
+	// len(Values).
+	Size int
+}
+
+// Page registers a canned page of a paginated 2.0 collection.
+func (s *Server) Page(method, path string, page PageResponse) {
+	size := page.Size
+	if size == 0 {
+		size = len(page.Values)
+	}
+	s.Handle(method, path, func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{
+			"pagelen": len(page.Values),
+			"size":    size,
+			"values":  page.Values,
+		}
+		if page.Next != "" {
+			body["next"] = s.URL + apiPath(page.Next)
+		}
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+// RequireAuth makes the server reject any request that does not carry Basic
+// credentials for the given "email:api_token" value, the way an expired or
+// missing token would.
+func (s *Server) RequireAuth(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.authToken = token
+}
+
+// Requests returns the requests received so far, in order.
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+// Client returns an http.Client that delivers every request to the fake
+// server no matter which host the code under test targeted, so commands that
+// build bitbucket.org URLs work unchanged.
+func (s *Server) Client() *http.Client {
+	target, err := url.Parse(s.URL)
+	if err != nil {
+		s.tb.Fatalf("bbtest: parsing server URL: %v", err)
+	}
+	return &http.Client{Transport: &rewriteTripper{host: target.Host}}
+}
+
+// Factory returns a cmdutil.Factory whose HTTP client talks to the fake
+// server and whose config is a blank stub, enough for most run functions.
+// Tests needing more wiring can overwrite individual fields.
+func (s *Server) Factory(io *iostreams.IOStreams) *cmdutil.Factory {
+	return &cmdutil.Factory{
+		IOStreams: io,
+		HttpClient: func() (*http.Client, error) {
+			return s.Client(), nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+	}
+}
+
+// apiPath resolves a path in Handle's convention to the URL path the server
+// will see.
+func apiPath(path string) string {
+	if strings.HasPrefix(path, "/") {
+		return path
+	}
+	return "/2.0/" + path
+}
+
+// rewriteTripper redirects requests to the fake server while keeping the
+// path and query intact.
+type rewriteTripper struct {
+	host string
+}
+
+func (t *rewriteTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = "http"
+	req.URL.Host = t.host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"type": "error",
+		"error": map[string]any{
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package bbtest
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerJSON(t *testing.T) {
+	s := NewServer(t)
+	s.JSON("GET", "repositories/ws/repo", map[string]any{
+		"full_name": "ws/repo",
+	})
+
+	apiClient := api.NewClientFromHTTP(s.Client())
+	var repo struct {
+		FullName string `json:"full_name"`
+	}
+	err := apiClient.Get("bitbucket.org", "repositories/ws/repo", &repo)
+	require.NoError(t, err)
+	assert.Equal(t, "ws/repo", repo.FullName)
+	assert.Len(t, s.Requests(), 1)
+}
+
+func TestServerPagination(t *testing.T) {
+	s := NewServer(t)
+	s.Page("GET", "repositories/ws/repo/pullrequests", PageResponse{
+		Values: []any{map[string]any{"id": 1}},
+		Next:   "repositories/ws/repo/pullrequests/page2",
+		Size:   2,
+	})
+	s.Page("GET", "repositories/ws/repo/pullrequests/page2", PageResponse{
+		Values: []any{map[string]any{"id": 2}},
+	})
+
+	apiClient := api.NewClientFromHTTP(s.Client())
+
+	var ids []int
+	nextURL := ""
+	for {
+		var page struct {
+			Values []struct {
+				ID int `json:"id"`
+			} `json:"values"`
+			Next string `json:"next"`
+			Size int    `json:"size"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", "repositories/ws/repo/pullrequests", &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		require.NoError(t, err)
+		for _, v := range page.Values {
+			ids = append(ids, v.ID)
+		}
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	assert.Equal(t, []int{1, 2}, ids)
+}
+
+func TestServerError(t *testing.T) {
+	s := NewServer(t)
+	s.Error("GET", "repositories/ws/gone", 404, "Repository ws/gone not found")
+
+	apiClient := api.NewClientFromHTTP(s.Client())
+	var out map[string]any
+	err := apiClient.Get("bitbucket.org", "repositories/ws/gone", &out)
+	require.Error(t, err)
+	assert.True(t, api.IsNotFoundError(err))
+	assert.Contains(t, err.Error(), "Repository ws/gone not found")
+}
+
+func TestServerRequireAuth(t *testing.T) {
+	s := NewServer(t)
+	s.RequireAuth("user@example.com:token123")
+	s.JSON("GET", "user", map[string]any{"username": "user"})
+
+	apiClient := api.NewClientFromHTTP(s.Client())
+	var out map[string]any
+	err := apiClient.Get("bitbucket.org", "user", &out)
+	require.Error(t, err)
+
+	var httpErr api.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, 401, httpErr.StatusCode)
+}
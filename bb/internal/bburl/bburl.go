@@ -0,0 +1,64 @@
+// Package bburl builds Bitbucket web UI URLs for the routes list commands
+// open with --web, so knowledge of the UI's paths lives in one place.
+package bburl
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+)
+
+// Repo returns a repository's overview page.
+func Repo(repo bbrepo.Interface) string {
+	return bbrepo.GenerateRepoURL(repo, "")
+}
+
+// PullRequests returns a repository's pull request list, optionally filtered
+// to a state (open, merged, declined, superseded). "all" means no filter.
+func PullRequests(repo bbrepo.Interface, state string) string {
+	u := bbrepo.GenerateRepoURL(repo, "pull-requests/")
+	if state != "" && !strings.EqualFold(state, "all") {
+		u += "?state=" + url.QueryEscape(strings.ToUpper(state))
+	}
+	return u
+}
+
+// Issues returns a repository's issue list, optionally filtered to statuses.
+// "all" or an empty list means no filter.
+func Issues(repo bbrepo.Interface, statuses ...string) string {
+	u := bbrepo.GenerateRepoURL(repo, "issues")
+	params := url.Values{}
+	for _, s := range statuses {
+		if s != "" && !strings.EqualFold(s, "all") {
+			params.Add("status", s)
+		}
+	}
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+	return u
+}
+
+// Pipelines returns a repository's pipelines page, optionally filtered to a
+// branch.
+func Pipelines(repo bbrepo.Interface, branch string) string {
+	u := bbrepo.GenerateRepoURL(repo, "pipelines")
+	if branch != "" {
+		u += "?branch=" + url.QueryEscape(branch)
+	}
+	return u
+}
+
+// Workspace returns a workspace's landing page, which lists its repositories.
+func Workspace(hostname, workspace string) string {
+	return bbinstance.HostPrefix(hostname) + url.PathEscape(workspace) + "/"
+}
+
+// Project returns a project's page within its workspace.
+func Project(hostname, workspace, key string) string {
+	return fmt.Sprintf("%s%s/workspace/projects/%s",
+		bbinstance.HostPrefix(hostname), url.PathEscape(workspace), url.PathEscape(key))
+}
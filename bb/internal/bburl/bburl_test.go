@@ -0,0 +1,24 @@
+package bburl
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLs(t *testing.T) {
+	repo, err := bbrepo.FromFullName("ws/repo")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://bitbucket.org/ws/repo", Repo(repo))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/pull-requests/?state=OPEN", PullRequests(repo, "open"))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/pull-requests/", PullRequests(repo, "all"))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/issues?status=new&status=open", Issues(repo, "new", "open"))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/issues", Issues(repo, "all"))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/pipelines?branch=main", Pipelines(repo, "main"))
+	assert.Equal(t, "https://bitbucket.org/ws/repo/pipelines", Pipelines(repo, ""))
+	assert.Equal(t, "https://bitbucket.org/ws/", Workspace("bitbucket.org", "ws"))
+	assert.Equal(t, "https://bitbucket.org/ws/workspace/projects/PROJ", Project("bitbucket.org", "ws", "PROJ"))
+}
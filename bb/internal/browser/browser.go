@@ -1,16 +1,75 @@
 package browser
 
 import (
+	"fmt"
 	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
 
 	ghBrowser "github.com/cli/go-gh/v2/pkg/browser"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
 )
 
 type Browser interface {
 	Browse(string) error
 }
 
+// New returns a Browser that runs launcher to open URLs. An empty launcher
+// falls back to platform detection: wslview under WSL, otherwise the standard
+// system opener. When no opener works, the URL is printed instead so it stays
+// usable in SSH sessions without one.
 func New(launcher string, stdout, stderr io.Writer) Browser {
+	if launcher == "" {
+		launcher = detectLauncher()
+	}
 	b := ghBrowser.New(launcher, stdout, stderr)
-	return b
+	return &fallbackBrowser{opener: b, stdout: stdout}
+}
+
+// opener is the part of the underlying browser that fallbackBrowser wraps.
+type opener interface {
+	Browse(string) error
+}
+
+// fallbackBrowser prints the URL when the opener fails, instead of surfacing
+// an error the user cannot act on.
+type fallbackBrowser struct {
+	opener opener
+	stdout io.Writer
+}
+
+func (b *fallbackBrowser) Browse(url string) error {
+	if err := b.opener.Browse(url); err != nil {
+		fmt.Fprintln(b.stdout, i18n.T("Open this URL in your browser: %s", url))
+	}
+	return nil
+}
+
+// detectLauncher picks an opener for environments the default detection
+// misses, notably WSL where the Linux openers cannot reach the Windows
+// browser.
+func detectLauncher() string {
+	if isWSL() {
+		if _, err := exec.LookPath("wslview"); err == nil {
+			return "wslview"
+		}
+	}
+	return ""
+}
+
+// isWSL reports whether this process runs inside Windows Subsystem for Linux.
+func isWSL() bool {
+	if runtime.GOOS != "linux" {
+		return false
+	}
+	if os.Getenv("WSL_DISTRO_NAME") != "" || os.Getenv("WSL_INTEROP") != "" {
+		return true
+	}
+	data, err := os.ReadFile("/proc/sys/kernel/osrelease")
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(string(data)), "microsoft")
 }
@@ -0,0 +1,40 @@
+package browser
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOpener struct {
+	err  error
+	urls []string
+}
+
+func (o *fakeOpener) Browse(url string) error {
+	o.urls = append(o.urls, url)
+	return o.err
+}
+
+func Test_fallbackBrowser(t *testing.T) {
+	t.Run("opener succeeds", func(t *testing.T) {
+		stdout := &bytes.Buffer{}
+		o := &fakeOpener{}
+		b := &fallbackBrowser{opener: o, stdout: stdout}
+
+		assert.NoError(t, b.Browse("https://bitbucket.org/ws/repo"))
+		assert.Equal(t, []string{"https://bitbucket.org/ws/repo"}, o.urls)
+		assert.Equal(t, "", stdout.String())
+	})
+
+	t.Run("opener fails", func(t *testing.T) {
+		stdout := &bytes.Buffer{}
+		o := &fakeOpener{err: errors.New("exec: no opener")}
+		b := &fallbackBrowser{opener: o, stdout: stdout}
+
+		assert.NoError(t, b.Browse("https://bitbucket.org/ws/repo"))
+		assert.Equal(t, "Open this URL in your browser: https://bitbucket.org/ws/repo\n", stdout.String())
+	})
+}
@@ -17,28 +17,60 @@ import (
 // Important: some of the following configuration settings are used outside of `cli/cli`,
 // they are defined here to avoid `cli/cli` being changed unexpectedly.
 const (
-	accessibleColorsKey   = "accessible_colors" // used by cli/go-gh to enable the use of customizable, accessible 4-bit colors.
-	accessiblePrompterKey = "accessible_prompter"
-	aliasesKey            = "aliases"
-	browserKey            = "browser" // used by cli/go-gh to open URLs in web browsers
-	colorLabelsKey        = "color_labels"
-	editorKey             = "editor" // used by cli/go-gh to open interactive text editor
-	gitProtocolKey        = "git_protocol"
-	hostsKey              = "hosts" // used by cli/go-gh to locate authenticated host tokens
-	httpUnixSocketKey     = "http_unix_socket"
-	oauthTokenKey         = "oauth_token" // used by cli/go-gh to locate authenticated host tokens
-	pagerKey              = "pager"
-	promptKey             = "prompt"
-	preferEditorPromptKey = "prefer_editor_prompt"
-	spinnerKey            = "spinner"
-	userKey               = "user"
-	usersKey              = "users"
-	versionKey            = "version"
+	accessibleColorsKey    = "accessible_colors" // used by cli/go-gh to enable the use of customizable, accessible 4-bit colors.
+	accessibleOutputKey    = "accessible_output"
+	accessiblePrompterKey  = "accessible_prompter"
+	aliasesKey             = "aliases"
+	apiSnippetsKey         = "api_snippets"
+	browserKey             = "browser" // used by cli/go-gh to open URLs in web browsers
+	caBundleKey            = "ca_bundle"
+	clientCertKey          = "client_cert"
+	clientKeyKey           = "client_key"
+	colorLabelsKey         = "color_labels"
+	concurrencyKey         = "concurrency"
+	confirmDestructiveKey  = "confirm_destructive"
+	defaultWorkspaceKey    = "default_workspace"
+	editorKey              = "editor" // used by cli/go-gh to open interactive text editor
+	filtersKey             = "filters"
+	gitProtocolKey         = "git_protocol"
+	hostsKey               = "hosts" // used by cli/go-gh to locate authenticated host tokens
+	httpProxyKey           = "http_proxy"
+	httpUnixSocketKey      = "http_unix_socket"
+	issueListColumnsKey    = "issue_list_columns"
+	jiraApiTokenKey        = "jira_api_token"
+	localeKey              = "locale"
+	jiraBaseUrlKey         = "jira_base_url"
+	jiraEmailKey           = "jira_email"
+	mergeTrailersKey       = "merge_trailers"
+	notificationsKey       = "notifications"
+	oauthTokenKey          = "oauth_token" // used by cli/go-gh to locate authenticated host tokens
+	pagerKey               = "pager"
+	pipelineListColumnsKey = "pipeline_list_columns"
+	postMergeKey           = "post_merge"
+	prListColumnsKey       = "pr_list_columns"
+	promptKey              = "prompt"
+	preferEditorPromptKey  = "prefer_editor_prompt"
+	repoListColumnsKey     = "repo_list_columns"
+	secretCacheGpgKeyKey   = "secret_cache_gpg_key"
+	spinnerKey             = "spinner"
+	themeKey               = "theme"
+	tlsCiphersKey          = "tls_ciphers"
+	tlsMinVersionKey       = "tls_min_version"
+	userKey                = "user"
+	usersKey               = "users"
+	versionKey             = "version"
+	workspaceAccountsKey   = "workspace_accounts"
 )
 
 func NewConfig() (gh.Config, error) {
 	c, err := ghConfig.Read(fallbackConfig())
 	if err != nil {
+		// A truncated or corrupt file (typically left by a crashed or killed
+		// process) would otherwise surface as a bare YAML parse error.
+		var invalidErr *ghConfig.InvalidConfigFileError
+		if errors.As(err, &invalidErr) {
+			return nil, fmt.Errorf("config file %s is corrupt or truncated: %w\nRestore it from a backup, or delete it and run `bb auth login` to recreate it", invalidErr.Path, invalidErr.Err)
+		}
 		return nil, err
 	}
 	return &cfg{c}, nil
@@ -102,14 +134,39 @@ func (c *cfg) Set(hostname, key, value string) {
 	}
 }
 
+// Write persists modified configuration under an inter-process lock, writing
+// through a staging directory and renaming files into place so concurrent bb
+// invocations cannot corrupt each other's config.
 func (c *cfg) Write() error {
-	return ghConfig.Write(c.cfg)
+	unlock, err := lockConfigDir()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return atomicWrite(c.cfg)
 }
 
 func (c *cfg) Aliases() gh.AliasConfig {
 	return &AliasConfig{cfg: c.cfg}
 }
 
+func (c *cfg) ApiSnippets() gh.AliasConfig {
+	return &AliasConfig{cfg: c.cfg, key: apiSnippetsKey}
+}
+
+func (c *cfg) Filters() gh.AliasConfig {
+	return &AliasConfig{cfg: c.cfg, key: filtersKey}
+}
+
+func (c *cfg) Notifications() gh.AliasConfig {
+	return &AliasConfig{cfg: c.cfg, key: notificationsKey}
+}
+
+func (c *cfg) WorkspaceAccounts() gh.AliasConfig {
+	return &AliasConfig{cfg: c.cfg, key: workspaceAccountsKey}
+}
+
 func (c *cfg) Authentication() gh.AuthConfig {
 	return &AuthConfig{cfg: c.cfg}
 }
@@ -134,6 +191,16 @@ func (c *cfg) ColorLabels(hostname string) gh.ConfigEntry {
 	return c.GetOrDefault(hostname, colorLabelsKey).Unwrap()
 }
 
+func (c *cfg) ConfirmDestructive(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, confirmDestructiveKey).Unwrap()
+}
+
+func (c *cfg) DefaultWorkspace(hostname string) gh.ConfigEntry {
+	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
+	return c.GetOrDefault(hostname, defaultWorkspaceKey).Unwrap()
+}
+
 func (c *cfg) Editor(hostname string) gh.ConfigEntry {
 	// Intentionally panic if there is no user provided value or default value (which would be a programmer error)
 	return c.GetOrDefault(hostname, editorKey).Unwrap()
@@ -169,6 +236,21 @@ func (c *cfg) Spinner(hostname string) gh.ConfigEntry {
 	return c.GetOrDefault(hostname, spinnerKey).Unwrap()
 }
 
+func (c *cfg) Theme() map[string]string {
+	keys, err := c.cfg.Keys([]string{themeKey})
+	if err != nil {
+		return nil
+	}
+
+	theme := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if val, err := c.cfg.Get([]string{themeKey, key}); err == nil {
+			theme[key] = val
+		}
+	}
+	return theme
+}
+
 func (c *cfg) Version() o.Option[string] {
 	return c.get("", versionKey)
 }
@@ -511,28 +593,38 @@ func keyringServiceName(hostname string) string {
 
 type AliasConfig struct {
 	cfg *ghConfig.Config
+	// key is the top-level config section the map is stored under; the zero
+	// value keeps historical constructions pointing at the aliases section.
+	key string
+}
+
+func (a *AliasConfig) section() string {
+	if a.key == "" {
+		return aliasesKey
+	}
+	return a.key
 }
 
 func (a *AliasConfig) Get(alias string) (string, error) {
-	return a.cfg.Get([]string{aliasesKey, alias})
+	return a.cfg.Get([]string{a.section(), alias})
 }
 
 func (a *AliasConfig) Add(alias, expansion string) {
-	a.cfg.Set([]string{aliasesKey, alias}, expansion)
+	a.cfg.Set([]string{a.section(), alias}, expansion)
 }
 
 func (a *AliasConfig) Delete(alias string) error {
-	return a.cfg.Remove([]string{aliasesKey, alias})
+	return a.cfg.Remove([]string{a.section(), alias})
 }
 
 func (a *AliasConfig) All() map[string]string {
 	out := map[string]string{}
-	keys, err := a.cfg.Keys([]string{aliasesKey})
+	keys, err := a.cfg.Keys([]string{a.section()})
 	if err != nil {
 		return out
 	}
 	for _, key := range keys {
-		val, _ := a.cfg.Get([]string{aliasesKey, key})
+		val, _ := a.cfg.Get([]string{a.section(), key})
 		out[key] = val
 	}
 	return out
@@ -576,6 +668,18 @@ accessible_colors: disabled
 accessible_prompter: disabled
 # Whether to use a animated spinner as a progress indicator. If disabled, a textual progress indicator is used instead. Supported values: enabled, disabled
 spinner: enabled
+# The comma-separated columns displayed by "bb pr list". If blank, the command's default columns are used.
+pr_list_columns:
+# The comma-separated columns displayed by "bb issue list". If blank, the command's default columns are used.
+issue_list_columns:
+# The comma-separated columns displayed by "bb repo list". If blank, the command's default columns are used.
+repo_list_columns:
+# The comma-separated columns displayed by "bb pipeline list". If blank, the command's default columns are used.
+pipeline_list_columns:
+# Customizable color roles, e.g. "state_open: green". Set "name: accessible" to start from the high-contrast theme.
+# theme:
+#   name: accessible
+#   accent: cyan+b
 `
 
 type ConfigOption struct {
@@ -646,6 +750,55 @@ var Options = []ConfigOption{
 			return c.Browser(hostname).Value
 		},
 	},
+	{
+		Key:          httpProxyKey,
+		Description:  "the proxy URL to use for HTTP connections, overriding HTTP(S)_PROXY",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, httpProxyKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          caBundleKey,
+		Description:  "the path to a PEM bundle of extra CA certificates to trust",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, caBundleKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          clientCertKey,
+		Description:  "the path to a PEM client certificate for mutual TLS",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, clientCertKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          clientKeyKey,
+		Description:  "the path to the PEM private key belonging to client_cert",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, clientKeyKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:           tlsMinVersionKey,
+		Description:   "the minimum TLS version accepted when connecting to this host",
+		DefaultValue:  "",
+		AllowedValues: []string{"1.0", "1.1", "1.2", "1.3"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, tlsMinVersionKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          tlsCiphersKey,
+		Description:  "the comma-separated TLS cipher suite names allowed when connecting to this host",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, tlsCiphersKey).UnwrapOrZero().Value
+		},
+	},
 	{
 		Key:           colorLabelsKey,
 		Description:   "whether to display labels using their RGB hex color codes in terminals that support truecolor",
@@ -664,6 +817,15 @@ var Options = []ConfigOption{
 			return c.AccessibleColors(hostname).Value
 		},
 	},
+	{
+		Key:           accessibleOutputKey,
+		Description:   "toggle screen-reader friendly output: plain status lines and worded state instead of spinners, icons, and colors",
+		DefaultValue:  "disabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, accessibleOutputKey).UnwrapOrZero().Value
+		},
+	},
 	{
 		Key:           accessiblePrompterKey,
 		Description:   "whether an accessible prompter should be used",
@@ -682,6 +844,120 @@ var Options = []ConfigOption{
 			return c.Spinner(hostname).Value
 		},
 	},
+	{
+		Key:          concurrencyKey,
+		Description:  "how many concurrent HTTP requests fan-out commands may make",
+		DefaultValue: "5",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, concurrencyKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:           confirmDestructiveKey,
+		Description:   "when destructive commands ask for confirmation",
+		DefaultValue:  "prompt",
+		AllowedValues: []string{"always", "never", "prompt"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.ConfirmDestructive(hostname).Value
+		},
+	},
+	{
+		Key:          defaultWorkspaceKey,
+		Description:  "the Bitbucket workspace to use when none is specified",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.DefaultWorkspace(hostname).Value
+		},
+	},
+	{
+		Key:          jiraBaseUrlKey,
+		Description:  "the Jira Cloud site URL used to look up linked issues",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, jiraBaseUrlKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          jiraEmailKey,
+		Description:  "the Atlassian account email used to authenticate against Jira",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, jiraEmailKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          jiraApiTokenKey,
+		Description:  "the Atlassian API token used to authenticate against Jira",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, jiraApiTokenKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          secretCacheGpgKeyKey,
+		Description:  "the GPG key that the local secret cache encrypts to",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, secretCacheGpgKeyKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          localeKey,
+		Description:  "the locale for translated command output (e.g. es); defaults to the LANG environment",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, localeKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:           mergeTrailersKey,
+		Description:   "whether pr merge appends Reviewed-by and Refs trailers to merge messages",
+		DefaultValue:  "enabled",
+		AllowedValues: []string{"enabled", "disabled"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, mergeTrailersKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          postMergeKey,
+		Description:  "semicolon-separated bb commands to run after a successful pr merge",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, postMergeKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          prListColumnsKey,
+		Description:  "the comma-separated columns displayed by pr list",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, prListColumnsKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          issueListColumnsKey,
+		Description:  "the comma-separated columns displayed by issue list",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, issueListColumnsKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          repoListColumnsKey,
+		Description:  "the comma-separated columns displayed by repo list",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, repoListColumnsKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          pipelineListColumnsKey,
+		Description:  "the comma-separated columns displayed by pipeline list",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, pipelineListColumnsKey).UnwrapOrZero().Value
+		},
+	},
 }
 
 func HomeDirPath(subdir string) (string, error) {
@@ -702,6 +978,10 @@ func DataDir() string {
 	return ghConfig.DataDir()
 }
 
+func CacheDir() string {
+	return ghConfig.CacheDir()
+}
+
 func ConfigDir() string {
 	return ghConfig.ConfigDir()
 }
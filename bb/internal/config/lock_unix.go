@@ -0,0 +1,35 @@
+//go:build !windows
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+)
+
+// lockConfigDir takes an exclusive advisory lock on the configuration
+// directory, blocking until concurrent bb processes release theirs. It
+// returns the function that releases the lock.
+func lockConfigDir() (func(), error) {
+	dir := ghConfig.ConfigDir()
+	if err := os.MkdirAll(dir, 0771); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, ".lock"), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return func() {
+		_ = syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
@@ -0,0 +1,8 @@
+package config
+
+// Windows has no flock equivalent that is safe to take on a file another
+// process may delete; the atomic rename in atomicWrite already prevents
+// torn files, so locking is a no-op here.
+func lockConfigDir() (func(), error) {
+	return func() {}, nil
+}
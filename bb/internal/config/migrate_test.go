@@ -174,59 +174,30 @@ func TestMigrationErrorWritesNoFiles(t *testing.T) {
 }
 
 func TestMigrationWriteErrors(t *testing.T) {
-	tests := []struct {
-		name            string
-		unwriteableFile string
-		wantErrContains string
-	}{
-		{
-			name:            "failure to write hosts",
-			unwriteableFile: "hosts.yml",
-			wantErrContains: "failed to write config after migration",
-		},
-		{
-			name:            "failure to write config",
-			unwriteableFile: "config.yml",
-			wantErrContains: "failed to write config after migration",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tempDir := t.TempDir()
-			t.Setenv("GH_CONFIG_DIR", tempDir)
-
-			// Given we error when writing the files (because we chmod the files as trickery)
-			makeFileUnwriteable(t, filepath.Join(tempDir, tt.unwriteableFile))
-
-			c := ghConfig.ReadFromString(testFullConfig())
-			topLevelKey := []string{"toplevelkey"}
-			hostsKey := []string{hostsKey, "newhost"}
-
-			migration := mockMigration(func(someCfg *ghConfig.Config) error {
-				someCfg.Set(topLevelKey, "toplevelvalue")
-				someCfg.Set(hostsKey, "newhostvalue")
-				return nil
-			})
-
-			// When we run the migration
-			conf := cfg{c}
-			err := conf.Migrate(migration)
+	// Writes go through a staging directory and are renamed into place, so a
+	// read-only file no longer blocks them; a read-only directory does.
+	tempDir := t.TempDir()
+	configDir := filepath.Join(tempDir, "config")
+	require.NoError(t, os.Mkdir(configDir, 0555))
+	t.Setenv("GH_CONFIG_DIR", configDir)
+	t.Cleanup(func() { _ = os.Chmod(configDir, 0755) })
 
-			// Then the error is wrapped and bubbled
-			require.ErrorContains(t, err, tt.wantErrContains)
-		})
-	}
-}
+	c := ghConfig.ReadFromString(testFullConfig())
+	topLevelKey := []string{"toplevelkey"}
+	hostsKey := []string{hostsKey, "newhost"}
 
-func makeFileUnwriteable(t *testing.T, file string) {
-	t.Helper()
+	migration := mockMigration(func(someCfg *ghConfig.Config) error {
+		someCfg.Set(topLevelKey, "toplevelvalue")
+		someCfg.Set(hostsKey, "newhostvalue")
+		return nil
+	})
 
-	f, err := os.Create(file)
-	require.NoError(t, err)
-	f.Close()
+	// When we run the migration
+	conf := cfg{c}
+	err := conf.Migrate(migration)
 
-	require.NoError(t, os.Chmod(file, 0000))
+	// Then the error is wrapped and bubbled
+	require.ErrorContains(t, err, "failed to write config after migration")
 }
 
 func mockMigration(doFunc func(config *ghConfig.Config) error) *ghmock.MigrationMock {
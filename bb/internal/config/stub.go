@@ -36,6 +36,15 @@ func NewFromString(cfgStr string) *ghmock.ConfigMock {
 	mock.AliasesFunc = func() gh.AliasConfig {
 		return &AliasConfig{cfg: c}
 	}
+	mock.ApiSnippetsFunc = func() gh.AliasConfig {
+		return &AliasConfig{cfg: c, key: apiSnippetsKey}
+	}
+	mock.FiltersFunc = func() gh.AliasConfig {
+		return &AliasConfig{cfg: c, key: filtersKey}
+	}
+	mock.WorkspaceAccountsFunc = func() gh.AliasConfig {
+		return &AliasConfig{cfg: c, key: workspaceAccountsKey}
+	}
 	mock.AuthenticationFunc = func() gh.AuthConfig {
 		return &AuthConfig{
 			cfg: c,
@@ -64,6 +73,12 @@ func NewFromString(cfgStr string) *ghmock.ConfigMock {
 	mock.ColorLabelsFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.ColorLabels(hostname)
 	}
+	mock.ConfirmDestructiveFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.ConfirmDestructive(hostname)
+	}
+	mock.DefaultWorkspaceFunc = func(hostname string) gh.ConfigEntry {
+		return cfg.DefaultWorkspace(hostname)
+	}
 	mock.EditorFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.Editor(hostname)
 	}
@@ -85,6 +100,9 @@ func NewFromString(cfgStr string) *ghmock.ConfigMock {
 	mock.SpinnerFunc = func(hostname string) gh.ConfigEntry {
 		return cfg.Spinner(hostname)
 	}
+	mock.ThemeFunc = func() map[string]string {
+		return cfg.Theme()
+	}
 	mock.VersionFunc = func() o.Option[string] {
 		return cfg.Version()
 	}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+)
+
+// atomicWrite persists the modified configuration files through a staging
+// directory and renames them into place. Concurrent bb invocations (parallel
+// CI steps are the usual culprit) therefore never observe a half-written
+// config.yml or hosts.yml: the rename either happens entirely or not at all.
+func atomicWrite(c *ghConfig.Config) error {
+	dir := ghConfig.ConfigDir()
+	if err := os.MkdirAll(dir, 0771); err != nil {
+		return err
+	}
+
+	// Staging inside the config directory keeps the rename on one filesystem,
+	// which is what makes it atomic.
+	stagingDir, err := os.MkdirTemp(dir, ".write-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	if err := writeStaged(c, stagingDir); err != nil {
+		return err
+	}
+
+	// Only files ghConfig considered modified exist in the staging directory.
+	for _, name := range []string{"config.yml", "hosts.yml"} {
+		staged := filepath.Join(stagingDir, name)
+		if _, err := os.Stat(staged); err != nil {
+			continue
+		}
+		if err := os.Rename(staged, filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// envMu serializes the GH_CONFIG_DIR swap in writeStaged so two in-process
+// writers cannot interleave their swaps.
+var envMu sync.Mutex
+
+// writeStaged redirects ghConfig.Write into the staging directory by swapping
+// GH_CONFIG_DIR for the duration of the call, since go-gh resolves its target
+// directory from the environment at call time and offers no way to serialize
+// the config elsewhere. The swap is visible process-wide, so it relies on
+// writes being issued from the command goroutine after any fan-out has
+// finished: nothing else may resolve the config directory or spawn a child
+// process while a write is in flight.
+func writeStaged(c *ghConfig.Config, stagingDir string) error {
+	envMu.Lock()
+	defer envMu.Unlock()
+
+	originalDir, hadOriginalDir := os.LookupEnv("GH_CONFIG_DIR")
+	os.Setenv("GH_CONFIG_DIR", stagingDir)
+	defer func() {
+		if hadOriginalDir {
+			os.Setenv("GH_CONFIG_DIR", originalDir)
+		} else {
+			os.Unsetenv("GH_CONFIG_DIR")
+		}
+	}()
+
+	return ghConfig.Write(c)
+}
@@ -43,6 +43,10 @@ type Config interface {
 	Browser(hostname string) ConfigEntry
 	// ColorLabels returns the configured color_label setting, optionally scoped by host.
 	ColorLabels(hostname string) ConfigEntry
+	// ConfirmDestructive returns the configured confirm_destructive policy, optionally scoped by host.
+	ConfirmDestructive(hostname string) ConfigEntry
+	// DefaultWorkspace returns the configured default workspace, optionally scoped by host.
+	DefaultWorkspace(hostname string) ConfigEntry
 	// Editor returns the configured editor, optionally scoped by host.
 	Editor(hostname string) ConfigEntry
 	// GitProtocol returns the configured git protocol, optionally scoped by host.
@@ -57,10 +61,26 @@ type Config interface {
 	PreferEditorPrompt(hostname string) ConfigEntry
 	// Spinner returns the configured spinner setting, optionally scoped by host.
 	Spinner(hostname string) ConfigEntry
+	// Theme returns the color role mappings configured under the theme section.
+	Theme() map[string]string
 
 	// Aliases provides persistent storage and modification of command aliases.
 	Aliases() AliasConfig
 
+	// ApiSnippets provides persistent storage and modification of saved bb api invocations.
+	ApiSnippets() AliasConfig
+
+	// Filters provides persistent storage and modification of saved list filters.
+	Filters() AliasConfig
+
+	// Notifications provides persistent storage and modification of chat
+	// notification rules registered with bb notify.
+	Notifications() AliasConfig
+
+	// WorkspaceAccounts provides persistent storage and modification of
+	// workspace-to-account mappings used to route credentials.
+	WorkspaceAccounts() AliasConfig
+
 	// Authentication provides persistent storage and modification of authentication configuration.
 	Authentication() AuthConfig
 
@@ -28,6 +28,9 @@ var _ gh.Config = &ConfigMock{}
 //			AliasesFunc: func() gh.AliasConfig {
 //				panic("mock out the Aliases method")
 //			},
+//			ApiSnippetsFunc: func() gh.AliasConfig {
+//				panic("mock out the ApiSnippets method")
+//			},
 //			AuthenticationFunc: func() gh.AuthConfig {
 //				panic("mock out the Authentication method")
 //			},
@@ -40,9 +43,18 @@ var _ gh.Config = &ConfigMock{}
 //			ColorLabelsFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the ColorLabels method")
 //			},
+//			ConfirmDestructiveFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the ConfirmDestructive method")
+//			},
+//			DefaultWorkspaceFunc: func(hostname string) gh.ConfigEntry {
+//				panic("mock out the DefaultWorkspace method")
+//			},
 //			EditorFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the Editor method")
 //			},
+//			FiltersFunc: func() gh.AliasConfig {
+//				panic("mock out the Filters method")
+//			},
 //			GetOrDefaultFunc: func(hostname string, key string) o.Option[gh.ConfigEntry] {
 //				panic("mock out the GetOrDefault method")
 //			},
@@ -55,6 +67,9 @@ var _ gh.Config = &ConfigMock{}
 //			MigrateFunc: func(migration gh.Migration) error {
 //				panic("mock out the Migrate method")
 //			},
+//			NotificationsFunc: func() gh.AliasConfig {
+//				panic("mock out the Notifications method")
+//			},
 //			PagerFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the Pager method")
 //			},
@@ -70,9 +85,15 @@ var _ gh.Config = &ConfigMock{}
 //			SpinnerFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the Spinner method")
 //			},
+//			ThemeFunc: func() map[string]string {
+//				panic("mock out the Theme method")
+//			},
 //			VersionFunc: func() o.Option[string] {
 //				panic("mock out the Version method")
 //			},
+//			WorkspaceAccountsFunc: func() gh.AliasConfig {
+//				panic("mock out the WorkspaceAccounts method")
+//			},
 //			WriteFunc: func() error {
 //				panic("mock out the Write method")
 //			},
@@ -92,6 +113,9 @@ type ConfigMock struct {
 	// AliasesFunc mocks the Aliases method.
 	AliasesFunc func() gh.AliasConfig
 
+	// ApiSnippetsFunc mocks the ApiSnippets method.
+	ApiSnippetsFunc func() gh.AliasConfig
+
 	// AuthenticationFunc mocks the Authentication method.
 	AuthenticationFunc func() gh.AuthConfig
 
@@ -104,9 +128,18 @@ type ConfigMock struct {
 	// ColorLabelsFunc mocks the ColorLabels method.
 	ColorLabelsFunc func(hostname string) gh.ConfigEntry
 
+	// ConfirmDestructiveFunc mocks the ConfirmDestructive method.
+	ConfirmDestructiveFunc func(hostname string) gh.ConfigEntry
+
+	// DefaultWorkspaceFunc mocks the DefaultWorkspace method.
+	DefaultWorkspaceFunc func(hostname string) gh.ConfigEntry
+
 	// EditorFunc mocks the Editor method.
 	EditorFunc func(hostname string) gh.ConfigEntry
 
+	// FiltersFunc mocks the Filters method.
+	FiltersFunc func() gh.AliasConfig
+
 	// GetOrDefaultFunc mocks the GetOrDefault method.
 	GetOrDefaultFunc func(hostname string, key string) o.Option[gh.ConfigEntry]
 
@@ -119,6 +152,9 @@ type ConfigMock struct {
 	// MigrateFunc mocks the Migrate method.
 	MigrateFunc func(migration gh.Migration) error
 
+	// NotificationsFunc mocks the Notifications method.
+	NotificationsFunc func() gh.AliasConfig
+
 	// PagerFunc mocks the Pager method.
 	PagerFunc func(hostname string) gh.ConfigEntry
 
@@ -134,9 +170,15 @@ type ConfigMock struct {
 	// SpinnerFunc mocks the Spinner method.
 	SpinnerFunc func(hostname string) gh.ConfigEntry
 
+	// ThemeFunc mocks the Theme method.
+	ThemeFunc func() map[string]string
+
 	// VersionFunc mocks the Version method.
 	VersionFunc func() o.Option[string]
 
+	// WorkspaceAccountsFunc mocks the WorkspaceAccounts method.
+	WorkspaceAccountsFunc func() gh.AliasConfig
+
 	// WriteFunc mocks the Write method.
 	WriteFunc func() error
 
@@ -155,6 +197,9 @@ type ConfigMock struct {
 		// Aliases holds details about calls to the Aliases method.
 		Aliases []struct {
 		}
+		// ApiSnippets holds details about calls to the ApiSnippets method.
+		ApiSnippets []struct {
+		}
 		// Authentication holds details about calls to the Authentication method.
 		Authentication []struct {
 		}
@@ -171,11 +216,24 @@ type ConfigMock struct {
 			// Hostname is the hostname argument value.
 			Hostname string
 		}
+		// ConfirmDestructive holds details about calls to the ConfirmDestructive method.
+		ConfirmDestructive []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
+		// DefaultWorkspace holds details about calls to the DefaultWorkspace method.
+		DefaultWorkspace []struct {
+			// Hostname is the hostname argument value.
+			Hostname string
+		}
 		// Editor holds details about calls to the Editor method.
 		Editor []struct {
 			// Hostname is the hostname argument value.
 			Hostname string
 		}
+		// Filters holds details about calls to the Filters method.
+		Filters []struct {
+		}
 		// GetOrDefault holds details about calls to the GetOrDefault method.
 		GetOrDefault []struct {
 			// Hostname is the hostname argument value.
@@ -198,6 +256,9 @@ type ConfigMock struct {
 			// Migration is the migration argument value.
 			Migration gh.Migration
 		}
+		// Notifications holds details about calls to the Notifications method.
+		Notifications []struct {
+		}
 		// Pager holds details about calls to the Pager method.
 		Pager []struct {
 			// Hostname is the hostname argument value.
@@ -227,9 +288,15 @@ type ConfigMock struct {
 			// Hostname is the hostname argument value.
 			Hostname string
 		}
+		// Theme holds details about calls to the Theme method.
+		Theme []struct {
+		}
 		// Version holds details about calls to the Version method.
 		Version []struct {
 		}
+		// WorkspaceAccounts holds details about calls to the WorkspaceAccounts method.
+		WorkspaceAccounts []struct {
+		}
 		// Write holds details about calls to the Write method.
 		Write []struct {
 		}
@@ -237,21 +304,28 @@ type ConfigMock struct {
 	lockAccessibleColors   sync.RWMutex
 	lockAccessiblePrompter sync.RWMutex
 	lockAliases            sync.RWMutex
+	lockApiSnippets        sync.RWMutex
 	lockAuthentication     sync.RWMutex
 	lockBrowser            sync.RWMutex
 	lockCacheDir           sync.RWMutex
 	lockColorLabels        sync.RWMutex
+	lockConfirmDestructive sync.RWMutex
+	lockDefaultWorkspace   sync.RWMutex
 	lockEditor             sync.RWMutex
+	lockFilters            sync.RWMutex
 	lockGetOrDefault       sync.RWMutex
 	lockGitProtocol        sync.RWMutex
 	lockHTTPUnixSocket     sync.RWMutex
 	lockMigrate            sync.RWMutex
+	lockNotifications      sync.RWMutex
 	lockPager              sync.RWMutex
 	lockPreferEditorPrompt sync.RWMutex
 	lockPrompt             sync.RWMutex
 	lockSet                sync.RWMutex
 	lockSpinner            sync.RWMutex
+	lockTheme              sync.RWMutex
 	lockVersion            sync.RWMutex
+	lockWorkspaceAccounts  sync.RWMutex
 	lockWrite              sync.RWMutex
 }
 
@@ -346,6 +420,33 @@ func (mock *ConfigMock) AliasesCalls() []struct {
 	return calls
 }
 
+// ApiSnippets calls ApiSnippetsFunc.
+func (mock *ConfigMock) ApiSnippets() gh.AliasConfig {
+	if mock.ApiSnippetsFunc == nil {
+		panic("ConfigMock.ApiSnippetsFunc: method is nil but Config.ApiSnippets was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockApiSnippets.Lock()
+	mock.calls.ApiSnippets = append(mock.calls.ApiSnippets, callInfo)
+	mock.lockApiSnippets.Unlock()
+	return mock.ApiSnippetsFunc()
+}
+
+// ApiSnippetsCalls gets all the calls that were made to ApiSnippets.
+// Check the length with:
+//
+//	len(mockedConfig.ApiSnippetsCalls())
+func (mock *ConfigMock) ApiSnippetsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockApiSnippets.RLock()
+	calls = mock.calls.ApiSnippets
+	mock.lockApiSnippets.RUnlock()
+	return calls
+}
+
 // Authentication calls AuthenticationFunc.
 func (mock *ConfigMock) Authentication() gh.AuthConfig {
 	if mock.AuthenticationFunc == nil {
@@ -464,6 +565,70 @@ func (mock *ConfigMock) ColorLabelsCalls() []struct {
 	return calls
 }
 
+// ConfirmDestructive calls ConfirmDestructiveFunc.
+func (mock *ConfigMock) ConfirmDestructive(hostname string) gh.ConfigEntry {
+	if mock.ConfirmDestructiveFunc == nil {
+		panic("ConfigMock.ConfirmDestructiveFunc: method is nil but Config.ConfirmDestructive was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockConfirmDestructive.Lock()
+	mock.calls.ConfirmDestructive = append(mock.calls.ConfirmDestructive, callInfo)
+	mock.lockConfirmDestructive.Unlock()
+	return mock.ConfirmDestructiveFunc(hostname)
+}
+
+// ConfirmDestructiveCalls gets all the calls that were made to ConfirmDestructive.
+// Check the length with:
+//
+//	len(mockedConfig.ConfirmDestructiveCalls())
+func (mock *ConfigMock) ConfirmDestructiveCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockConfirmDestructive.RLock()
+	calls = mock.calls.ConfirmDestructive
+	mock.lockConfirmDestructive.RUnlock()
+	return calls
+}
+
+// DefaultWorkspace calls DefaultWorkspaceFunc.
+func (mock *ConfigMock) DefaultWorkspace(hostname string) gh.ConfigEntry {
+	if mock.DefaultWorkspaceFunc == nil {
+		panic("ConfigMock.DefaultWorkspaceFunc: method is nil but Config.DefaultWorkspace was just called")
+	}
+	callInfo := struct {
+		Hostname string
+	}{
+		Hostname: hostname,
+	}
+	mock.lockDefaultWorkspace.Lock()
+	mock.calls.DefaultWorkspace = append(mock.calls.DefaultWorkspace, callInfo)
+	mock.lockDefaultWorkspace.Unlock()
+	return mock.DefaultWorkspaceFunc(hostname)
+}
+
+// DefaultWorkspaceCalls gets all the calls that were made to DefaultWorkspace.
+// Check the length with:
+//
+//	len(mockedConfig.DefaultWorkspaceCalls())
+func (mock *ConfigMock) DefaultWorkspaceCalls() []struct {
+	Hostname string
+} {
+	var calls []struct {
+		Hostname string
+	}
+	mock.lockDefaultWorkspace.RLock()
+	calls = mock.calls.DefaultWorkspace
+	mock.lockDefaultWorkspace.RUnlock()
+	return calls
+}
+
 // Editor calls EditorFunc.
 func (mock *ConfigMock) Editor(hostname string) gh.ConfigEntry {
 	if mock.EditorFunc == nil {
@@ -496,6 +661,33 @@ func (mock *ConfigMock) EditorCalls() []struct {
 	return calls
 }
 
+// Filters calls FiltersFunc.
+func (mock *ConfigMock) Filters() gh.AliasConfig {
+	if mock.FiltersFunc == nil {
+		panic("ConfigMock.FiltersFunc: method is nil but Config.Filters was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockFilters.Lock()
+	mock.calls.Filters = append(mock.calls.Filters, callInfo)
+	mock.lockFilters.Unlock()
+	return mock.FiltersFunc()
+}
+
+// FiltersCalls gets all the calls that were made to Filters.
+// Check the length with:
+//
+//	len(mockedConfig.FiltersCalls())
+func (mock *ConfigMock) FiltersCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockFilters.RLock()
+	calls = mock.calls.Filters
+	mock.lockFilters.RUnlock()
+	return calls
+}
+
 // GetOrDefault calls GetOrDefaultFunc.
 func (mock *ConfigMock) GetOrDefault(hostname string, key string) o.Option[gh.ConfigEntry] {
 	if mock.GetOrDefaultFunc == nil {
@@ -628,6 +820,33 @@ func (mock *ConfigMock) MigrateCalls() []struct {
 	return calls
 }
 
+// Notifications calls NotificationsFunc.
+func (mock *ConfigMock) Notifications() gh.AliasConfig {
+	if mock.NotificationsFunc == nil {
+		panic("ConfigMock.NotificationsFunc: method is nil but Config.Notifications was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockNotifications.Lock()
+	mock.calls.Notifications = append(mock.calls.Notifications, callInfo)
+	mock.lockNotifications.Unlock()
+	return mock.NotificationsFunc()
+}
+
+// NotificationsCalls gets all the calls that were made to Notifications.
+// Check the length with:
+//
+//	len(mockedConfig.NotificationsCalls())
+func (mock *ConfigMock) NotificationsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockNotifications.RLock()
+	calls = mock.calls.Notifications
+	mock.lockNotifications.RUnlock()
+	return calls
+}
+
 // Pager calls PagerFunc.
 func (mock *ConfigMock) Pager(hostname string) gh.ConfigEntry {
 	if mock.PagerFunc == nil {
@@ -796,6 +1015,33 @@ func (mock *ConfigMock) SpinnerCalls() []struct {
 	return calls
 }
 
+// Theme calls ThemeFunc.
+func (mock *ConfigMock) Theme() map[string]string {
+	if mock.ThemeFunc == nil {
+		panic("ConfigMock.ThemeFunc: method is nil but Config.Theme was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockTheme.Lock()
+	mock.calls.Theme = append(mock.calls.Theme, callInfo)
+	mock.lockTheme.Unlock()
+	return mock.ThemeFunc()
+}
+
+// ThemeCalls gets all the calls that were made to Theme.
+// Check the length with:
+//
+//	len(mockedConfig.ThemeCalls())
+func (mock *ConfigMock) ThemeCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockTheme.RLock()
+	calls = mock.calls.Theme
+	mock.lockTheme.RUnlock()
+	return calls
+}
+
 // Version calls VersionFunc.
 func (mock *ConfigMock) Version() o.Option[string] {
 	if mock.VersionFunc == nil {
@@ -823,6 +1069,33 @@ func (mock *ConfigMock) VersionCalls() []struct {
 	return calls
 }
 
+// WorkspaceAccounts calls WorkspaceAccountsFunc.
+func (mock *ConfigMock) WorkspaceAccounts() gh.AliasConfig {
+	if mock.WorkspaceAccountsFunc == nil {
+		panic("ConfigMock.WorkspaceAccountsFunc: method is nil but Config.WorkspaceAccounts was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockWorkspaceAccounts.Lock()
+	mock.calls.WorkspaceAccounts = append(mock.calls.WorkspaceAccounts, callInfo)
+	mock.lockWorkspaceAccounts.Unlock()
+	return mock.WorkspaceAccountsFunc()
+}
+
+// WorkspaceAccountsCalls gets all the calls that were made to WorkspaceAccounts.
+// Check the length with:
+//
+//	len(mockedConfig.WorkspaceAccountsCalls())
+func (mock *ConfigMock) WorkspaceAccountsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockWorkspaceAccounts.RLock()
+	calls = mock.calls.WorkspaceAccounts
+	mock.lockWorkspaceAccounts.RUnlock()
+	return calls
+}
+
 // Write calls WriteFunc.
 func (mock *ConfigMock) Write() error {
 	if mock.WriteFunc == nil {
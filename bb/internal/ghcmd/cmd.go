@@ -19,6 +19,9 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/internal/build"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/config/migration"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/history"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/profile"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/update"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/factory"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/root"
@@ -47,6 +50,14 @@ func Main() exitCode {
 	cmdFactory := factory.New(buildVersion)
 	stderr := cmdFactory.IOStreams.ErrOut
 
+	// The report covers every exit path; phases are recorded regardless and
+	// only printed when --profile enabled reporting during flag parsing
+	defer func() {
+		if profile.Enabled() {
+			profile.Report(stderr)
+		}
+	}()
+
 	ctx := context.Background()
 
 	if cfg, err := cmdFactory.Config(); err == nil {
@@ -55,6 +66,9 @@ func Main() exitCode {
 			fmt.Fprintln(stderr, err)
 			return exitError
 		}
+		i18n.Init(cfg.GetOrDefault("", "locale").UnwrapOrZero().Value)
+	} else {
+		i18n.Init("")
 	}
 
 	updateCtx, updateCancel := context.WithCancel(ctx)
@@ -106,6 +120,9 @@ func Main() exitCode {
 		expandedArgs = append(expandedArgs, "--help")
 	}
 
+	// Record the invocation so history and redo can replay it
+	history.Record(expandedArgs)
+
 	rootCmd.SetArgs(expandedArgs)
 
 	if cmd, err := rootCmd.ExecuteContextC(ctx); err != nil {
@@ -0,0 +1,160 @@
+// Package history records the commands a user has run so they can be listed
+// and re-run. Entries live in the state directory; values of secret-bearing
+// flags are redacted before anything touches disk.
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+)
+
+// maxEntries bounds how many commands the history file keeps.
+const maxEntries = 50
+
+// Entry is one recorded invocation.
+type Entry struct {
+	Args []string  `json:"args"`
+	Time time.Time `json:"time"`
+}
+
+func historyFile() string {
+	return filepath.Join(config.StateDir(), "history.jsonl")
+}
+
+// Record appends an invocation to the history, trimming it to the newest
+// maxEntries. Recording is best-effort: a failure never disturbs the command
+// being run.
+func Record(args []string) {
+	if len(args) == 0 || !recordable(args[0]) {
+		return
+	}
+
+	entries, _ := List()
+	entries = append(entries, Entry{Args: Redact(args), Time: time.Now()})
+	if len(entries) > maxEntries {
+		entries = entries[len(entries)-maxEntries:]
+	}
+
+	path := historyFile()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+
+	var buf strings.Builder
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	_ = os.WriteFile(path, []byte(buf.String()), 0600)
+}
+
+// List returns the recorded invocations, oldest first.
+func List() ([]Entry, error) {
+	f, err := os.Open(historyFile())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// Skip lines a crashed write may have truncated
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// recordable reports whether a command belongs in the history. The history
+// and redo commands themselves do not, nor do shell helpers.
+func recordable(command string) bool {
+	switch command {
+	case "history", "redo", "completion", "help", "prompt-status":
+		return false
+	}
+	return true
+}
+
+// Redact replaces the values of secret-bearing flags so they never reach
+// disk. A flag counts as secret-bearing when its name mentions token,
+// password, or secret, or when it is the --body of a secret set. The api
+// command's field flags carry key=value pairs, so their values are also
+// redacted when the key looks secret-bearing, as in `api -f token=abc123`.
+func Redact(args []string) []string {
+	redacted := make([]string, len(args))
+	copy(redacted, args)
+
+	secretCommand := len(args) > 0 && args[0] == "secret"
+	apiCommand := len(args) > 0 && args[0] == "api"
+
+	for i, arg := range redacted {
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name, value, hasValue := strings.Cut(arg, "=")
+		if apiCommand && fieldFlag(name) {
+			if hasValue {
+				redacted[i] = name + "=" + redactField(value)
+			} else if i+1 < len(redacted) {
+				redacted[i+1] = redactField(redacted[i+1])
+			}
+			continue
+		}
+		if !secretFlag(name, secretCommand) {
+			continue
+		}
+		if hasValue {
+			redacted[i] = name + "=********"
+		} else if i+1 < len(redacted) {
+			redacted[i+1] = "********"
+		}
+	}
+	return redacted
+}
+
+// fieldFlag reports whether name is one of the api command's field flags,
+// whose values are key=value pairs rather than the secret itself.
+func fieldFlag(name string) bool {
+	switch strings.TrimLeft(name, "-") {
+	case "f", "field", "F", "raw-field":
+		return true
+	}
+	return false
+}
+
+// redactField masks the value side of a key=value api field when the key
+// looks secret-bearing.
+func redactField(field string) string {
+	key, _, ok := strings.Cut(field, "=")
+	if !ok || !secretFlag(key, false) {
+		return field
+	}
+	return key + "=********"
+}
+
+func secretFlag(name string, secretCommand bool) bool {
+	trimmed := strings.TrimLeft(name, "-")
+	if strings.Contains(trimmed, "token") ||
+		strings.Contains(trimmed, "password") ||
+		strings.Contains(trimmed, "secret") {
+		return true
+	}
+	return secretCommand && (trimmed == "body" || trimmed == "b")
+}
@@ -0,0 +1,76 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "plain command untouched",
+			args: []string{"pr", "list", "--state", "open"},
+			want: []string{"pr", "list", "--state", "open"},
+		},
+		{
+			name: "token value redacted",
+			args: []string{"auth", "login", "--with-token", "hunter2"},
+			want: []string{"auth", "login", "--with-token", "********"},
+		},
+		{
+			name: "inline token value redacted",
+			args: []string{"api", "user", "--app-password=hunter2"},
+			want: []string{"api", "user", "--app-password=********"},
+		},
+		{
+			name: "secret set body redacted",
+			args: []string{"secret", "set", "API_KEY", "--body", "hunter2"},
+			want: []string{"secret", "set", "API_KEY", "--body", "********"},
+		},
+		{
+			name: "body outside secret set untouched",
+			args: []string{"issue", "create", "--title", "t", "--body", "oops"},
+			want: []string{"issue", "create", "--title", "t", "--body", "oops"},
+		},
+		{
+			name: "api field with secret-bearing key redacted",
+			args: []string{"api", "/x", "-f", "token=abc123"},
+			want: []string{"api", "/x", "-f", "token=********"},
+		},
+		{
+			name: "inline api field with secret-bearing key redacted",
+			args: []string{"api", "/x", "--field=password=hunter2"},
+			want: []string{"api", "/x", "--field=password=********"},
+		},
+		{
+			name: "api field with ordinary key untouched",
+			args: []string{"api", "/x", "-F", "title=hello"},
+			want: []string{"api", "/x", "-F", "title=hello"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Redact(tt.args))
+		})
+	}
+}
+
+func TestRecordAndList(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	Record([]string{"pr", "list"})
+	Record([]string{"history"}) // must not be recorded
+	Record([]string{"issue", "list", "--limit", "5"})
+
+	entries, err := List()
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, []string{"pr", "list"}, entries[0].Args)
+	assert.Equal(t, []string{"issue", "list", "--limit", "5"}, entries[1].Args)
+}
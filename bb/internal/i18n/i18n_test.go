@@ -0,0 +1,29 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "es", normalize("es_ES.UTF-8"))
+	assert.Equal(t, "es", normalize("es"))
+	assert.Equal(t, "pt", normalize("pt_BR"))
+	assert.Equal(t, "en", normalize("C"))
+	assert.Equal(t, "en", normalize("POSIX"))
+	assert.Equal(t, "", normalize(""))
+}
+
+func TestT(t *testing.T) {
+	t.Cleanup(func() { Init("en") })
+
+	Init("es")
+	assert.Equal(t, "Pull request #7 fusionado", T("Merged pull request #%d", 7))
+	// Untranslated strings pass through unchanged
+	assert.Equal(t, "no catalog entry", T("no catalog entry"))
+
+	// Unknown locales fall back to English
+	Init("xx")
+	assert.Equal(t, "Merged pull request #3", T("Merged pull request #%d", 3))
+}
@@ -0,0 +1,146 @@
+// Package jira provides a minimal Jira Cloud REST client for linking
+// Bitbucket pull requests and branches to Jira issues.
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+)
+
+// Config keys holding the Jira Cloud connection settings. They are stored in
+// the bb config file, separate from the Bitbucket credentials, e.g.
+// `bb config set jira_base_url https://example.atlassian.net`.
+const (
+	// BaseURLConfigKey is the Jira Cloud site URL.
+	BaseURLConfigKey = "jira_base_url"
+	// EmailConfigKey is the Atlassian account email used for basic auth.
+	EmailConfigKey = "jira_email"
+	// APITokenConfigKey is an Atlassian API token created at
+	// https://id.atlassian.com/manage-profile/security/api-tokens.
+	APITokenConfigKey = "jira_api_token"
+)
+
+// issueKeyRE matches Jira issue keys such as PROJ-123. The leading pattern
+// keeps lowercase words like "pre-1" from matching.
+var issueKeyRE = regexp.MustCompile(`(^|[^A-Z0-9])([A-Z][A-Z0-9]+-[1-9][0-9]*)`)
+
+// FindIssueKey returns the first Jira issue key found in the candidates,
+// checked in order, or "" when none contains one.
+func FindIssueKey(candidates ...string) string {
+	for _, candidate := range candidates {
+		if m := issueKeyRE.FindStringSubmatch(candidate); m != nil {
+			return m[2]
+		}
+	}
+	return ""
+}
+
+// Issue is the subset of a Jira issue displayed by bb.
+type Issue struct {
+	Key      string
+	Summary  string
+	Status   string
+	Assignee string
+	URL      string
+}
+
+// Client talks to a Jira Cloud site using an email and API token.
+type Client struct {
+	http    *http.Client
+	baseURL string
+	email   string
+	token   string
+}
+
+// NewClientFromConfig builds a Client from the jira_* config keys. It returns
+// an error describing how to configure the connection when any key is unset.
+func NewClientFromConfig(httpClient *http.Client, cfg gh.Config) (*Client, error) {
+	baseURL := configValue(cfg, BaseURLConfigKey)
+	email := configValue(cfg, EmailConfigKey)
+	token := configValue(cfg, APITokenConfigKey)
+
+	if baseURL == "" || email == "" || token == "" {
+		return nil, fmt.Errorf(
+			"Jira is not configured; set it up with:\n"+
+				"  bb config set %s https://<site>.atlassian.net\n"+
+				"  bb config set %s <email>\n"+
+				"  bb config set %s <api-token>",
+			BaseURLConfigKey, EmailConfigKey, APITokenConfigKey)
+	}
+
+	return &Client{
+		http:    httpClient,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		email:   email,
+		token:   token,
+	}, nil
+}
+
+func configValue(cfg gh.Config, key string) string {
+	if entry, ok := cfg.GetOrDefault("", key).Value(); ok {
+		return strings.TrimSpace(entry.Value)
+	}
+	return ""
+}
+
+// Issue fetches the summary, status, and assignee of the given issue key.
+func (c *Client) Issue(key string) (*Issue, error) {
+	url := fmt.Sprintf("%s/rest/api/3/issue/%s?fields=summary,status,assignee", c.baseURL, key)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.email, c.token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// fall through to decoding
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("Jira issue %s not found", key)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("Jira rejected the credentials; check %s and %s", EmailConfigKey, APITokenConfigKey)
+	default:
+		return nil, fmt.Errorf("failed to fetch Jira issue %s (HTTP %d)", key, resp.StatusCode)
+	}
+
+	var payload struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary string `json:"summary"`
+			Status  struct {
+				Name string `json:"name"`
+			} `json:"status"`
+			Assignee *struct {
+				DisplayName string `json:"displayName"`
+			} `json:"assignee"`
+		} `json:"fields"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		Key:     payload.Key,
+		Summary: payload.Fields.Summary,
+		Status:  payload.Fields.Status.Name,
+		URL:     fmt.Sprintf("%s/browse/%s", c.baseURL, payload.Key),
+	}
+	if payload.Fields.Assignee != nil {
+		issue.Assignee = payload.Fields.Assignee.DisplayName
+	}
+
+	return issue, nil
+}
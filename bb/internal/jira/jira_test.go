@@ -0,0 +1,122 @@
+package jira
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/httpmock"
+)
+
+func TestFindIssueKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []string
+		want       string
+	}{
+		{
+			name:       "key in title",
+			candidates: []string{"PROJ-123: add login page"},
+			want:       "PROJ-123",
+		},
+		{
+			name:       "key in branch name",
+			candidates: []string{"Add login page", "feature/PROJ-123-add-login"},
+			want:       "PROJ-123",
+		},
+		{
+			name:       "first candidate wins",
+			candidates: []string{"ABC-1 first", "XYZ-2 second"},
+			want:       "ABC-1",
+		},
+		{
+			name:       "key with digits in project",
+			candidates: []string{"[P2X-42] fix things"},
+			want:       "P2X-42",
+		},
+		{
+			name:       "lowercase is not a key",
+			candidates: []string{"fix pre-1 release notes"},
+			want:       "",
+		},
+		{
+			name:       "no key",
+			candidates: []string{"add login page", "feature/add-login"},
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindIssueKey(tt.candidates...); got != tt.want {
+				t.Errorf("FindIssueKey(%v) = %q, want %q", tt.candidates, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssue(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "rest/api/3/issue/PROJ-123"),
+		httpmock.StringResponse(`{
+			"key": "PROJ-123",
+			"fields": {
+				"summary": "Add login page",
+				"status": {"name": "In Progress"},
+				"assignee": {"displayName": "Mona Lisa"}
+			}
+		}`),
+	)
+
+	client := &Client{
+		http:    &http.Client{Transport: reg},
+		baseURL: "https://example.atlassian.net",
+		email:   "mona@example.com",
+		token:   "token",
+	}
+
+	issue, err := client.Issue("PROJ-123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if issue.Key != "PROJ-123" {
+		t.Errorf("Key = %q, want %q", issue.Key, "PROJ-123")
+	}
+	if issue.Summary != "Add login page" {
+		t.Errorf("Summary = %q, want %q", issue.Summary, "Add login page")
+	}
+	if issue.Status != "In Progress" {
+		t.Errorf("Status = %q, want %q", issue.Status, "In Progress")
+	}
+	if issue.Assignee != "Mona Lisa" {
+		t.Errorf("Assignee = %q, want %q", issue.Assignee, "Mona Lisa")
+	}
+	if issue.URL != "https://example.atlassian.net/browse/PROJ-123" {
+		t.Errorf("URL = %q", issue.URL)
+	}
+}
+
+func TestIssueNotFound(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "rest/api/3/issue/PROJ-404"),
+		httpmock.StatusStringResponse(404, `{"errorMessages": ["Issue does not exist"]}`),
+	)
+
+	client := &Client{
+		http:    &http.Client{Transport: reg},
+		baseURL: "https://example.atlassian.net",
+		email:   "mona@example.com",
+		token:   "token",
+	}
+
+	_, err := client.Issue("PROJ-404")
+	if err == nil || err.Error() != "Jira issue PROJ-404 not found" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+// Package membercache caches workspace member lists on disk so that reviewer
+// and assignee lookups do not hit the members API on every invocation. Each
+// workspace gets one cache file that expires after TTL.
+package membercache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+)
+
+// TTL is how long a cached member list stays fresh. Workspace membership
+// changes rarely enough that a day-old list is fine for name resolution.
+const TTL = 24 * time.Hour
+
+// Member is the subset of a workspace member used for user resolution.
+type Member struct {
+	UUID        string `json:"uuid"`
+	Nickname    string `json:"nickname"`
+	DisplayName string `json:"display_name"`
+	AccountID   string `json:"account_id"`
+}
+
+type cacheFile struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Members   []Member  `json:"members"`
+}
+
+// Cache reads and writes per-workspace member lists under the bb cache
+// directory.
+type Cache struct {
+	dir string
+	now func() time.Time
+}
+
+func New() *Cache {
+	return &Cache{
+		dir: filepath.Join(config.CacheDir(), "members"),
+		now: time.Now,
+	}
+}
+
+func (c *Cache) path(hostname, workspace string) string {
+	return filepath.Join(c.dir, hostname, workspace+".json")
+}
+
+// Get returns the cached member list for a workspace while it is still fresh.
+func (c *Cache) Get(hostname, workspace string) ([]Member, bool) {
+	data, err := os.ReadFile(c.path(hostname, workspace))
+	if err != nil {
+		return nil, false
+	}
+
+	var f cacheFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, false
+	}
+	if c.now().Sub(f.FetchedAt) > TTL {
+		return nil, false
+	}
+
+	return f.Members, true
+}
+
+// Set stores the member list for a workspace.
+func (c *Cache) Set(hostname, workspace string, members []Member) error {
+	path := c.path(hostname, workspace)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cacheFile{FetchedAt: c.now(), Members: members})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// Members returns the member list for a workspace, from the cache while it is
+// fresh and from the members API otherwise. Fetched lists are cached
+// best-effort; a cache write failure does not fail the lookup.
+func Members(client *api.Client, hostname, workspace string) ([]Member, error) {
+	cache := New()
+	if members, ok := cache.Get(hostname, workspace); ok {
+		return members, nil
+	}
+
+	members, err := fetchMembers(client, hostname, workspace)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(hostname, workspace, members)
+
+	return members, nil
+}
+
+// Refresh fetches the member list for a workspace and stores it, replacing
+// any cached copy regardless of freshness.
+func Refresh(client *api.Client, hostname, workspace string) ([]Member, error) {
+	members, err := fetchMembers(client, hostname, workspace)
+	if err != nil {
+		return nil, err
+	}
+	if err := New().Set(hostname, workspace, members); err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// Match finds a member by nickname, display name, or account ID, ignoring
+// case. It returns nil when no member matches.
+func Match(members []Member, selector string) *Member {
+	for i := range members {
+		m := &members[i]
+		if strings.EqualFold(m.Nickname, selector) ||
+			strings.EqualFold(m.DisplayName, selector) ||
+			m.AccountID == selector {
+			return m
+		}
+	}
+	return nil
+}
+
+func fetchMembers(client *api.Client, hostname, workspace string) ([]Member, error) {
+	var members []Member
+
+	path := fmt.Sprintf("workspaces/%s/members?pagelen=100", workspace)
+	nextURL := ""
+	for {
+		var page struct {
+			Values []struct {
+				User Member `json:"user"`
+			} `json:"values"`
+			Next string `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = client.Get(hostname, path, &page)
+		} else {
+			err = client.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Values {
+			members = append(members, v.User)
+		}
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return members, nil
+}
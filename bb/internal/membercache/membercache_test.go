@@ -0,0 +1,71 @@
+package membercache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheRoundTrip(t *testing.T) {
+	now := time.Now()
+	cache := &Cache{
+		dir: filepath.Join(t.TempDir(), "members"),
+		now: func() time.Time { return now },
+	}
+
+	_, ok := cache.Get("bitbucket.org", "myworkspace")
+	assert.False(t, ok)
+
+	members := []Member{
+		{UUID: "{u1}", Nickname: "alice", DisplayName: "Alice Smith"},
+		{UUID: "{u2}", Nickname: "bob", DisplayName: "Bob Jones"},
+	}
+	require.NoError(t, cache.Set("bitbucket.org", "myworkspace", members))
+
+	got, ok := cache.Get("bitbucket.org", "myworkspace")
+	assert.True(t, ok)
+	assert.Equal(t, members, got)
+
+	// a different workspace does not share entries
+	_, ok = cache.Get("bitbucket.org", "otherworkspace")
+	assert.False(t, ok)
+
+	// entries expire after the TTL
+	now = now.Add(TTL + time.Minute)
+	_, ok = cache.Get("bitbucket.org", "myworkspace")
+	assert.False(t, ok)
+}
+
+func TestMatch(t *testing.T) {
+	members := []Member{
+		{UUID: "{u1}", Nickname: "alice", DisplayName: "Alice Smith", AccountID: "123abc"},
+		{UUID: "{u2}", Nickname: "bob", DisplayName: "Bob Jones"},
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		wantUUID string
+	}{
+		{name: "by nickname", selector: "alice", wantUUID: "{u1}"},
+		{name: "nickname ignores case", selector: "Bob", wantUUID: "{u2}"},
+		{name: "by display name", selector: "Alice Smith", wantUUID: "{u1}"},
+		{name: "by account ID", selector: "123abc", wantUUID: "{u1}"},
+		{name: "no match", selector: "carol", wantUUID: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := Match(members, tt.selector)
+			if tt.wantUUID == "" {
+				assert.Nil(t, m)
+				return
+			}
+			require.NotNil(t, m)
+			assert.Equal(t, tt.wantUUID, m.UUID)
+		})
+	}
+}
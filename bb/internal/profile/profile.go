@@ -0,0 +1,98 @@
+// Package profile collects coarse phase timings (config load, auth, API
+// calls, rendering) for the --profile flag. Phases are recorded
+// unconditionally — the cost is an appended slice entry — and only reported
+// when profiling was enabled, since some phases run before flag parsing.
+package profile
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Span is one timed phase of a command run.
+type Span struct {
+	Name     string
+	Duration time.Duration
+}
+
+var (
+	mu           sync.Mutex
+	enabled      bool
+	spans        []Span
+	processStart = time.Now()
+)
+
+// Enable turns on reporting; called when the --profile flag is seen.
+func Enable() {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = true
+}
+
+// Enabled reports whether --profile was given.
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return enabled
+}
+
+// Record adds a completed phase.
+func Record(name string, d time.Duration) {
+	mu.Lock()
+	defer mu.Unlock()
+	spans = append(spans, Span{Name: name, Duration: d})
+}
+
+// Track starts timing a phase and returns the function that completes it,
+// for use as `defer profile.Track("config load")()`.
+func Track(name string) func() {
+	started := time.Now()
+	return func() {
+		Record(name, time.Since(started))
+	}
+}
+
+// Report writes the recorded phases in order, followed by the total wall
+// time of the process so far.
+func Report(w io.Writer) {
+	mu.Lock()
+	recorded := make([]Span, len(spans))
+	copy(recorded, spans)
+	mu.Unlock()
+
+	width := len("total")
+	for _, span := range recorded {
+		if len(span.Name) > width {
+			width = len(span.Name)
+		}
+	}
+
+	fmt.Fprintln(w, "\nProfile:")
+	for _, span := range recorded {
+		fmt.Fprintf(w, "  %-*s  %s\n", width, span.Name, formatDuration(span.Duration))
+	}
+	fmt.Fprintf(w, "  %-*s  %s\n", width, "total", formatDuration(time.Since(processStart)))
+}
+
+// formatDuration rounds to a precision that keeps the report readable.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(10 * time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(100 * time.Microsecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
+
+// Reset clears the recorded state; used by tests.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	enabled = false
+	spans = nil
+	processStart = time.Now()
+}
@@ -0,0 +1,63 @@
+package profile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReport(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	Record("config load", 2100*time.Microsecond)
+	Record("api GET /2.0/repositories", 210*time.Millisecond)
+	Record("render markdown", 250*time.Microsecond)
+
+	buf := &bytes.Buffer{}
+	Report(buf)
+	out := buf.String()
+
+	for _, want := range []string{
+		"Profile:",
+		"config load",
+		"2.1ms",
+		"api GET /2.0/repositories",
+		"210ms",
+		"render markdown",
+		"250µs",
+		"total",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("report missing %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestEnable(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	if Enabled() {
+		t.Error("expected profiling to start disabled")
+	}
+	Enable()
+	if !Enabled() {
+		t.Error("expected profiling to be enabled")
+	}
+}
+
+func TestTrack(t *testing.T) {
+	Reset()
+	defer Reset()
+
+	stop := Track("phase")
+	stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(spans) != 1 || spans[0].Name != "phase" {
+		t.Errorf("unexpected spans: %v", spans)
+	}
+}
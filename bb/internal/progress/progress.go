@@ -0,0 +1,75 @@
+// Package progress emits machine-readable NDJSON progress events for long
+// running commands, so wrappers and IDE integrations can render their own
+// progress UIs instead of parsing human-oriented output.
+package progress
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Event is one NDJSON progress record.
+type Event struct {
+	Time      string `json:"time"`
+	Operation string `json:"operation"`
+	Status    string `json:"status"` // start, progress, done, or error
+	Item      string `json:"item,omitempty"`
+	Current   int    `json:"current,omitempty"`
+	Total     int    `json:"total,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// Emitter writes progress events for one operation, one JSON object per
+// line. A nil Emitter discards every event, so callers can emit
+// unconditionally and only construct an Emitter when the mode is enabled.
+type Emitter struct {
+	w         io.Writer
+	operation string
+	now       func() time.Time
+
+	mu sync.Mutex
+}
+
+// New returns an Emitter writing events for operation to w.
+func New(w io.Writer, operation string) *Emitter {
+	return &Emitter{w: w, operation: operation, now: time.Now}
+}
+
+func (e *Emitter) emit(ev Event) {
+	if e == nil {
+		return
+	}
+	ev.Time = e.now().UTC().Format(time.RFC3339)
+	ev.Operation = e.operation
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+	data = append(data, '\n')
+	_, _ = e.w.Write(data)
+}
+
+// Start announces the operation and how many items it covers.
+func (e *Emitter) Start(total int) {
+	e.emit(Event{Status: "start", Total: total})
+}
+
+// Progress records that one item finished; current counts finished items.
+func (e *Emitter) Progress(item string, current, total int) {
+	e.emit(Event{Status: "progress", Item: item, Current: current, Total: total})
+}
+
+// Error records that one item failed.
+func (e *Emitter) Error(item, message string) {
+	e.emit(Event{Status: "error", Item: item, Message: message})
+}
+
+// Done announces that the operation finished.
+func (e *Emitter) Done(message string) {
+	e.emit(Event{Status: "done", Message: message})
+}
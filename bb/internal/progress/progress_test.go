@@ -0,0 +1,37 @@
+package progress
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmitterWritesNDJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	e := New(buf, "foreach")
+	e.now = func() time.Time { return time.Date(2026, 8, 29, 12, 0, 0, 0, time.UTC) }
+
+	e.Start(2)
+	e.Progress("ws/repo-a", 1, 2)
+	e.Error("ws/repo-b", "exit status 1")
+	e.Done("ran against 2 repositories")
+
+	want := `{"time":"2026-08-29T12:00:00Z","operation":"foreach","status":"start","total":2}
+{"time":"2026-08-29T12:00:00Z","operation":"foreach","status":"progress","item":"ws/repo-a","current":1,"total":2}
+{"time":"2026-08-29T12:00:00Z","operation":"foreach","status":"error","item":"ws/repo-b","message":"exit status 1"}
+{"time":"2026-08-29T12:00:00Z","operation":"foreach","status":"done","message":"ran against 2 repositories"}
+`
+	assert.Equal(t, want, buf.String())
+}
+
+func TestNilEmitterDiscardsEvents(t *testing.T) {
+	var e *Emitter
+
+	// must not panic
+	e.Start(1)
+	e.Progress("item", 1, 1)
+	e.Error("item", "boom")
+	e.Done("finished")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/huh"
 	ghPrompter "github.com/cli/go-gh/v2/pkg/prompter"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/ghinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/surveyext"
 )
@@ -44,22 +45,55 @@ type Prompter interface {
 }
 
 func New(editorCmd string, io *iostreams.IOStreams) Prompter {
+	var p Prompter
 	if io.AccessiblePrompterEnabled() {
-		return &accessiblePrompter{
+		p = &accessiblePrompter{
+			stdin:     io.In,
+			stdout:    io.Out,
+			stderr:    io.ErrOut,
+			editorCmd: editorCmd,
+		}
+	} else {
+		p = &surveyPrompter{
+			prompter:  ghPrompter.New(io.In, io.Out, io.ErrOut),
 			stdin:     io.In,
 			stdout:    io.Out,
 			stderr:    io.ErrOut,
 			editorCmd: editorCmd,
 		}
 	}
+	return &translatingPrompter{Prompter: p}
+}
 
-	return &surveyPrompter{
-		prompter:  ghPrompter.New(io.In, io.Out, io.ErrOut),
-		stdin:     io.In,
-		stdout:    io.Out,
-		stderr:    io.ErrOut,
-		editorCmd: editorCmd,
-	}
+// translatingPrompter routes every prompt message through the i18n catalog,
+// so individual commands keep passing plain English strings and translation
+// happens in one place for both prompter implementations.
+type translatingPrompter struct {
+	Prompter
+}
+
+func (p *translatingPrompter) Select(prompt, defaultValue string, options []string) (int, error) {
+	return p.Prompter.Select(i18n.T(prompt), defaultValue, options)
+}
+
+func (p *translatingPrompter) MultiSelect(prompt string, defaults, options []string) ([]int, error) {
+	return p.Prompter.MultiSelect(i18n.T(prompt), defaults, options)
+}
+
+func (p *translatingPrompter) Input(prompt, defaultValue string) (string, error) {
+	return p.Prompter.Input(i18n.T(prompt), defaultValue)
+}
+
+func (p *translatingPrompter) Password(prompt string) (string, error) {
+	return p.Prompter.Password(i18n.T(prompt))
+}
+
+func (p *translatingPrompter) Confirm(prompt string, defaultValue bool) (bool, error) {
+	return p.Prompter.Confirm(i18n.T(prompt), defaultValue)
+}
+
+func (p *translatingPrompter) MarkdownEditor(prompt, defaultValue string, blankAllowed bool) (string, error) {
+	return p.Prompter.MarkdownEditor(i18n.T(prompt), defaultValue, blankAllowed)
 }
 
 type accessiblePrompter struct {
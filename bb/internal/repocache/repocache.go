@@ -0,0 +1,144 @@
+// Package repocache caches workspace repository lists on disk so that
+// commands fanning out across a whole workspace do not page through the
+// repositories API on every invocation. Each workspace gets one cache file
+// that expires after TTL.
+package repocache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+)
+
+// TTL is how long a cached repository list stays fresh. Repositories come and
+// go more often than workspace members, so the list expires after an hour.
+const TTL = time.Hour
+
+// Repo is the subset of a repository used for workspace fan-out.
+type Repo struct {
+	Slug     string `json:"slug"`
+	FullName string `json:"full_name"`
+}
+
+type cacheFile struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	Repos     []Repo    `json:"repos"`
+}
+
+// Cache reads and writes per-workspace repository lists under the bb cache
+// directory.
+type Cache struct {
+	dir string
+	now func() time.Time
+}
+
+func New() *Cache {
+	return &Cache{
+		dir: filepath.Join(config.CacheDir(), "repos"),
+		now: time.Now,
+	}
+}
+
+func (c *Cache) path(hostname, workspace string) string {
+	return filepath.Join(c.dir, hostname, workspace+".json")
+}
+
+// Get returns the cached repository list for a workspace while it is still
+// fresh.
+func (c *Cache) Get(hostname, workspace string) ([]Repo, bool) {
+	data, err := os.ReadFile(c.path(hostname, workspace))
+	if err != nil {
+		return nil, false
+	}
+
+	var f cacheFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, false
+	}
+	if c.now().Sub(f.FetchedAt) > TTL {
+		return nil, false
+	}
+
+	return f.Repos, true
+}
+
+// Set stores the repository list for a workspace.
+func (c *Cache) Set(hostname, workspace string, repos []Repo) error {
+	path := c.path(hostname, workspace)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cacheFile{FetchedAt: c.now(), Repos: repos})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// Repos returns the repository list for a workspace, from the cache while it
+// is fresh and from the repositories API otherwise. Fetched lists are cached
+// best-effort; a cache write failure does not fail the lookup.
+func Repos(client *api.Client, hostname, workspace string) ([]Repo, error) {
+	cache := New()
+	if repos, ok := cache.Get(hostname, workspace); ok {
+		return repos, nil
+	}
+
+	repos, err := fetchRepos(client, hostname, workspace)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Set(hostname, workspace, repos)
+
+	return repos, nil
+}
+
+// Refresh fetches the repository list for a workspace and stores it,
+// replacing any cached copy regardless of freshness.
+func Refresh(client *api.Client, hostname, workspace string) ([]Repo, error) {
+	repos, err := fetchRepos(client, hostname, workspace)
+	if err != nil {
+		return nil, err
+	}
+	if err := New().Set(hostname, workspace, repos); err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+func fetchRepos(client *api.Client, hostname, workspace string) ([]Repo, error) {
+	var repos []Repo
+
+	path := fmt.Sprintf("repositories/%s?pagelen=100&fields=next,values.slug,values.full_name", workspace)
+	nextURL := ""
+	for {
+		var page struct {
+			Values []Repo `json:"values"`
+			Next   string `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = client.Get(hostname, path, &page)
+		} else {
+			err = client.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		repos = append(repos, page.Values...)
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return repos, nil
+}
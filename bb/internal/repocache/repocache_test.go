@@ -0,0 +1,40 @@
+package repocache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheRoundTrip(t *testing.T) {
+	now := time.Now()
+	cache := &Cache{
+		dir: filepath.Join(t.TempDir(), "repos"),
+		now: func() time.Time { return now },
+	}
+
+	_, ok := cache.Get("bitbucket.org", "myworkspace")
+	assert.False(t, ok)
+
+	repos := []Repo{
+		{Slug: "backend", FullName: "myworkspace/backend"},
+		{Slug: "frontend", FullName: "myworkspace/frontend"},
+	}
+	require.NoError(t, cache.Set("bitbucket.org", "myworkspace", repos))
+
+	got, ok := cache.Get("bitbucket.org", "myworkspace")
+	assert.True(t, ok)
+	assert.Equal(t, repos, got)
+
+	// a different workspace does not share entries
+	_, ok = cache.Get("bitbucket.org", "otherworkspace")
+	assert.False(t, ok)
+
+	// entries expire after the TTL
+	now = now.Add(TTL + time.Minute)
+	_, ok = cache.Get("bitbucket.org", "myworkspace")
+	assert.False(t, ok)
+}
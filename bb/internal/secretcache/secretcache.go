@@ -0,0 +1,150 @@
+// Package secretcache stores pipeline secret values in a local GPG-encrypted
+// cache. Bitbucket never returns secured variable values, so the cache is the
+// only way to repopulate the secrets of a recreated repository.
+package secretcache
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+)
+
+// GPGKeyConfigKey is the config key naming the GPG key that cache entries are
+// encrypted to, e.g. `bb config set secret_cache_gpg_key me@example.com`.
+const GPGKeyConfigKey = "secret_cache_gpg_key"
+
+// repoDir is the per-repository directory holding repository-level secrets;
+// environment-level secrets live in "env-<environment>" siblings.
+const repoDir = "_repo"
+
+// Cache encrypts and decrypts secret values with the gpg binary, one file per
+// secret under the bb data directory.
+type Cache struct {
+	dir       string
+	recipient string
+}
+
+// NewFromConfig builds a Cache from the secret_cache_gpg_key config key. It
+// returns an error describing how to configure the cache when the key is
+// unset.
+func NewFromConfig(cfg gh.Config) (*Cache, error) {
+	recipient := ""
+	if entry, ok := cfg.GetOrDefault("", GPGKeyConfigKey).Value(); ok {
+		recipient = strings.TrimSpace(entry.Value)
+	}
+	if recipient == "" {
+		return nil, fmt.Errorf(
+			"the secret cache is not configured; set the GPG key to encrypt to with:\n"+
+				"  bb config set %s <key-id or email>", GPGKeyConfigKey)
+	}
+
+	return &Cache{
+		dir:       filepath.Join(config.DataDir(), "secret-cache"),
+		recipient: recipient,
+	}, nil
+}
+
+// Entry identifies one cached secret.
+type Entry struct {
+	Name        string
+	Environment string // empty for repository-level secrets
+
+	path string
+}
+
+// Put encrypts value and stores it for the given repository and secret name.
+// An empty environment means a repository-level secret.
+func (c *Cache) Put(repo bbrepo.Interface, environment, name, value string) error {
+	dir := filepath.Join(c.repoPath(repo), envDir(environment))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("gpg", "--batch", "--yes", "--quiet",
+		"--encrypt", "--recipient", c.recipient,
+		"--output", filepath.Join(dir, name+".gpg"))
+	cmd.Stdin = strings.NewReader(value)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return gpgError("encrypt", err, stderr.String())
+	}
+	return nil
+}
+
+// Entries lists the cached secrets of a repository.
+func (c *Cache) Entries(repo bbrepo.Interface) ([]Entry, error) {
+	root := c.repoPath(repo)
+	dirs, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		environment := ""
+		if d.Name() != repoDir {
+			environment = strings.TrimPrefix(d.Name(), "env-")
+		}
+
+		files, err := os.ReadDir(filepath.Join(root, d.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			name, ok := strings.CutSuffix(f.Name(), ".gpg")
+			if !ok {
+				continue
+			}
+			entries = append(entries, Entry{
+				Name:        name,
+				Environment: environment,
+				path:        filepath.Join(root, d.Name(), f.Name()),
+			})
+		}
+	}
+	return entries, nil
+}
+
+// Decrypt returns the plaintext value of a cached secret.
+func (c *Cache) Decrypt(entry Entry) (string, error) {
+	cmd := exec.Command("gpg", "--batch", "--quiet", "--decrypt", entry.path)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", gpgError("decrypt", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+func (c *Cache) repoPath(repo bbrepo.Interface) string {
+	return filepath.Join(c.dir, repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug())
+}
+
+func envDir(environment string) string {
+	if environment == "" {
+		return repoDir
+	}
+	return "env-" + environment
+}
+
+func gpgError(action string, err error, stderr string) error {
+	if stderr = strings.TrimSpace(stderr); stderr != "" {
+		return fmt.Errorf("gpg failed to %s: %s", action, stderr)
+	}
+	return fmt.Errorf("gpg failed to %s: %w", action, err)
+}
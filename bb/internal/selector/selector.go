@@ -0,0 +1,95 @@
+// Package selector resolves the arguments that pr, issue, and pipeline
+// commands accept for picking out a single resource: a plain number, a full
+// web URL (bitbucket.org or self-hosted), or a branch name.
+package selector
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+)
+
+// Selector is the parsed form of a resource argument. Exactly one of Number
+// or Branch is meaningful; Repo is only set when the argument was a URL.
+type Selector struct {
+	Number int
+	Branch string
+	Repo   bbrepo.Interface
+}
+
+// Kind describes one resource type's URL shapes and error wording.
+type Kind struct {
+	noun     string
+	patterns []*regexp.Regexp
+}
+
+// Each pattern captures host, workspace (or project key), repository slug,
+// and number, in that order.
+var (
+	PullRequest = Kind{
+		noun: "pull request",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/pull-requests/(\d+)`),
+			// Self-hosted Bitbucket URL shape
+			regexp.MustCompile(`^https?://([^/]+)/projects/([^/]+)/repos/([^/]+)/pull-requests/(\d+)`),
+		},
+	}
+	Issue = Kind{
+		noun: "issue",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/issues/(\d+)`),
+		},
+	}
+	Pipeline = Kind{
+		noun: "pipeline",
+		patterns: []*regexp.Regexp{
+			regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/addon/pipelines/home#!/results/(\d+)`),
+			// Newer web UI shape
+			regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/pipelines/results/(\d+)`),
+		},
+	}
+)
+
+// Parse resolves an argument into a Selector. Numbers and matching URLs
+// produce a Number (with Repo set for URLs); any other non-URL string is
+// treated as a branch name.
+func (k Kind) Parse(arg string) (Selector, error) {
+	if num, err := strconv.Atoi(arg); err == nil {
+		return Selector{Number: num}, nil
+	}
+
+	if strings.HasPrefix(arg, "http://") || strings.HasPrefix(arg, "https://") {
+		for _, re := range k.patterns {
+			matches := re.FindStringSubmatch(arg)
+			if matches == nil {
+				continue
+			}
+			num, _ := strconv.Atoi(matches[4])
+			repo := bbrepo.NewWithHost(matches[2], matches[3], matches[1])
+			return Selector{Number: num, Repo: repo}, nil
+		}
+		return Selector{}, fmt.Errorf("invalid %s argument: %s", k.noun, arg)
+	}
+
+	if arg == "" {
+		return Selector{}, fmt.Errorf("invalid %s argument: %s", k.noun, arg)
+	}
+
+	return Selector{Branch: arg}, nil
+}
+
+// ParseNumber is Parse restricted to numbers and URLs, for commands that
+// cannot resolve a branch name to a resource.
+func (k Kind) ParseNumber(arg string) (int, bbrepo.Interface, error) {
+	sel, err := k.Parse(arg)
+	if err != nil {
+		return 0, nil, err
+	}
+	if sel.Branch != "" {
+		return 0, nil, fmt.Errorf("invalid %s argument: %s", k.noun, arg)
+	}
+	return sel.Number, sel.Repo, nil
+}
@@ -0,0 +1,126 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       Kind
+		arg        string
+		wantNum    int
+		wantBranch string
+		wantRepo   bool
+		wantHost   string
+		wantWS     string
+		wantSlug   string
+		wantErrMsg string
+	}{
+		{
+			name:    "number",
+			kind:    PullRequest,
+			arg:     "123",
+			wantNum: 123,
+		},
+		{
+			name:     "pull request URL",
+			kind:     PullRequest,
+			arg:      "https://bitbucket.org/myworkspace/myrepo/pull-requests/456",
+			wantNum:  456,
+			wantRepo: true,
+			wantHost: "bitbucket.org",
+			wantWS:   "myworkspace",
+			wantSlug: "myrepo",
+		},
+		{
+			name:     "self-hosted pull request URL",
+			kind:     PullRequest,
+			arg:      "https://bb.example.com/projects/TEAM/repos/project/pull-requests/789",
+			wantNum:  789,
+			wantRepo: true,
+			wantHost: "bb.example.com",
+			wantWS:   "TEAM",
+			wantSlug: "project",
+		},
+		{
+			name:     "issue URL",
+			kind:     Issue,
+			arg:      "https://bitbucket.org/myworkspace/myrepo/issues/42",
+			wantNum:  42,
+			wantRepo: true,
+			wantHost: "bitbucket.org",
+			wantWS:   "myworkspace",
+			wantSlug: "myrepo",
+		},
+		{
+			name:     "pipeline addon URL",
+			kind:     Pipeline,
+			arg:      "https://bitbucket.org/myworkspace/myrepo/addon/pipelines/home#!/results/7",
+			wantNum:  7,
+			wantRepo: true,
+			wantHost: "bitbucket.org",
+			wantWS:   "myworkspace",
+			wantSlug: "myrepo",
+		},
+		{
+			name:     "pipeline results URL",
+			kind:     Pipeline,
+			arg:      "https://bitbucket.org/myworkspace/myrepo/pipelines/results/8",
+			wantNum:  8,
+			wantRepo: true,
+			wantHost: "bitbucket.org",
+			wantWS:   "myworkspace",
+			wantSlug: "myrepo",
+		},
+		{
+			name:       "branch name",
+			kind:       PullRequest,
+			arg:        "feature/login",
+			wantBranch: "feature/login",
+		},
+		{
+			name:       "URL of the wrong shape",
+			kind:       PullRequest,
+			arg:        "https://bitbucket.org/myworkspace/myrepo/src/main",
+			wantErrMsg: "invalid pull request argument: https://bitbucket.org/myworkspace/myrepo/src/main",
+		},
+		{
+			name:       "empty",
+			kind:       Issue,
+			arg:        "",
+			wantErrMsg: "invalid issue argument: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := tt.kind.Parse(tt.arg)
+
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantNum, sel.Number)
+			assert.Equal(t, tt.wantBranch, sel.Branch)
+
+			if tt.wantRepo {
+				assert.NotNil(t, sel.Repo)
+				assert.Equal(t, tt.wantHost, sel.Repo.RepoHost())
+				assert.Equal(t, tt.wantWS, sel.Repo.RepoWorkspace())
+				assert.Equal(t, tt.wantSlug, sel.Repo.RepoSlug())
+			} else {
+				assert.Nil(t, sel.Repo)
+			}
+		})
+	}
+}
+
+func TestParseNumberRejectsBranches(t *testing.T) {
+	_, _, err := PullRequest.ParseNumber("not-a-number")
+	assert.EqualError(t, err, "invalid pull request argument: not-a-number")
+}
@@ -0,0 +1,107 @@
+// Package snooze keeps local reminders that hide issues from listings until
+// a chosen time. Entries live in the state directory on this machine only;
+// nothing is sent to Bitbucket.
+package snooze
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+)
+
+// Entry is one snoozed issue.
+type Entry struct {
+	Repo  string    `json:"repo"` // "workspace/slug"
+	ID    int       `json:"id"`
+	Until time.Time `json:"until"`
+}
+
+func snoozeFile() string {
+	return filepath.Join(config.StateDir(), "snoozes.json")
+}
+
+// List returns the snoozes that have not yet expired, soonest first. Expired
+// entries are pruned from disk as a side effect, on a best-effort basis.
+func List() ([]Entry, error) {
+	data, err := os.ReadFile(snoozeFile())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var all []Entry
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := make([]Entry, 0, len(all))
+	for _, e := range all {
+		if e.Until.After(now) {
+			active = append(active, e)
+		}
+	}
+	if len(active) < len(all) {
+		_ = write(active)
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Until.Before(active[j].Until)
+	})
+	return active, nil
+}
+
+// Add snoozes an issue until the given time, replacing any existing snooze
+// for the same issue.
+func Add(repo string, id int, until time.Time) error {
+	entries, err := List()
+	if err != nil {
+		return err
+	}
+
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.Repo != repo || e.ID != id {
+			kept = append(kept, e)
+		}
+	}
+	kept = append(kept, Entry{Repo: repo, ID: id, Until: until})
+
+	return write(kept)
+}
+
+// Active returns the snoozed issue IDs of a repository and when each snooze
+// ends, for filtering listings. Lookups never fail: on any read problem the
+// result is simply empty and nothing is hidden.
+func Active(repo string) map[int]time.Time {
+	entries, err := List()
+	if err != nil {
+		return nil
+	}
+
+	active := map[int]time.Time{}
+	for _, e := range entries {
+		if e.Repo == repo {
+			active[e.ID] = e.Until
+		}
+	}
+	return active
+}
+
+func write(entries []Entry) error {
+	path := snoozeFile()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
@@ -0,0 +1,61 @@
+package snooze
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddListActive(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	future := time.Now().Add(24 * time.Hour)
+	require.NoError(t, Add("ws/repo", 42, future))
+	require.NoError(t, Add("ws/other", 7, future.Add(time.Hour)))
+
+	entries, err := List()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "ws/repo", entries[0].Repo)
+	assert.Equal(t, 42, entries[0].ID)
+
+	active := Active("ws/repo")
+	assert.Len(t, active, 1)
+	assert.Contains(t, active, 42)
+	assert.Empty(t, Active("ws/unknown"))
+}
+
+func TestAddReplacesExisting(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	require.NoError(t, Add("ws/repo", 42, time.Now().Add(time.Hour)))
+	later := time.Now().Add(48 * time.Hour)
+	require.NoError(t, Add("ws/repo", 42, later))
+
+	entries, err := List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.WithinDuration(t, later, entries[0].Until, time.Second)
+}
+
+func TestListPrunesExpired(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	require.NoError(t, Add("ws/repo", 1, time.Now().Add(-time.Hour)))
+	require.NoError(t, Add("ws/repo", 2, time.Now().Add(time.Hour)))
+
+	entries, err := List()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, 2, entries[0].ID)
+}
+
+func TestListWithoutFile(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	entries, err := List()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -5,6 +5,7 @@ import (
 	deleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/delete"
 	importCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/imports"
 	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/list"
+	runCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/run"
 	setCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/set"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ func NewCmdAlias(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
 	cmd.AddCommand(importCmd.NewCmdImport(f, nil))
 	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(runCmd.NewCmdRun(f, nil))
 	cmd.AddCommand(setCmd.NewCmdSet(f, nil))
 
 	return cmd
@@ -0,0 +1,114 @@
+package run
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	internalrun "github.com/dlbroadfoot/bitbucket-cli/internal/run"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RunOptions struct {
+	Config   func() (gh.Config, error)
+	IO       *iostreams.IOStreams
+	BaseRepo func() (bbrepo.Interface, error)
+
+	Name string
+	Args []string
+}
+
+func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command {
+	opts := &RunOptions{
+		IO:       f.IOStreams,
+		Config:   f.Config,
+		BaseRepo: f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "run <alias> [<args>...]",
+		Short: "Run an alias by name",
+		Long: heredoc.Docf(`
+			Look up an alias and run it with the given arguments.
+
+			This is mostly useful while iterating on an expansion: the alias runs
+			exactly as it would when invoked through its own name, including the
+			BB_* environment variables passed to shell aliases. Use %[1]s--%[1]s to
+			separate the alias's arguments from flags meant for this command.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ bb alias run bugs
+			$ bb alias run epicsBy -- vilmibm
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Name = args[0]
+			opts.Args = args[1:]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runRun(opts *RunOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	expansion, err := cfg.Aliases().Get(opts.Name)
+	if err != nil {
+		return fmt.Errorf("no such alias %s", opts.Name)
+	}
+
+	var expandedArgs []string
+	var env []string
+	if strings.HasPrefix(expansion, "!") {
+		expandedArgs, err = shared.ExpandShellAlias(opts.Name, expansion, opts.Args, nil)
+		if err != nil {
+			return err
+		}
+		env = shared.AliasEnv(cfg, opts.BaseRepo)
+	} else {
+		expandedArgs, err = shared.ExpandAlias(expansion, opts.Args)
+		if err != nil {
+			return err
+		}
+		// Re-executing the binary keeps regular aliases on the exact code
+		// path they take when invoked by name, without this package needing
+		// the root command
+		exe, err := os.Executable()
+		if err != nil {
+			return err
+		}
+		expandedArgs = append([]string{exe}, expandedArgs...)
+	}
+
+	externalCmd := exec.Command(expandedArgs[0], expandedArgs[1:]...)
+	externalCmd.Stderr = opts.IO.ErrOut
+	externalCmd.Stdout = opts.IO.Out
+	externalCmd.Stdin = opts.IO.In
+	externalCmd.Env = append(os.Environ(), env...)
+	preparedCmd := internalrun.PrepareCmd(externalCmd)
+	if err := preparedCmd.Run(); err != nil {
+		var execError *exec.ExitError
+		if errors.As(err, &execError) {
+			return &cmdutil.ExternalCommandExitError{ExitError: execError}
+		}
+		return fmt.Errorf("failed to run external command: %w", err)
+	}
+	return nil
+}
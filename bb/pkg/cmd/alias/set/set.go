@@ -49,6 +49,11 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 			If the expansion starts with %[1]s!%[1]s or if %[1]s--shell%[1]s was given, the expansion is a shell
 			expression that will be evaluated through the %[1]ssh%[1]s interpreter when the alias is
 			invoked. This allows for chaining multiple commands via piping and redirection.
+
+			Shell aliases receive extra arguments as %[1]s$1%[1]s, %[1]s$2%[1]s, and so on, passed through
+			the shell's own argument list so whitespace and special characters survive intact.
+			The environment variables %[1]sBB_REPO%[1]s, %[1]sBB_HOST%[1]s, and %[1]sBB_USER%[1]s name the current
+			repository, host, and logged-in user when they can be determined.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Note: Command Prompt on Windows requires using double quotes for arguments
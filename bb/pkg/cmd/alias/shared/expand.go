@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/findsh"
+	"github.com/google/shlex"
+)
+
+// ExpandAlias processes argv to see if it should be rewritten according to a user's aliases.
+func ExpandAlias(expansion string, args []string) ([]string, error) {
+	extraArgs := []string{}
+	for i, a := range args {
+		if !strings.Contains(expansion, "$") {
+			extraArgs = append(extraArgs, a)
+		} else {
+			expansion = strings.ReplaceAll(expansion, fmt.Sprintf("$%d", i+1), a)
+		}
+	}
+
+	lingeringRE := regexp.MustCompile(`\$\d`)
+	if lingeringRE.MatchString(expansion) {
+		return nil, fmt.Errorf("not enough arguments for alias: %s", expansion)
+	}
+
+	newArgs, err := shlex.Split(expansion)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := append(newArgs, extraArgs...)
+
+	return expanded, nil
+}
+
+// ExpandShellAlias expands a "!"-prefixed alias into an sh invocation. The
+// alias name becomes the script's $0 and the original arguments travel
+// through sh's own argv, so $1, $2, and "$@" in the script see them exactly
+// as typed no matter what whitespace or metacharacters they contain.
+func ExpandShellAlias(aliasName, expansion string, args []string, findShFunc func() (string, error)) ([]string, error) {
+	if findShFunc == nil {
+		findShFunc = findSh
+	}
+
+	shPath, shErr := findShFunc()
+	if shErr != nil {
+		return nil, shErr
+	}
+
+	expanded := []string{shPath, "-c", expansion[1:], aliasName}
+	expanded = append(expanded, args...)
+
+	return expanded, nil
+}
+
+// AliasEnv assembles the BB_* variables that describe the invocation context
+// to a shell alias's child process. Everything is best-effort: outside a
+// repository, or when unauthenticated, the corresponding variables are left
+// unset.
+func AliasEnv(cfg gh.Config, baseRepo func() (bbrepo.Interface, error)) []string {
+	var env []string
+
+	host, _ := cfg.Authentication().DefaultHost()
+	if baseRepo != nil {
+		if repo, err := baseRepo(); err == nil {
+			env = append(env, "BB_REPO="+bbrepo.FullName(repo))
+			host = repo.RepoHost()
+		}
+	}
+	env = append(env, "BB_HOST="+host)
+	if user, err := cfg.Authentication().ActiveUser(host); err == nil && user != "" {
+		env = append(env, "BB_USER="+user)
+	}
+
+	return env
+}
+
+func findSh() (string, error) {
+	shPath, err := findsh.Find()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			if runtime.GOOS == "windows" {
+				return "", errors.New("unable to locate sh to execute the shell alias with. The sh.exe interpreter is typically distributed with Git for Windows.")
+			}
+			return "", errors.New("unable to locate sh to execute shell alias with")
+		}
+		return "", err
+	}
+	return shPath, nil
+}
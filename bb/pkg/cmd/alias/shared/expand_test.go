@@ -1,4 +1,4 @@
-package root
+package shared
 
 import (
 	"errors"
@@ -60,7 +60,7 @@ func TestExpandAlias(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotExpanded, err := expandAlias(tt.expansion, tt.args)
+			gotExpanded, err := ExpandAlias(tt.expansion, tt.args)
 			if tt.wantErr != "" {
 				assert.Nil(t, gotExpanded)
 				assert.EqualError(t, err, tt.wantErr)
@@ -78,6 +78,7 @@ func TestExpandShellAlias(t *testing.T) {
 	}
 	tests := []struct {
 		name         string
+		aliasName    string
 		expansion    string
 		args         []string
 		findSh       func() (string, error)
@@ -86,20 +87,31 @@ func TestExpandShellAlias(t *testing.T) {
 	}{
 		{
 			name:         "simple expansion",
+			aliasName:    "current",
 			expansion:    "!git branch --show-current",
 			args:         []string{},
 			findSh:       findShFunc,
-			wantExpanded: []string{"/usr/bin/sh", "-c", "git branch --show-current"},
+			wantExpanded: []string{"/usr/bin/sh", "-c", "git branch --show-current", "current"},
 		},
 		{
 			name:         "adding arguments after expansion",
+			aliasName:    "co",
 			expansion:    "!git branch checkout",
 			args:         []string{"123"},
 			findSh:       findShFunc,
-			wantExpanded: []string{"/usr/bin/sh", "-c", "git branch checkout", "--", "123"},
+			wantExpanded: []string{"/usr/bin/sh", "-c", "git branch checkout", "co", "123"},
+		},
+		{
+			name:         "arguments with whitespace and metacharacters pass through verbatim",
+			aliasName:    "igrep",
+			expansion:    `!bb issue list --label="$1" | grep "$2"`,
+			args:         []string{"help wanted", "$(hostname); rm -rf"},
+			findSh:       findShFunc,
+			wantExpanded: []string{"/usr/bin/sh", "-c", `bb issue list --label="$1" | grep "$2"`, "igrep", "help wanted", "$(hostname); rm -rf"},
 		},
 		{
 			name:      "unable to find sh",
+			aliasName: "current",
 			expansion: "!git branch --show-current",
 			args:      []string{},
 			findSh: func() (string, error) {
@@ -110,7 +122,7 @@ func TestExpandShellAlias(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotExpanded, err := expandShellAlias(tt.expansion, tt.args, tt.findSh)
+			gotExpanded, err := ExpandShellAlias(tt.aliasName, tt.expansion, tt.args, tt.findSh)
 			if tt.wantErr != "" {
 				assert.Nil(t, gotExpanded)
 				assert.EqualError(t, err, tt.wantErr)
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
@@ -36,7 +37,23 @@ type ApiOptions struct {
 	RequestHeaders  []string
 	Silent          bool
 	Paginate        bool
+	Verbose         bool
+	OutputFile      string
 	JQ              string
+	SaveSnippet     string
+	RunSnippet      string
+}
+
+// snippet is the JSON shape a saved api invocation is stored as in config.
+type snippet struct {
+	Path      string   `json:"path"`
+	Hostname  string   `json:"hostname,omitempty"`
+	Method    string   `json:"method,omitempty"`
+	Fields    []string `json:"fields,omitempty"`
+	RawFields []string `json:"raw_fields,omitempty"`
+	Headers   []string `json:"headers,omitempty"`
+	Paginate  bool     `json:"paginate,omitempty"`
+	JQ        string   `json:"jq,omitempty"`
 }
 
 func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command {
@@ -69,6 +86,10 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 
 			The endpoint path can include placeholders like "{workspace}" and "{repo_slug}" which
 			will be replaced with values from the current repository context.
+
+			Save a frequently used invocation as a named snippet with %[1]s--save name%[1]s; the
+			endpoint, method, fields, and headers are stored in config without making a request.
+			Replay it later with %[1]s--run name%[1]s, optionally overriding any of the stored flags.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# List pull requests for the current repository
@@ -88,14 +109,38 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 
 			# Use with jq for filtering
 			$ bb api repositories/{workspace}/{repo_slug}/pullrequests | jq '.values[].title'
+
+			# Save a filtered PR query as a snippet, then replay it by name
+			$ bb api 'repositories/{workspace}/{repo_slug}/pullrequests?q=state="MERGED"' --save merged-prs
+			$ bb api --run merged-prs
 		`),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.RequestPath = args[0]
+			if len(args) > 0 {
+				opts.RequestPath = args[0]
+			}
+
+			if opts.SaveSnippet != "" && opts.RunSnippet != "" {
+				return cmdutil.FlagErrorf("--save and --run cannot be used together")
+			}
+			if opts.RequestPath == "" && opts.RunSnippet == "" {
+				return cmdutil.FlagErrorf("an endpoint is required unless running a saved snippet with --run")
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--output` or `--silent`",
+				opts.OutputFile != "",
+				opts.Silent,
+			); err != nil {
+				return err
+			}
 
 			if runF != nil {
 				return runF(opts)
 			}
+
+			if opts.SaveSnippet != "" {
+				return saveSnippetRun(opts)
+			}
 			return apiRun(opts)
 		},
 	}
@@ -107,13 +152,96 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().StringArrayVarP(&opts.RequestHeaders, "header", "H", nil, "Add a HTTP request header")
 	cmd.Flags().StringVar(&opts.RequestBody, "input", "", "The file to use as body for the HTTP request (use \"-\" to read from stdin)")
 	cmd.Flags().BoolVar(&opts.Silent, "silent", false, "Do not print the response body")
+	cmd.Flags().BoolVar(&opts.Verbose, "verbose", false, "Print a transfer size and time summary for each request")
+	cmd.Flags().StringVarP(&opts.OutputFile, "output", "o", "", "Write the raw response body to `file` instead of stdout")
 	cmd.Flags().BoolVar(&opts.Paginate, "paginate", false, "Make additional HTTP requests to fetch all pages of results")
 	cmd.Flags().StringVarP(&opts.JQ, "jq", "q", "", "Query to select values from the response using jq syntax")
+	cmd.Flags().StringVar(&opts.SaveSnippet, "save", "", "Save the invocation as a named snippet instead of making the request")
+	cmd.Flags().StringVar(&opts.RunSnippet, "run", "", "Make the request stored in a named snippet")
 
 	return cmd
 }
 
+func saveSnippetRun(opts *ApiOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(snippet{
+		Path:      opts.RequestPath,
+		Hostname:  opts.Hostname,
+		Method:    opts.RequestMethod,
+		Fields:    opts.MagicFields,
+		RawFields: opts.RawFields,
+		Headers:   opts.RequestHeaders,
+		Paginate:  opts.Paginate,
+		JQ:        opts.JQ,
+	})
+	if err != nil {
+		return err
+	}
+
+	cfg.ApiSnippets().Add(opts.SaveSnippet, string(data))
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Saved api snippet %s; run it with: bb api --run %s\n",
+			cs.SuccessIcon(), cs.Bold(opts.SaveSnippet), opts.SaveSnippet)
+	}
+
+	return nil
+}
+
+// applySnippet fills in any options not given on the command line from a
+// saved snippet; explicit flags take precedence over the stored values.
+func applySnippet(opts *ApiOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	stored, err := cfg.ApiSnippets().Get(opts.RunSnippet)
+	if err != nil {
+		return fmt.Errorf("no saved api snippet %q; save one with `bb api <endpoint> --save %s`",
+			opts.RunSnippet, opts.RunSnippet)
+	}
+
+	var s snippet
+	if err := json.Unmarshal([]byte(stored), &s); err != nil {
+		return fmt.Errorf("saved api snippet %q is malformed: %w", opts.RunSnippet, err)
+	}
+
+	if opts.RequestPath == "" {
+		opts.RequestPath = s.Path
+	}
+	if opts.Hostname == "" {
+		opts.Hostname = s.Hostname
+	}
+	if opts.RequestMethod == "" {
+		opts.RequestMethod = s.Method
+	}
+	if opts.JQ == "" {
+		opts.JQ = s.JQ
+	}
+	opts.MagicFields = append(s.Fields, opts.MagicFields...)
+	opts.RawFields = append(s.RawFields, opts.RawFields...)
+	opts.RequestHeaders = append(s.Headers, opts.RequestHeaders...)
+	opts.Paginate = opts.Paginate || s.Paginate
+
+	return nil
+}
+
 func apiRun(opts *ApiOptions) error {
+	if opts.RunSnippet != "" {
+		if err := applySnippet(opts); err != nil {
+			return err
+		}
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -207,6 +335,20 @@ func apiRun(opts *ApiOptions) error {
 		body = bytes.NewReader(jsonBody)
 	}
 
+	var out io.Writer = opts.IO.Out
+	if opts.OutputFile != "" {
+		f, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	var bytesReceived int64
+	requestCount := 0
+	started := time.Now()
+
 	for {
 		req, err := http.NewRequest(method, requestURL, body)
 		if err != nil {
@@ -226,10 +368,16 @@ func apiRun(opts *ApiOptions) error {
 			req.Header.Set(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
 		}
 
+		requestStarted := time.Now()
 		resp, err := httpClient.Do(req)
 		if err != nil {
 			return err
 		}
+		requestCount++
+		if opts.Verbose {
+			fmt.Fprintf(opts.IO.ErrOut, "* %s %s: %s in %s\n",
+				method, requestURL, resp.Status, time.Since(requestStarted).Round(time.Millisecond))
+		}
 
 		if resp.StatusCode >= 400 {
 			defer resp.Body.Close()
@@ -237,19 +385,40 @@ func apiRun(opts *ApiOptions) error {
 		}
 
 		if !opts.Silent {
+			// When writing to a file, or when output is piped and no
+			// pagination is needed, stream the body through untouched instead
+			// of buffering it for pretty printing; large responses then cost
+			// no extra memory.
+			if !opts.Paginate && (opts.OutputFile != "" || !opts.IO.IsStdoutTTY()) {
+				n, err := io.Copy(out, resp.Body)
+				bytesReceived += n
+				resp.Body.Close()
+				if err != nil {
+					return err
+				}
+				break
+			}
+
 			// Read and print response
 			responseBody, err := io.ReadAll(resp.Body)
 			resp.Body.Close()
 			if err != nil {
 				return err
 			}
+			bytesReceived += int64(len(responseBody))
 
-			// Pretty print JSON
-			var prettyJSON bytes.Buffer
-			if json.Indent(&prettyJSON, responseBody, "", "  ") == nil {
-				fmt.Fprintln(opts.IO.Out, prettyJSON.String())
+			if opts.OutputFile != "" {
+				if _, err := out.Write(responseBody); err != nil {
+					return err
+				}
 			} else {
-				fmt.Fprintln(opts.IO.Out, string(responseBody))
+				// Pretty print JSON
+				var prettyJSON bytes.Buffer
+				if json.Indent(&prettyJSON, responseBody, "", "  ") == nil {
+					fmt.Fprintln(opts.IO.Out, prettyJSON.String())
+				} else {
+					fmt.Fprintln(opts.IO.Out, string(responseBody))
+				}
 			}
 
 			// Check for pagination
@@ -264,15 +433,38 @@ func apiRun(opts *ApiOptions) error {
 				}
 			}
 		} else {
+			// Drain so the --verbose summary still reflects the transfer size
+			n, _ := io.Copy(io.Discard, resp.Body)
+			bytesReceived += n
 			resp.Body.Close()
 		}
 
 		break
 	}
 
+	if opts.Verbose {
+		fmt.Fprintf(opts.IO.ErrOut, "* Received %s in %s (%d request(s))\n",
+			formatSize(bytesReceived), time.Since(started).Round(time.Millisecond), requestCount)
+	}
+
 	return nil
 }
 
+// formatSize renders a byte count in a human-readable unit for the --verbose
+// transfer summary.
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
 // ParseURL parses a URL and returns the host and path components
 func ParseURL(urlStr string) (*url.URL, error) {
 	return url.Parse(urlStr)
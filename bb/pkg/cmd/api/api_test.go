@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 )
@@ -123,15 +125,49 @@ func TestNewCmdApi(t *testing.T) {
 				RequestHeaders: []string{"Accept: text/plain", "X-Custom: value"},
 			},
 		},
+		{
+			name: "with save",
+			cli:  "user --save whoami",
+			wants: ApiOptions{
+				RequestPath: "user",
+				SaveSnippet: "whoami",
+			},
+		},
+		{
+			name: "with run",
+			cli:  "--run whoami",
+			wants: ApiOptions{
+				RunSnippet: "whoami",
+			},
+		},
 		{
 			name:     "no endpoint",
 			cli:      "",
-			wantsErr: "accepts 1 arg(s), received 0",
+			wantsErr: "an endpoint is required unless running a saved snippet with --run",
+		},
+		{
+			name:     "save and run together",
+			cli:      "user --save whoami --run whoami",
+			wantsErr: "--save and --run cannot be used together",
 		},
 		{
 			name:     "too many args",
 			cli:      "user extra",
-			wantsErr: "accepts 1 arg(s), received 2",
+			wantsErr: "accepts at most 1 arg(s), received 2",
+		},
+		{
+			name: "with output file",
+			cli:  "user --output resp.json --verbose",
+			wants: ApiOptions{
+				RequestPath: "user",
+				OutputFile:  "resp.json",
+				Verbose:     true,
+			},
+		},
+		{
+			name:     "output and silent together",
+			cli:      "user --output resp.json --silent",
+			wantsErr: "specify only one of `--output` or `--silent`",
 		},
 	}
 
@@ -171,10 +207,62 @@ func TestNewCmdApi(t *testing.T) {
 			assert.Equal(t, tt.wants.RawFields, gotOpts.RawFields)
 			assert.Equal(t, tt.wants.MagicFields, gotOpts.MagicFields)
 			assert.Equal(t, tt.wants.RequestHeaders, gotOpts.RequestHeaders)
+			assert.Equal(t, tt.wants.SaveSnippet, gotOpts.SaveSnippet)
+			assert.Equal(t, tt.wants.RunSnippet, gotOpts.RunSnippet)
+			assert.Equal(t, tt.wants.OutputFile, gotOpts.OutputFile)
+			assert.Equal(t, tt.wants.Verbose, gotOpts.Verbose)
 		})
 	}
 }
 
+func TestApiSnippetRoundTrip(t *testing.T) {
+	cfg := config.NewBlankConfig()
+	cfg.WriteFunc = func() error { return nil }
+	ios, _, _, _ := iostreams.Test()
+
+	saveOpts := &ApiOptions{
+		IO:             ios,
+		Config:         func() (gh.Config, error) { return cfg, nil },
+		RequestPath:    `repositories/{workspace}/{repo_slug}/pullrequests?q=state="MERGED"`,
+		RequestMethod:  "GET",
+		RequestHeaders: []string{"Accept: application/json"},
+		Paginate:       true,
+		SaveSnippet:    "merged-prs",
+	}
+	require.NoError(t, saveSnippetRun(saveOpts))
+
+	runOpts := &ApiOptions{
+		IO:         ios,
+		Config:     func() (gh.Config, error) { return cfg, nil },
+		RunSnippet: "merged-prs",
+	}
+	require.NoError(t, applySnippet(runOpts))
+
+	assert.Equal(t, saveOpts.RequestPath, runOpts.RequestPath)
+	assert.Equal(t, "GET", runOpts.RequestMethod)
+	assert.Equal(t, []string{"Accept: application/json"}, runOpts.RequestHeaders)
+	assert.True(t, runOpts.Paginate)
+
+	// Explicit flags win over the stored values.
+	overrideOpts := &ApiOptions{
+		IO:             ios,
+		Config:         func() (gh.Config, error) { return cfg, nil },
+		RequestMethod:  "POST",
+		RequestHeaders: []string{"X-Custom: value"},
+		RunSnippet:     "merged-prs",
+	}
+	require.NoError(t, applySnippet(overrideOpts))
+	assert.Equal(t, "POST", overrideOpts.RequestMethod)
+	assert.Equal(t, []string{"Accept: application/json", "X-Custom: value"}, overrideOpts.RequestHeaders)
+
+	missingOpts := &ApiOptions{
+		IO:         ios,
+		Config:     func() (gh.Config, error) { return cfg, nil },
+		RunSnippet: "nope",
+	}
+	assert.ErrorContains(t, applySnippet(missingOpts), `no saved api snippet "nope"`)
+}
+
 func TestParseURL(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -210,3 +298,11 @@ func TestParseURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_formatSize(t *testing.T) {
+	assert.Equal(t, "0 B", formatSize(0))
+	assert.Equal(t, "512 B", formatSize(512))
+	assert.Equal(t, "1.0 KiB", formatSize(1024))
+	assert.Equal(t, "1.5 MiB", formatSize(1536*1024))
+	assert.Equal(t, "2.0 GiB", formatSize(2*1024*1024*1024))
+}
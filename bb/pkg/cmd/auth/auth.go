@@ -1,7 +1,9 @@
 package auth
 
 import (
+	authExportCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/export"
 	gitCredentialCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/gitcredential"
+	authImportCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/imports"
 	authLoginCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/login"
 	authLogoutCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/logout"
 	authRefreshCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/refresh"
@@ -30,6 +32,8 @@ func NewCmdAuth(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(authSetupGitCmd.NewCmdSetupGit(f, nil))
 	cmd.AddCommand(authTokenCmd.NewCmdToken(f, nil))
 	cmd.AddCommand(authSwitchCmd.NewCmdSwitch(f, nil))
+	cmd.AddCommand(authExportCmd.NewCmdExport(f, nil))
+	cmd.AddCommand(authImportCmd.NewCmdImport(f, nil))
 
 	return cmd
 }
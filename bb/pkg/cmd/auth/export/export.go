@@ -0,0 +1,127 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ExportOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (gh.Config, error)
+
+	Recipient string
+	Hostname  string
+	ExpiresIn time.Duration
+	Output    string
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export --encrypt-for <recipient>",
+		Short: "Export credentials as an encrypted bundle",
+		Long: heredoc.Docf(`
+			Export the stored credentials as an encrypted bundle, for moving them
+			to another machine or into CI without hand-copying app passwords.
+
+			The bundle is encrypted for a single recipient by shelling out to
+			%[1]sage%[1]s (recipients starting with %[1]sage1%[1]s) or %[1]sgpg%[1]s (key IDs and email
+			addresses), so only the holder of the matching key can read it. Each
+			bundle carries an expiry after which %[1]sbb auth import%[1]s refuses it;
+			the credentials inside stay valid regardless, so revoke any app
+			password that leaks.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Encrypt for an age recipient and provision another machine
+			$ bb auth export --encrypt-for age1qqpv... > creds.age
+
+			# Encrypt for a GPG key, valid for one hour
+			$ bb auth export --encrypt-for ops@example.com --expires-in 1h -o creds.gpg
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return exportRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Recipient, "encrypt-for", "", "The age recipient or GPG `key` to encrypt the bundle for (required)")
+	_ = cmd.MarkFlagRequired("encrypt-for")
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Export only the credentials for one Bitbucket host")
+	cmd.Flags().DurationVar(&opts.ExpiresIn, "expires-in", 24*time.Hour, "How long the bundle stays importable")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Write the bundle to a `file` instead of standard output")
+
+	return cmd
+}
+
+func exportRun(opts *ExportOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	authCfg := cfg.Authentication()
+
+	hostnames := authCfg.Hosts()
+	if opts.Hostname != "" {
+		hostnames = []string{opts.Hostname}
+	}
+
+	now := time.Now()
+	bundle := shared.Bundle{
+		Version:    shared.BundleVersion,
+		ExportedAt: now,
+		ExpiresAt:  now.Add(opts.ExpiresIn),
+	}
+	for _, hostname := range hostnames {
+		token, _ := authCfg.ActiveToken(hostname)
+		if token == "" {
+			continue
+		}
+		user, _ := authCfg.ActiveUser(hostname)
+		bundle.Hosts = append(bundle.Hosts, shared.BundleHost{
+			Hostname: hostname,
+			Username: user,
+			Token:    token,
+		})
+	}
+	if len(bundle.Hosts) == 0 {
+		return errors.New("no credentials to export; run `bb auth login` first")
+	}
+
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := shared.EncryptForRecipient(opts.Recipient, plaintext)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s The bundle contains live credentials for %d host(s); treat it like a password\n",
+		cs.WarningIcon(), len(bundle.Hosts))
+	fmt.Fprintf(opts.IO.ErrOut, "%s Import refuses it after %s, but the credentials inside stay valid until revoked\n",
+		cs.WarningIcon(), bundle.ExpiresAt.Format(time.RFC3339))
+
+	if opts.Output != "" {
+		return os.WriteFile(opts.Output, ciphertext, 0600)
+	}
+	_, err = opts.IO.Out.Write(ciphertext)
+	return err
+}
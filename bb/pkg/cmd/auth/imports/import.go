@@ -0,0 +1,114 @@
+package imports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ImportOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (gh.Config, error)
+
+	File            string
+	IdentityFile    string
+	InsecureStorage bool
+}
+
+func NewCmdImport(f *cmdutil.Factory, runF func(*ImportOptions) error) *cobra.Command {
+	opts := &ImportOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import [<file>]",
+		Short: "Import credentials from an encrypted bundle",
+		Long: heredoc.Docf(`
+			Import credentials from a bundle created with %[1]sbb auth export%[1]s,
+			reading from a file or standard input.
+
+			The bundle is decrypted by shelling out to %[1]sage%[1]s or %[1]sgpg%[1]s depending
+			on its format; age bundles additionally need %[1]s--identity%[1]s pointing at
+			the matching key file. Expired bundles are refused. Imported accounts
+			replace any stored credentials for the same host and user.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ bb auth import creds.gpg
+			$ bb auth import --identity ~/.config/age/key.txt creds.age
+			$ ssh provision-host cat creds.age | bb auth import --identity key.txt -
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.File = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return importRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.IdentityFile, "identity", "i", "", "The age identity `file` to decrypt with")
+	cmd.Flags().BoolVar(&opts.InsecureStorage, "insecure-storage", false, "Save credentials in plain text instead of credential store")
+
+	return cmd
+}
+
+func importRun(opts *ImportOptions) error {
+	var ciphertext []byte
+	var err error
+	if opts.File == "" || opts.File == "-" {
+		ciphertext, err = io.ReadAll(opts.IO.In)
+	} else {
+		ciphertext, err = os.ReadFile(opts.File)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read bundle: %w", err)
+	}
+
+	plaintext, err := shared.DecryptBundle(ciphertext, opts.IdentityFile)
+	if err != nil {
+		return err
+	}
+
+	var bundle shared.Bundle
+	if err := json.Unmarshal(plaintext, &bundle); err != nil {
+		return fmt.Errorf("failed to parse bundle: %w", err)
+	}
+	if err := bundle.Validate(time.Now()); err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	authCfg := cfg.Authentication()
+
+	cs := opts.IO.ColorScheme()
+	for _, host := range bundle.Hosts {
+		insecureUsed, err := authCfg.Login(host.Hostname, host.Username, host.Token, "", !opts.InsecureStorage)
+		if err != nil {
+			return fmt.Errorf("failed to store credentials for %s: %w", host.Hostname, err)
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s Imported credentials for %s on %s\n",
+			cs.SuccessIcon(), cs.Bold(host.Username), host.Hostname)
+		if insecureUsed {
+			fmt.Fprintf(opts.IO.ErrOut, "%s The token is stored in plain text in the config\n", cs.WarningIcon())
+		}
+	}
+
+	return nil
+}
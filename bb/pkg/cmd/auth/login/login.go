@@ -139,12 +139,21 @@ func loginRun(opts *LoginOptions) error {
 	// Interactive prompts
 	if opts.Interactive {
 		if email == "" {
+			selected, err := opts.Prompter.MultiSelect(
+				"What do you plan to use bb for?",
+				[]string{capabilities[0].label},
+				capabilityLabels())
+			if err != nil {
+				return err
+			}
+			scopes := requiredScopes(selected)
+
 			fmt.Fprintln(opts.IO.ErrOut)
-			fmt.Fprintln(opts.IO.ErrOut, "Tip: Create an API token at https://id.atlassian.com/manage-profile/security/api-tokens")
-			fmt.Fprintln(opts.IO.ErrOut, "Required scopes: read:user, read:account, read:repository, write:repository, read:pullrequest, write:pullrequest")
+			fmt.Fprintf(opts.IO.ErrOut, "Required scopes: %s\n", strings.Join(scopes, ", "))
+			fmt.Fprintln(opts.IO.ErrOut, "Tip: Create an API token with these scopes pre-selected at:")
+			fmt.Fprintf(opts.IO.ErrOut, "  %s\n", tokenCreationURL(scopes))
 			fmt.Fprintln(opts.IO.ErrOut)
 
-			var err error
 			email, err = opts.Prompter.Input("Atlassian account email:", "")
 			if err != nil {
 				return err
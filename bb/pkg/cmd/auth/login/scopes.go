@@ -0,0 +1,63 @@
+package login
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// baseScopes are required for bb to function at all: identifying the account
+// and reading and pushing to repositories.
+var baseScopes = []string{
+	"read:user",
+	"read:account",
+	"read:repository",
+	"write:repository",
+}
+
+// capability maps something the user plans to do with bb to the token scopes
+// it needs on top of baseScopes.
+type capability struct {
+	label  string
+	scopes []string
+}
+
+var capabilities = []capability{
+	{label: "Pull requests", scopes: []string{"read:pullrequest", "write:pullrequest"}},
+	{label: "Pipelines", scopes: []string{"read:pipeline", "write:pipeline"}},
+	{label: "Webhooks", scopes: []string{"read:webhook", "write:webhook"}},
+	{label: "Workspace administration", scopes: []string{"admin:repository", "admin:workspace"}},
+}
+
+func capabilityLabels() []string {
+	labels := make([]string, len(capabilities))
+	for i, c := range capabilities {
+		labels[i] = c.label
+	}
+	return labels
+}
+
+// requiredScopes returns the scopes needed for the selected capabilities,
+// including the base scopes, in a stable order.
+func requiredScopes(selected []int) []string {
+	scopes := slices.Clone(baseScopes)
+	for _, i := range selected {
+		if i < 0 || i >= len(capabilities) {
+			continue
+		}
+		for _, s := range capabilities[i].scopes {
+			if !slices.Contains(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	return scopes
+}
+
+// tokenCreationURL returns a link to the API token creation page with the
+// given scopes pre-selected.
+func tokenCreationURL(scopes []string) string {
+	params := url.Values{}
+	params.Set("scopes", strings.Join(scopes, ","))
+	return "https://id.atlassian.com/manage-profile/security/api-tokens?" + params.Encode()
+}
@@ -0,0 +1,54 @@
+package login
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_requiredScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected []int
+		want     []string
+	}{
+		{
+			name:     "no capabilities",
+			selected: nil,
+			want:     []string{"read:user", "read:account", "read:repository", "write:repository"},
+		},
+		{
+			name:     "pull requests",
+			selected: []int{0},
+			want: []string{
+				"read:user", "read:account", "read:repository", "write:repository",
+				"read:pullrequest", "write:pullrequest",
+			},
+		},
+		{
+			name:     "pipelines and webhooks",
+			selected: []int{1, 2},
+			want: []string{
+				"read:user", "read:account", "read:repository", "write:repository",
+				"read:pipeline", "write:pipeline",
+				"read:webhook", "write:webhook",
+			},
+		},
+		{
+			name:     "out of range indices are ignored",
+			selected: []int{-1, 99},
+			want:     []string{"read:user", "read:account", "read:repository", "write:repository"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requiredScopes(tt.selected))
+		})
+	}
+}
+
+func Test_tokenCreationURL(t *testing.T) {
+	got := tokenCreationURL([]string{"read:user", "read:account"})
+	assert.Equal(t, "https://id.atlassian.com/manage-profile/security/api-tokens?scopes=read%3Auser%2Cread%3Aaccount", got)
+}
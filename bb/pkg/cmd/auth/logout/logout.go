@@ -141,6 +141,22 @@ func logoutRun(opts *LogoutOptions) error {
 		return cmdutil.SilentError
 	}
 
+	// Logout has no --yes flag, so only the "always" confirmation policy prompts here
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, true, func() error {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Log out of %s account %s?", hostname, username), true)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
 	// We can ignore the error here because a host must always have an active user
 	preLogoutActiveUser, _ := authCfg.ActiveUser(hostname)
 
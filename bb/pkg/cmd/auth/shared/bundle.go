@@ -0,0 +1,115 @@
+package shared
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/run"
+)
+
+// BundleVersion is the schema version written by bb auth export. Import
+// refuses bundles from a newer bb so tokens never round-trip through a
+// parser that does not understand them.
+const BundleVersion = 1
+
+// Bundle is the payload bb auth export encrypts: a set of credentials plus
+// enough metadata for bb auth import to refuse stale bundles.
+type Bundle struct {
+	Version    int          `json:"version"`
+	ExportedAt time.Time    `json:"exported_at"`
+	ExpiresAt  time.Time    `json:"expires_at"`
+	Hosts      []BundleHost `json:"hosts"`
+}
+
+// BundleHost is one host's credentials within a bundle.
+type BundleHost struct {
+	Hostname string `json:"hostname"`
+	Username string `json:"username"`
+	Token    string `json:"token"`
+}
+
+// Validate reports whether a decrypted bundle may be imported at the given
+// time.
+func (b *Bundle) Validate(now time.Time) error {
+	if b.Version != BundleVersion {
+		return fmt.Errorf("unsupported bundle version %d; re-export with a matching bb version", b.Version)
+	}
+	if len(b.Hosts) == 0 {
+		return errors.New("the bundle contains no credentials")
+	}
+	if now.After(b.ExpiresAt) {
+		return fmt.Errorf("the bundle expired %s; export a fresh one", b.ExpiresAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// EncryptForRecipient encrypts plaintext for an age or GPG recipient by
+// shelling out to the respective tool, so bb never needs the recipient's
+// keys itself. Recipients starting with "age1" select age; anything else is
+// treated as a GPG recipient (key ID or email).
+func EncryptForRecipient(recipient string, plaintext []byte) ([]byte, error) {
+	var cmd *exec.Cmd
+	if strings.HasPrefix(recipient, "age1") {
+		exe, err := exec.LookPath("age")
+		if err != nil {
+			return nil, errors.New("encrypting for an age recipient requires the `age` executable in PATH")
+		}
+		cmd = exec.Command(exe, "--encrypt", "--armor", "--recipient", recipient)
+	} else {
+		exe, err := exec.LookPath("gpg")
+		if err != nil {
+			return nil, errors.New("encrypting for a GPG recipient requires the `gpg` executable in PATH")
+		}
+		cmd = exec.Command(exe, "--encrypt", "--armor", "--recipient", recipient)
+	}
+
+	cmd.Stdin = bytes.NewReader(plaintext)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return nil, fmt.Errorf("encryption failed: %s", strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// DecryptBundle decrypts an armored bundle, picking age or GPG from the
+// armor header. identityFile is only consulted for age bundles, which cannot
+// be decrypted without one.
+func DecryptBundle(ciphertext []byte, identityFile string) ([]byte, error) {
+	text := string(ciphertext)
+
+	var cmd *exec.Cmd
+	switch {
+	case strings.Contains(text, "BEGIN AGE ENCRYPTED FILE") || strings.HasPrefix(text, "age-encryption.org/"):
+		if identityFile == "" {
+			return nil, errors.New("decrypting an age bundle requires `--identity` with your age key file")
+		}
+		exe, err := exec.LookPath("age")
+		if err != nil {
+			return nil, errors.New("decrypting an age bundle requires the `age` executable in PATH")
+		}
+		cmd = exec.Command(exe, "--decrypt", "--identity", identityFile)
+	case strings.Contains(text, "BEGIN PGP MESSAGE"):
+		exe, err := exec.LookPath("gpg")
+		if err != nil {
+			return nil, errors.New("decrypting a GPG bundle requires the `gpg` executable in PATH")
+		}
+		cmd = exec.Command(exe, "--decrypt", "--quiet")
+	default:
+		return nil, errors.New("unrecognized bundle; expected age or GPG armored data from bb auth export")
+	}
+
+	cmd.Stdin = bytes.NewReader(ciphertext)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return nil, fmt.Errorf("decryption failed: %s", strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
@@ -0,0 +1,25 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundleValidate(t *testing.T) {
+	now := time.Date(2026, 8, 29, 12, 0, 0, 0, time.UTC)
+	hosts := []BundleHost{{Hostname: "bitbucket.org", Username: "monalisa", Token: "t"}}
+
+	valid := Bundle{Version: BundleVersion, ExpiresAt: now.Add(time.Hour), Hosts: hosts}
+	assert.NoError(t, valid.Validate(now))
+
+	wrongVersion := Bundle{Version: BundleVersion + 1, ExpiresAt: now.Add(time.Hour), Hosts: hosts}
+	assert.ErrorContains(t, wrongVersion.Validate(now), "unsupported bundle version")
+
+	empty := Bundle{Version: BundleVersion, ExpiresAt: now.Add(time.Hour)}
+	assert.ErrorContains(t, empty.Validate(now), "no credentials")
+
+	expired := Bundle{Version: BundleVersion, ExpiresAt: now.Add(-time.Minute), Hosts: hosts}
+	assert.ErrorContains(t, expired.Validate(now), "expired")
+}
@@ -2,6 +2,7 @@ package shared
 
 type Prompt interface {
 	Select(string, string, []string) (int, error)
+	MultiSelect(string, []string, []string) ([]int, error)
 	Confirm(string, bool) (bool, error)
 	InputHostname() (string, error)
 	AuthToken() (string, error)
@@ -9,11 +9,13 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -36,6 +38,12 @@ type authEntry struct {
 	TokenSource string         `json:"tokenSource"`
 	Token       string         `json:"token,omitempty"`
 	GitProtocol string         `json:"gitProtocol"`
+
+	// Credential metadata is best-effort; the fields are empty when the host
+	// does not report them.
+	TokenCreated   string `json:"tokenCreated,omitempty"`
+	TokenLastUsed  string `json:"tokenLastUsed,omitempty"`
+	TokenExpiresOn string `json:"tokenExpiresOn,omitempty"`
 }
 
 type authStatus struct {
@@ -68,6 +76,18 @@ func (e authEntry) String(cs *iostreams.ColorScheme) string {
 		sb.WriteString(fmt.Sprintf("  - Active account: %s\n", cs.Bold(activeStr)))
 		sb.WriteString(fmt.Sprintf("  - Git operations protocol: %s\n", cs.Bold(e.GitProtocol)))
 		sb.WriteString(fmt.Sprintf("  - Token: %s\n", cs.Bold(e.Token)))
+		if e.TokenCreated != "" {
+			sb.WriteString(fmt.Sprintf("  - Token created: %s\n", cs.Bold(fuzzyTime(e.TokenCreated))))
+		}
+		if e.TokenLastUsed != "" {
+			sb.WriteString(fmt.Sprintf("  - Token last used: %s\n", cs.Bold(fuzzyTime(e.TokenLastUsed))))
+		}
+		if e.TokenExpiresOn != "" {
+			sb.WriteString(fmt.Sprintf("  - Token expires: %s\n", cs.Bold(expiryDisplay(e.TokenExpiresOn))))
+			if warning, ok := expiryWarning(time.Now(), e.TokenExpiresOn); ok {
+				sb.WriteString(fmt.Sprintf("  %s %s\n", cs.WarningIcon(), warning))
+			}
+		}
 
 	case authEntryStateError:
 		if e.Login != "" {
@@ -379,9 +399,58 @@ func buildEntry(opts buildEntryOptions) authEntry {
 	}
 
 	entry.State = authEntryStateSuccess
+
+	// Credential metadata is best-effort; hosts that don't report it just
+	// get the plain entry
+	if meta, err := fetchTokenMetadata(opts.hostname, opts.token); err == nil {
+		entry.TokenCreated = meta.CreatedOn
+		entry.TokenLastUsed = meta.LastAccessed
+		entry.TokenExpiresOn = meta.ExpiresOn
+	}
+
 	return entry
 }
 
+type tokenMetadata struct {
+	CreatedOn    string `json:"created_on"`
+	LastAccessed string `json:"last_accessed"`
+	ExpiresOn    string `json:"expires_on"`
+}
+
+// fetchTokenMetadata asks the host about the credential used for the request:
+// when it was created, when it was last used, and (for expiring credentials)
+// when it expires.
+func fetchTokenMetadata(hostname, token string) (*tokenMetadata, error) {
+	idx := strings.Index(token, ":")
+	if idx < 0 {
+		return nil, fmt.Errorf("invalid token format: expected email:api_token")
+	}
+
+	client := &http.Client{}
+	req, err := http.NewRequest("GET", bbinstance.RESTPrefix(hostname)+"user/credentials/current", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(token[:idx], token[idx+1:])
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("credential metadata unavailable (status %d)", resp.StatusCode)
+	}
+
+	var meta tokenMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
 func verifyToken(hostname, token string) error {
 	if token == "" {
 		return fmt.Errorf("no token provided")
@@ -430,3 +499,54 @@ func verifyToken(hostname, token string) error {
 func authTokenWriteable(src string) bool {
 	return !strings.HasSuffix(src, "_TOKEN")
 }
+
+// expiryWarningWindow is how close to expiry a credential has to be before
+// auth status starts warning about it.
+const expiryWarningWindow = 7 * 24 * time.Hour
+
+// fuzzyTime renders an RFC 3339 timestamp relative to now, falling back to
+// the raw value.
+func fuzzyTime(value string) string {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return text.FuzzyAgo(time.Now(), t)
+	}
+	return value
+}
+
+// expiryDisplay renders an expiry timestamp as a countdown, e.g.
+// "2026-09-05 (in 7 days)".
+func expiryDisplay(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	day := t.Format("2006-01-02")
+	if t.Before(time.Now()) {
+		return fmt.Sprintf("%s (expired)", day)
+	}
+	days := int(time.Until(t).Hours() / 24)
+	if days < 1 {
+		return fmt.Sprintf("%s (in less than a day)", day)
+	}
+	return fmt.Sprintf("%s (in %s)", day, text.Pluralize(days, "day"))
+}
+
+// expiryWarning returns the warning shown when a credential has expired or
+// expires within the warning window.
+func expiryWarning(now time.Time, value string) (string, bool) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", false
+	}
+	if t.Before(now) {
+		return "This credential has expired.", true
+	}
+	if until := t.Sub(now); until <= expiryWarningWindow {
+		days := int(until.Hours() / 24)
+		if days < 1 {
+			return "This credential expires in less than a day.", true
+		}
+		return fmt.Sprintf("This credential expires in %s.", text.Pluralize(days, "day")), true
+	}
+	return "", false
+}
@@ -3,6 +3,7 @@ package status
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
@@ -161,3 +162,49 @@ func Test_authTokenWriteable(t *testing.T) {
 		})
 	}
 }
+
+func Test_expiryWarning(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresOn string
+		want      string
+		wantOk    bool
+	}{
+		{
+			name:      "far in the future",
+			expiresOn: now.AddDate(0, 6, 0).Format(time.RFC3339),
+		},
+		{
+			name:      "within the warning window",
+			expiresOn: now.Add(3 * 24 * time.Hour).Format(time.RFC3339),
+			want:      "This credential expires in 3 days.",
+			wantOk:    true,
+		},
+		{
+			name:      "less than a day",
+			expiresOn: now.Add(2 * time.Hour).Format(time.RFC3339),
+			want:      "This credential expires in less than a day.",
+			wantOk:    true,
+		},
+		{
+			name:      "already expired",
+			expiresOn: now.Add(-time.Hour).Format(time.RFC3339),
+			want:      "This credential has expired.",
+			wantOk:    true,
+		},
+		{
+			name:      "unparseable value",
+			expiresOn: "not-a-date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := expiryWarning(now, tt.expiresOn)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
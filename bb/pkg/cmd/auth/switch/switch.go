@@ -43,6 +43,11 @@ func NewCmdSwitch(f *cmdutil.Factory, runF func(*SwitchOptions) error) *cobra.Co
 			required either through the %[1]s--user%[1]s flag or an interactive prompt.
 
 			For a list of authenticated accounts you can run %[1]sbb auth status%[1]s.
+
+			To pin a workspace to a specific account regardless of which one is
+			active, add an entry to the %[1]sworkspace_accounts%[1]s section of the config
+			file, e.g. %[1]sworkspace_accounts: {acme-corp: work@example.com}%[1]s. Requests
+			targeting that workspace then always use that account's credentials.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Select what host and account to switch to via a prompt
@@ -0,0 +1,23 @@
+package branch
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	branchNewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/branch/new"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdBranch(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch <command>",
+		Short: "Manage branches",
+		Long:  "Work with git branches using the repository's branching model.",
+		Example: heredoc.Doc(`
+			$ bb branch new "Fix payment rounding"
+		`),
+	}
+
+	cmd.AddCommand(branchNewCmd.NewCmdNew(f, nil))
+
+	return cmd
+}
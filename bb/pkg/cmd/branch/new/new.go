@@ -0,0 +1,235 @@
+package new
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type NewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Title string
+	Type  string
+	From  string
+	Push  bool
+}
+
+func NewCmdNew(f *cmdutil.Factory, runF func(*NewOptions) error) *cobra.Command {
+	opts := &NewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "new <title>",
+		Short: "Create a branch named after a change description",
+		Long: heredoc.Doc(`
+			Create and check out a git branch whose name is a slug of the given
+			title, prefixed according to the repository's branching model — e.g.
+			"Fix payment rounding" becomes "bugfix/fix-payment-rounding" with
+			--type bugfix.
+
+			The branch starts from the branching model's development branch (or
+			the main branch when no model is configured); --from overrides that.
+		`),
+		Example: heredoc.Doc(`
+			# Create a feature branch from the development branch
+			$ bb branch new "Add CSV export"
+
+			# Create a bugfix branch and push it with an upstream
+			$ bb branch new "Fix payment rounding" --type bugfix --push
+
+			# Branch off a specific base instead of the development branch
+			$ bb branch new "Hotfix rate limits" --type hotfix --from production
+		`),
+		Args: cmdutil.ExactArgs(1, "branch title required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Title = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return newRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Type, "type", "t", "feature", []string{"feature", "bugfix", "hotfix", "release"}, "Branch type from the branching model")
+	cmd.Flags().StringVar(&opts.From, "from", "", "Base `branch` to start from instead of the development branch")
+	cmd.Flags().BoolVarP(&opts.Push, "push", "p", false, "Push the new branch and set its upstream")
+	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "from")
+
+	return cmd
+}
+
+func newRun(opts *NewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	prefix, development, err := fetchModel(apiClient, repo, opts.Type)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	branch := prefix + slugify(opts.Title)
+	if branch == prefix {
+		return cmdutil.FlagErrorf("title %q leaves nothing to name the branch after", opts.Title)
+	}
+
+	base := opts.From
+	if base == "" {
+		base = development
+	}
+
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+
+	if opts.GitClient.HasLocalBranch(ctx, branch) {
+		return fmt.Errorf("branch %q already exists", branch)
+	}
+
+	baseRef, err := resolveBaseRef(ctx, opts.GitClient, base)
+	if err != nil {
+		return err
+	}
+
+	gitCmd, err := opts.GitClient.Command(ctx, "checkout", "-b", branch, baseRef)
+	if err != nil {
+		return err
+	}
+	if err := gitCmd.Run(); err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", branch, err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created branch %s from %s\n",
+		cs.SuccessIcon(), cs.Bold(branch), cs.Cyan(baseRef))
+
+	if opts.Push {
+		// Push sets the upstream as a side effect
+		if err := opts.GitClient.Push(ctx, "origin", branch); err != nil {
+			return fmt.Errorf("failed to push branch %s: %w", branch, err)
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s Pushed branch %s and set upstream to origin/%s\n",
+			cs.SuccessIcon(), cs.Bold(branch), branch)
+	}
+
+	return nil
+}
+
+// fetchModel resolves the branch prefix for the requested type and the
+// development branch name from the repository's branching model. A repository
+// without a model falls back to "<type>/" and its main branch.
+func fetchModel(apiClient *api.Client, repo bbrepo.Interface, branchType string) (prefix, development string, err error) {
+	prefix = branchType + "/"
+
+	path := fmt.Sprintf("repositories/%s/%s/branching-model", repo.RepoWorkspace(), repo.RepoSlug())
+	var model struct {
+		BranchTypes []struct {
+			Kind   string `json:"kind"`
+			Prefix string `json:"prefix"`
+		} `json:"branch_types"`
+		Development *struct {
+			Name string `json:"name"`
+		} `json:"development"`
+	}
+	if err := apiClient.Get(repo.RepoHost(), path, &model); err != nil {
+		if !api.IsNotFoundError(err) {
+			return "", "", err
+		}
+	}
+
+	for _, bt := range model.BranchTypes {
+		if bt.Kind == branchType && bt.Prefix != "" {
+			prefix = bt.Prefix
+			break
+		}
+	}
+
+	if model.Development != nil && model.Development.Name != "" {
+		return prefix, model.Development.Name, nil
+	}
+
+	// No development branch in the model; fall back to the main branch
+	var repository api.Repository
+	repoPath := fmt.Sprintf("repositories/%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
+	if err := apiClient.Get(repo.RepoHost(), repoPath, &repository); err != nil {
+		return "", "", err
+	}
+	if repository.MainBranch != nil && repository.MainBranch.Name != "" {
+		return prefix, repository.MainBranch.Name, nil
+	}
+	return prefix, "main", nil
+}
+
+// resolveBaseRef picks the local branch when it exists, falling back to a
+// remote-tracking ref so a fresh clone works without checking the base out.
+func resolveBaseRef(ctx context.Context, gc *git.Client, branch string) (string, error) {
+	if gc.HasLocalBranch(ctx, branch) {
+		return branch, nil
+	}
+
+	cmd, err := gc.Command(ctx, "for-each-ref", "--format=%(refname:short)", "--count=1", "refs/remotes/*/"+branch)
+	if err != nil {
+		return "", err
+	}
+	if out, err := cmd.Output(); err == nil {
+		if ref := strings.TrimSpace(string(out)); ref != "" {
+			return ref, nil
+		}
+	}
+
+	return "", fmt.Errorf("base branch %q not found locally; fetch it with `git fetch origin %s` and try again", branch, branch)
+}
+
+// slugify lowercases the title and reduces it to alphanumeric words joined by
+// hyphens, truncated at a word boundary.
+func slugify(title string) string {
+	const maxSlugLength = 50
+
+	var b strings.Builder
+	lastHyphen := true
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastHyphen = false
+		case !lastHyphen:
+			b.WriteByte('-')
+			lastHyphen = true
+		}
+	}
+
+	slug := strings.Trim(b.String(), "-")
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+		if i := strings.LastIndex(slug, "-"); i > 0 {
+			slug = slug[:i]
+		}
+	}
+	return slug
+}
@@ -0,0 +1,40 @@
+package new
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{
+			name:  "simple title",
+			title: "Fix payment rounding",
+			want:  "fix-payment-rounding",
+		},
+		{
+			name:  "punctuation collapsed",
+			title: "Add CSV export (v2)!",
+			want:  "add-csv-export-v2",
+		},
+		{
+			name:  "long title truncated at word boundary",
+			title: "This is a very long change description that keeps going on and on",
+			want:  "this-is-a-very-long-change-description-that-keeps",
+		},
+		{
+			name:  "only punctuation",
+			title: "!!!",
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slugify(tt.title); got != tt.want {
+				t.Errorf("slugify(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
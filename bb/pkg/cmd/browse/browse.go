@@ -27,7 +27,14 @@ type BrowseOptions struct {
 	PipelinesFlag bool
 	PRsFlag       bool
 	IssuesFlag    bool
+	BranchesFlag  bool
+	CommitsFlag   bool
+	DownloadsFlag bool
+	SecurityFlag  bool
+	WorkspaceFlag bool
+	Project       string
 	NoBrowserFlag bool
+	CopyFlag      bool
 }
 
 func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Command {
@@ -65,8 +72,20 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 			# Open a file at a specific branch
 			$ bb browse src/main.go --branch develop
 
+			# Open the repository commit history
+			$ bb browse --commits
+
+			# Open the workspace overview
+			$ bb browse --workspace
+
+			# Open a project in the workspace
+			$ bb browse --project TEAM
+
 			# Print URL without opening browser
 			$ bb browse --no-browser
+
+			# Copy the URL to the clipboard
+			$ bb browse --copy
 		`),
 		Args:    cobra.MaximumNArgs(1),
 		GroupID: "core",
@@ -77,6 +96,20 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 				opts.SelectorArg = args[0]
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--workspace` or `--project`",
+				opts.WorkspaceFlag,
+				opts.Project != "",
+			); err != nil {
+				return err
+			}
+			if (opts.WorkspaceFlag || opts.Project != "") &&
+				(opts.SelectorArg != "" || opts.SettingsFlag || opts.WikiFlag || opts.PipelinesFlag ||
+					opts.PRsFlag || opts.IssuesFlag || opts.BranchesFlag || opts.CommitsFlag ||
+					opts.DownloadsFlag || opts.SecurityFlag) {
+				return cmdutil.FlagErrorf("repository targets cannot be combined with `--workspace` or `--project`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -90,8 +123,16 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.PipelinesFlag, "pipelines", "p", false, "Open repository pipelines")
 	cmd.Flags().BoolVar(&opts.PRsFlag, "prs", false, "Open repository pull requests")
 	cmd.Flags().BoolVar(&opts.IssuesFlag, "issues", false, "Open repository issues")
+	cmd.Flags().BoolVar(&opts.BranchesFlag, "branches", false, "Open repository branches")
+	cmd.Flags().BoolVar(&opts.CommitsFlag, "commits", false, "Open repository commit history")
+	cmd.Flags().BoolVar(&opts.DownloadsFlag, "downloads", false, "Open repository downloads")
+	cmd.Flags().BoolVar(&opts.SecurityFlag, "security", false, "Open repository security reports")
+	cmd.Flags().BoolVar(&opts.WorkspaceFlag, "workspace", false, "Open the workspace overview instead of the repository")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Open a project in the workspace by its `key`")
 	cmd.Flags().BoolVarP(&opts.NoBrowserFlag, "no-browser", "n", false, "Print destination URL instead of opening the browser")
+	cmd.Flags().BoolVarP(&opts.CopyFlag, "copy", "c", false, "Copy destination URL to the clipboard instead of opening the browser")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Select another branch by passing in the branch name")
+	cmd.MarkFlagsMutuallyExclusive("no-browser", "copy")
 
 	return cmd
 }
@@ -102,8 +143,17 @@ func runBrowse(opts *BrowseOptions) error {
 		return fmt.Errorf("unable to determine base repository: %w", err)
 	}
 
-	section := parseSection(opts)
-	url := generateRepoURL(baseRepo, section)
+	var url string
+	if opts.WorkspaceFlag || opts.Project != "" {
+		url = generateWorkspaceURL(baseRepo, opts.Project)
+	} else {
+		section := parseSection(opts)
+		url = generateRepoURL(baseRepo, section)
+	}
+
+	if opts.CopyFlag {
+		return cmdutil.CopyURLToClipboard(opts.IO, url)
+	}
 
 	if opts.NoBrowserFlag {
 		_, err = fmt.Fprintln(opts.IO.Out, url)
@@ -127,6 +177,14 @@ func parseSection(opts *BrowseOptions) string {
 		return "pull-requests"
 	} else if opts.IssuesFlag {
 		return "issues"
+	} else if opts.BranchesFlag {
+		return "branches"
+	} else if opts.CommitsFlag {
+		return "commits"
+	} else if opts.DownloadsFlag {
+		return "downloads"
+	} else if opts.SecurityFlag {
+		return "security"
 	}
 
 	if opts.SelectorArg == "" {
@@ -149,6 +207,14 @@ func parseSection(opts *BrowseOptions) string {
 	return fmt.Sprintf("src/%s/%s", branch, opts.SelectorArg)
 }
 
+func generateWorkspaceURL(repo bbrepo.Interface, projectKey string) string {
+	baseURL := fmt.Sprintf("https://%s/%s/workspace", repo.RepoHost(), repo.RepoWorkspace())
+	if projectKey != "" {
+		return fmt.Sprintf("%s/projects/%s", baseURL, projectKey)
+	}
+	return baseURL + "/overview"
+}
+
 func generateRepoURL(repo bbrepo.Interface, section string) string {
 	baseURL := fmt.Sprintf("https://%s/%s/%s", repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug())
 	if section == "" {
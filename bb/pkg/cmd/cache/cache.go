@@ -0,0 +1,25 @@
+package cache
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	warmCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/cache/warm"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCache(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache <command>",
+		Short: "Manage bb's on-disk caches",
+		Long: heredoc.Doc(`
+			Manage the on-disk caches that keep tab completion and prompt-status
+			fast.
+
+			To delete the caches, see bb config clear-cache.
+		`),
+	}
+
+	cmd.AddCommand(warmCmd.NewCmdWarm(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,148 @@
+package warm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/membercache"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/repocache"
+	promptstatus "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/prompt-status"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type WarmOptions struct {
+	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Workspace string
+}
+
+func NewCmdWarm(f *cmdutil.Factory, runF func(*WarmOptions) error) *cobra.Command {
+	opts := &WarmOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "warm [<workspace>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Pre-fetch repository metadata into the on-disk cache",
+		Long: heredoc.Doc(`
+			Pre-fetch a workspace's repository list and member list into the
+			on-disk cache, so tab completion and workspace-wide commands do not
+			wait on the API.
+
+			Inside a repository, the cache entries behind bb prompt-status are
+			also warmed for every branch with an open pull request.
+
+			If no workspace is specified, the current repository's workspace is
+			used, falling back to the configured default workspace. The command
+			is suitable for running in the background, for example from a shell
+			startup file:
+
+			    bb cache warm >/dev/null 2>&1 &
+		`),
+		Example: heredoc.Doc(`
+			$ bb cache warm
+			$ bb cache warm myworkspace
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Workspace = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return warmRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func warmRun(opts *WarmOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	// Get the default host, filtering out GitHub hosts from shared config
+	host, _ := cfg.Authentication().DefaultHost()
+	if strings.Contains(host, "github.com") || host == "" {
+		host = bbinstance.Default()
+	}
+
+	// The current repository is optional; outside one, only the workspace
+	// caches are warmed
+	repo, repoErr := opts.BaseRepo()
+
+	workspace := opts.Workspace
+	if workspace == "" && repoErr == nil {
+		workspace = repo.RepoWorkspace()
+		host = repo.RepoHost()
+	}
+	if workspace == "" {
+		workspace = cfg.DefaultWorkspace(host).Value
+	}
+	if workspace == "" {
+		return fmt.Errorf("no workspace specified; pass one or set a default with `bb config set default_workspace <workspace>`")
+	}
+
+	cs := opts.IO.ColorScheme()
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	repos, err := repocache.Refresh(apiClient, host, workspace)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Cached %d repositories in workspace %s\n",
+			cs.SuccessIcon(), len(repos), cs.Bold(workspace))
+	}
+
+	// Member lists are not visible in every workspace, so a failure here
+	// should not fail the warm
+	opts.IO.StartProgressIndicator()
+	members, err := membercache.Refresh(apiClient, host, workspace)
+	opts.IO.StopProgressIndicator()
+	if err == nil && opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Cached %d workspace members\n", cs.SuccessIcon(), len(members))
+	}
+
+	if repoErr != nil {
+		return nil
+	}
+
+	opts.IO.StartProgressIndicator()
+	warmed, err := promptstatus.WarmBranches(httpClient, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Cached pull request status for %d branches of %s\n",
+			cs.SuccessIcon(), warmed, cs.Bold(bbrepo.FullName(repo)))
+	}
+
+	return nil
+}
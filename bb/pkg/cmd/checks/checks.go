@@ -0,0 +1,26 @@
+package checks
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	checksReportCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/checks/report"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdChecks(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checks <command>",
+		Short: "Report commit build statuses",
+		Long:  `Report build statuses against commits, without needing Pipelines.`,
+		Example: heredoc.Doc(`
+			$ bb checks report --key lint --from-command "make lint"
+			$ bb checks report --key deploy --state SUCCESSFUL
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(checksReportCmd.NewCmdReport(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,205 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Key         string
+	State       string
+	Name        string
+	Description string
+	URL         string
+	Commit      string
+	FromCommand string
+}
+
+func NewCmdReport(f *cmdutil.Factory, runF func(*ReportOptions) error) *cobra.Command {
+	opts := &ReportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "report --key <key> (--state <state> | --from-command <command>)",
+		Short: "Post a commit build status from a local run",
+		Long: heredoc.Doc(`
+			Post a build status against a commit, so repositories without
+			Pipelines can still show checks on their pull requests.
+
+			With --from-command, the given shell command is run locally; its
+			exit status decides between SUCCESSFUL and FAILED, and the status
+			description records the exit code and duration. With --state, the
+			status is posted as given.
+
+			The status is reported against HEAD unless --commit names another
+			commit.
+		`),
+		Example: heredoc.Doc(`
+			# Run the linter and report its result
+			$ bb checks report --key lint --from-command "make lint"
+
+			# Report a state directly
+			$ bb checks report --key lint --state FAILED
+
+			# Report against a specific commit, with a link to details
+			$ bb checks report --key e2e --state SUCCESSFUL --commit 3f8a21c --url https://ci.example.com/run/42
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--state` or `--from-command`",
+				opts.State != "", opts.FromCommand != "",
+			); err != nil {
+				return err
+			}
+			if opts.State == "" && opts.FromCommand == "" {
+				return cmdutil.FlagErrorf("one of `--state` or `--from-command` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return reportRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Key, "key", "k", "", "Unique key identifying the check (required)")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "", []string{"SUCCESSFUL", "FAILED", "INPROGRESS", "STOPPED"}, "State to report")
+	cmd.Flags().StringVar(&opts.FromCommand, "from-command", "", "Shell command whose exit status decides the state")
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Display name of the check (default: the key)")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description shown with the status")
+	cmd.Flags().StringVarP(&opts.URL, "url", "u", "", "Link to details about the check")
+	cmd.Flags().StringVarP(&opts.Commit, "commit", "c", "", "Commit to report against (default: HEAD)")
+	_ = cmd.MarkFlagRequired("key")
+
+	return cmd
+}
+
+func reportRun(opts *ReportOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	commitSHA := opts.Commit
+	if commitSHA == "" {
+		commit, err := opts.GitClient.LastCommit(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not determine HEAD commit; use --commit: %w", err)
+		}
+		commitSHA = commit.Sha
+	}
+
+	state := opts.State
+	description := opts.Description
+	var commandFailed bool
+	if opts.FromCommand != "" {
+		state, description, commandFailed = runCommand(opts)
+		if opts.Description != "" {
+			description = opts.Description
+		}
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = opts.Key
+	}
+
+	payload := map[string]interface{}{
+		"key":   opts.Key,
+		"state": state,
+		"name":  name,
+	}
+	if description != "" {
+		payload["description"] = description
+	}
+	if opts.URL != "" {
+		payload["url"] = opts.URL
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repositories/%s/%s/commit/%s/statuses/build",
+		repo.RepoWorkspace(), repo.RepoSlug(), commitSHA)
+
+	opts.IO.StartProgressIndicator()
+	err = apiClient.Post(repo.RepoHost(), path, payload, nil)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to report build status: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	icon := cs.SuccessIcon()
+	if state == "FAILED" {
+		icon = cs.FailureIcon()
+	}
+	fmt.Fprintf(opts.IO.Out, "%s Reported %s as %s on %s\n",
+		icon, cs.Bold(opts.Key), state, text.Truncate(12, commitSHA))
+
+	// The caller's exit status should reflect the command it asked us to run
+	if commandFailed {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+// runCommand runs the --from-command shell command with output streamed
+// through, and derives the state to report plus a description recording the
+// exit code and duration.
+func runCommand(opts *ReportOptions) (state, description string, failed bool) {
+	fmt.Fprintf(opts.IO.ErrOut, "Running: %s\n", opts.FromCommand)
+
+	cmd := exec.Command("sh", "-c", opts.FromCommand)
+	cmd.Env = os.Environ()
+	cmd.Stdin = opts.IO.In
+	cmd.Stdout = opts.IO.Out
+	cmd.Stderr = opts.IO.ErrOut
+
+	started := time.Now()
+	err := cmd.Run()
+	duration := time.Since(started).Round(time.Second)
+
+	exitCode := 0
+	if err != nil {
+		exitCode = 1
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			exitCode = exitErr.ExitCode()
+		}
+	}
+
+	state = "SUCCESSFUL"
+	if exitCode != 0 {
+		state = "FAILED"
+	}
+	description = fmt.Sprintf("%s: exit %d in %s", strings.TrimSpace(opts.FromCommand), exitCode, duration)
+	return state, description, exitCode != 0
+}
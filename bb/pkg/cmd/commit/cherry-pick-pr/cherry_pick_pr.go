@@ -0,0 +1,228 @@
+package cherrypickpr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CherryPickPROptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	SelectorArg string
+	Onto        string
+	Branch      string
+}
+
+func NewCmdCherryPickPR(f *cmdutil.Factory, runF func(*CherryPickPROptions) error) *cobra.Command {
+	opts := &CherryPickPROptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "cherry-pick-pr <number> --onto <branch>",
+		Short: "Backport a merged pull request onto another branch",
+		Long: heredoc.Doc(`
+			Cherry-pick the merge commit of a merged pull request onto a release
+			branch, push the result as a new branch, and open a backport pull
+			request targeting that branch.
+
+			The working copy must be clean; the cherry-pick happens on a branch
+			created from the release branch and your current branch is restored
+			afterwards. A cherry-pick that hits conflicts is aborted and leaves
+			the repository untouched.
+		`),
+		Example: heredoc.Doc(`
+			# Backport pull request #123 onto release/2.0
+			$ bb commit cherry-pick-pr 123 --onto release/2.0
+
+			# Use a custom backport branch name
+			$ bb commit cherry-pick-pr 123 --onto release/2.0 --branch hotfix-123
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return cherryPickPRRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Onto, "onto", "", "The release `branch` to backport onto (required)")
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Name for the backport branch (default \"backport-<number>-to-<onto>\")")
+	_ = cmd.MarkFlagRequired("onto")
+
+	return cmd
+}
+
+func cherryPickPRRun(opts *CherryPickPROptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+	if prRepo != nil {
+		repo = prRepo
+	}
+
+	pr, err := list.FetchPullRequest(httpClient, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	if pr.State != "MERGED" {
+		return fmt.Errorf("pull request #%d is not merged (state: %s)", prID, pr.StateDisplay())
+	}
+	if pr.MergeCommit == nil || pr.MergeCommit.Hash == "" {
+		return fmt.Errorf("pull request #%d has no merge commit to cherry-pick", prID)
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch = fmt.Sprintf("backport-%d-to-%s", prID, strings.ReplaceAll(opts.Onto, "/", "-"))
+	}
+
+	if err := cherryPickLocally(opts, pr, branch); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Pushed backport branch %s\n", cs.SuccessIcon(), cs.Bold(branch))
+
+	backport, err := createBackportPR(httpClient, repo, pr, branch, opts.Onto)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created backport pull request #%d\n", cs.SuccessIcon(), backport.ID)
+	if backport.HTMLURL() != "" {
+		fmt.Fprintln(opts.IO.Out, backport.HTMLURL())
+	}
+
+	return nil
+}
+
+// cherryPickLocally creates the backport branch from the release branch,
+// cherry-picks the pull request's merge commit onto it, and pushes it. Every
+// step that can leave the working copy in a surprising state either aborts
+// cleanly or refuses to run.
+func cherryPickLocally(opts *CherryPickPROptions, pr *shared.PullRequest, branch string) error {
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+	remote := "origin"
+	hash := pr.MergeCommit.Hash
+
+	// Refuse to touch a dirty working copy
+	if changes, err := opts.GitClient.UncommittedChangeCount(ctx); err != nil {
+		return err
+	} else if changes > 0 {
+		return fmt.Errorf("cannot cherry-pick with uncommitted changes; commit or stash them first")
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Fetching %s and %s from %s...\n", opts.Onto, hash, remote)
+	if err := opts.GitClient.Fetch(ctx, remote, opts.Onto); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", opts.Onto, err)
+	}
+	if err := opts.GitClient.Fetch(ctx, remote, hash); err != nil {
+		return fmt.Errorf("failed to fetch commit %s: %w", hash, err)
+	}
+
+	if opts.GitClient.HasLocalBranch(ctx, branch) {
+		return fmt.Errorf("branch %s already exists; delete it or pick another name with --branch", branch)
+	}
+
+	// Remember where we are so the working copy ends up back there
+	originalBranch, err := opts.GitClient.CurrentBranch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := runGit(ctx, opts.GitClient, "checkout", "-b", branch, fmt.Sprintf("%s/%s", remote, opts.Onto)); err != nil {
+		return fmt.Errorf("failed to create branch %s from %s/%s: %w", branch, remote, opts.Onto, err)
+	}
+	defer func() {
+		if err := opts.GitClient.CheckoutBranch(ctx, originalBranch); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to return to branch %s: %v\n", cs.WarningIcon(), originalBranch, err)
+		}
+	}()
+
+	fmt.Fprintf(opts.IO.ErrOut, "Cherry-picking %s onto %s...\n", hash, opts.Onto)
+	// A merge commit needs -m 1 to pick the changes relative to the mainline;
+	// squash and fast-forward merges produce ordinary commits.
+	if err := runGit(ctx, opts.GitClient, "cherry-pick", "-m", "1", hash); err != nil {
+		if err := runGit(ctx, opts.GitClient, "cherry-pick", hash); err != nil {
+			// Leave no half-finished cherry-pick behind
+			if abortErr := runGit(ctx, opts.GitClient, "cherry-pick", "--abort"); abortErr != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Failed to abort cherry-pick: %v\n", cs.WarningIcon(), abortErr)
+			}
+			return fmt.Errorf("cherry-pick of %s onto %s hit conflicts and was aborted; backport manually", hash, opts.Onto)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Pushing %s...\n", branch)
+	if err := runGit(ctx, opts.GitClient, "push", "-u", remote, branch); err != nil {
+		return fmt.Errorf("failed to push %s: %w", branch, err)
+	}
+
+	return nil
+}
+
+func runGit(ctx context.Context, client *git.Client, args ...string) error {
+	cmd, err := client.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	return cmd.Run()
+}
+
+func createBackportPR(client *http.Client, repo bbrepo.Interface, pr *shared.PullRequest, branch, onto string) (*shared.PullRequest, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests", repo.RepoWorkspace(), repo.RepoSlug())
+	body := map[string]interface{}{
+		"title":               fmt.Sprintf("[Backport %s] %s", onto, pr.Title),
+		"description":         fmt.Sprintf("Backport of #%d to `%s`.", pr.ID, onto),
+		"close_source_branch": true,
+		"source": map[string]interface{}{
+			"branch": map[string]string{"name": branch},
+		},
+		"destination": map[string]interface{}{
+			"branch": map[string]string{"name": onto},
+		},
+	}
+
+	var backport shared.PullRequest
+	if err := apiClient.Post(repo.RepoHost(), path, body, &backport); err != nil {
+		return nil, fmt.Errorf("failed to create backport pull request: %w", err)
+	}
+
+	return &backport, nil
+}
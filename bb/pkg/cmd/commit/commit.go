@@ -0,0 +1,22 @@
+package commit
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cherryPickPRCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/commit/cherry-pick-pr"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdCommit(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit <command>",
+		Short: "Work with commits",
+		Example: heredoc.Doc(`
+			$ bb commit cherry-pick-pr 123 --onto release/2.0
+		`),
+	}
+
+	cmd.AddCommand(cherryPickPRCmd.NewCmdCherryPickPR(f, nil))
+
+	return cmd
+}
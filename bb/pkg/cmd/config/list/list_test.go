@@ -100,10 +100,31 @@ func Test_listRun(t *testing.T) {
 				pager=less
 				http_unix_socket=
 				browser=brave
+				http_proxy=
+				ca_bundle=
+				client_cert=
+				client_key=
+				tls_min_version=
+				tls_ciphers=
 				color_labels=disabled
 				accessible_colors=disabled
+				accessible_output=disabled
 				accessible_prompter=disabled
 				spinner=enabled
+				concurrency=5
+				confirm_destructive=prompt
+				default_workspace=
+				jira_base_url=
+				jira_email=
+				jira_api_token=
+				secret_cache_gpg_key=
+				locale=
+				merge_trailers=enabled
+				post_merge=
+				pr_list_columns=
+				issue_list_columns=
+				repo_list_columns=
+				pipeline_list_columns=
 			`),
 		},
 	}
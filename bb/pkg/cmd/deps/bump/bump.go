@@ -0,0 +1,264 @@
+package bump
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/run"
+	prshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type BumpOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Branch  string
+	Title   string
+	Command []string
+
+	Now func() time.Time
+}
+
+func NewCmdBump(f *cmdutil.Factory, runF func(*BumpOptions) error) *cobra.Command {
+	opts := &BumpOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+		Now:        time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "bump <command>...",
+		Short: "Run a dependency update command and open a pull request with the result",
+		Long: heredoc.Doc(`
+			Run an update command on a fresh branch, commit whatever it changed,
+			push, and open a pull request whose description summarizes the
+			version changes found in the diff.
+
+			The working tree must be clean, and the branch the command runs from
+			becomes the pull request's destination. When the command leaves the
+			tree unchanged, the branch is removed again and no pull request is
+			created.
+		`),
+		Example: heredoc.Doc(`
+			$ bb deps bump go get -u ./...
+			$ bb deps bump npm update
+			$ bb deps bump --title "Bump lodash" npm update lodash
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Command = args
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return bumpRun(opts)
+		},
+	}
+
+	// Stop flag parsing at the first non-flag argument so flags of the
+	// update command itself pass through untouched
+	cmd.Flags().SetInterspersed(false)
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Name for the update branch (default \"deps/bump-<date>\")")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "Bump dependencies", "Title for the commit and pull request")
+
+	return cmd
+}
+
+func bumpRun(opts *BumpOptions) error {
+	ctx := context.Background()
+	gitc := opts.GitClient
+	cs := opts.IO.ColorScheme()
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if changed, err := gitc.UncommittedChangeCount(ctx); err != nil {
+		return err
+	} else if changed > 0 {
+		return fmt.Errorf("the working tree has uncommitted changes; commit or stash them first")
+	}
+
+	base, err := gitc.CurrentBranch(ctx)
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch = "deps/bump-" + opts.Now().Format("2006-01-02")
+	}
+	if gitc.HasLocalBranch(ctx, branch) {
+		return fmt.Errorf("branch %q already exists; pass --branch to pick another name", branch)
+	}
+
+	if err := runGit(ctx, gitc, "checkout", "-b", branch); err != nil {
+		return err
+	}
+	// Until something is committed, failure paths put the tree back the way
+	// it was
+	cleanup := func() {
+		_ = runGit(ctx, gitc, "checkout", "--", ".")
+		_ = gitc.CheckoutBranch(ctx, base)
+		_ = gitc.DeleteLocalBranch(ctx, branch)
+	}
+
+	updateCmd := exec.CommandContext(ctx, opts.Command[0], opts.Command[1:]...)
+	updateCmd.Stdout = opts.IO.Out
+	updateCmd.Stderr = opts.IO.ErrOut
+	updateCmd.Stdin = opts.IO.In
+	if err := run.PrepareCmd(updateCmd).Run(); err != nil {
+		cleanup()
+		return fmt.Errorf("update command failed: %w", err)
+	}
+
+	if changed, err := gitc.UncommittedChangeCount(ctx); err != nil {
+		cleanup()
+		return err
+	} else if changed == 0 {
+		cleanup()
+		return cmdutil.NewNoResultsError(fmt.Sprintf("%q left the working tree unchanged", strings.Join(opts.Command, " ")))
+	}
+
+	// Summarize before the diff is committed away
+	changes := versionChanges(diffOutput(ctx, gitc))
+
+	if err := runGit(ctx, gitc, "add", "-A"); err != nil {
+		cleanup()
+		return err
+	}
+	if err := runGit(ctx, gitc, "commit", "-m", opts.Title); err != nil {
+		cleanup()
+		return err
+	}
+
+	// From here on the branch holds real work, so failures leave it in place
+	if err := gitc.Push(ctx, "origin", branch); err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	payload := map[string]interface{}{
+		"title":               opts.Title,
+		"description":         description(opts.Command, changes),
+		"close_source_branch": true,
+		"source": map[string]interface{}{
+			"branch": map[string]string{"name": branch},
+		},
+		"destination": map[string]interface{}{
+			"branch": map[string]string{"name": base},
+		},
+	}
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests", repo.RepoWorkspace(), repo.RepoSlug())
+	var pr prshared.PullRequest
+	if err := apiClient.Post(repo.RepoHost(), path, payload, &pr); err != nil {
+		return cmdutil.WrapWriteError(err, repo)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Created pull request #%d\n", cs.SuccessIcon(), pr.ID)
+	fmt.Fprintf(opts.IO.Out, "%s\n", pr.HTMLURL())
+
+	return nil
+}
+
+func runGit(ctx context.Context, client *git.Client, args ...string) error {
+	cmd, err := client.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	return cmd.Run()
+}
+
+func diffOutput(ctx context.Context, client *git.Client) string {
+	cmd, err := client.Command(ctx, "diff", "--unified=0")
+	if err != nil {
+		return ""
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return string(out)
+}
+
+func description(command, changes []string) string {
+	out := fmt.Sprintf("Automated dependency update generated by running `%s`.", strings.Join(command, " "))
+	if len(changes) > 0 {
+		out += "\n\n## Version changes\n\n* " + strings.Join(changes, "\n* ")
+	}
+	return out
+}
+
+// depLineRE matches one "name version" line of a dependency manifest in its
+// common shapes: go.mod ("github.com/x/y v1.2.3"), package.json
+// ("\"x\": \"^1.2.3\","), and requirements.txt ("x==1.2.3").
+var depLineRE = regexp.MustCompile(`^[-+]\s*"?([A-Za-z0-9@_][A-Za-z0-9@_./-]*)"?\s*(?:==|:\s*|\s+)"?([\^~>=<]*v?\d[\w.+-]*)"?,?\s*(?://\s*indirect\s*)?$`)
+
+// versionChanges reads a unified diff of dependency manifests and pairs
+// removed and added lines per dependency into "name: old → new" bullets.
+// Lines that do not look like a dependency pin are ignored, so lockfile noise
+// and unrelated edits fall away.
+func versionChanges(diff string) []string {
+	removed := map[string]string{}
+	added := map[string]string{}
+
+	for _, line := range strings.Split(diff, "\n") {
+		if strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++") {
+			continue
+		}
+		m := depLineRE.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		if strings.HasPrefix(line, "-") {
+			removed[m[1]] = m[2]
+		} else {
+			added[m[1]] = m[2]
+		}
+	}
+
+	var changes []string
+	for name, newVersion := range added {
+		oldVersion, ok := removed[name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("%s: added %s", name, newVersion))
+			continue
+		}
+		if oldVersion != newVersion {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", name, oldVersion, newVersion))
+		}
+	}
+	for name, oldVersion := range removed {
+		if _, ok := added[name]; !ok {
+			changes = append(changes, fmt.Sprintf("%s: removed (was %s)", name, oldVersion))
+		}
+	}
+
+	sort.Strings(changes)
+	return changes
+}
@@ -0,0 +1,76 @@
+package bump
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		diff string
+		want []string
+	}{
+		{
+			name: "go.mod",
+			diff: heredoc.Doc(`
+				--- a/go.mod
+				+++ b/go.mod
+				@@ -5,2 +5,2 @@
+				-	github.com/spf13/cobra v1.8.0
+				+	github.com/spf13/cobra v1.8.1
+				@@ -9 +9,2 @@
+				-	github.com/old/dep v0.3.0
+				+	github.com/new/dep v1.0.0
+				+	golang.org/x/sync v0.7.0 // indirect
+			`),
+			want: []string{
+				"github.com/new/dep: added v1.0.0",
+				"github.com/old/dep: removed (was v0.3.0)",
+				"github.com/spf13/cobra: v1.8.0 → v1.8.1",
+				"golang.org/x/sync: added v0.7.0",
+			},
+		},
+		{
+			name: "package.json",
+			diff: heredoc.Doc(`
+				--- a/package.json
+				+++ b/package.json
+				@@ -12 +12 @@
+				-    "lodash": "^4.17.20",
+				+    "lodash": "^4.17.21",
+			`),
+			want: []string{`lodash: ^4.17.20 → ^4.17.21`},
+		},
+		{
+			name: "requirements.txt",
+			diff: heredoc.Doc(`
+				--- a/requirements.txt
+				+++ b/requirements.txt
+				@@ -1 +1 @@
+				-requests==2.31.0
+				+requests==2.32.3
+			`),
+			want: []string{"requests: 2.31.0 → 2.32.3"},
+		},
+		{
+			name: "unrelated edits are ignored",
+			diff: heredoc.Doc(`
+				--- a/main.go
+				+++ b/main.go
+				@@ -3 +3 @@
+				-	fmt.Println("hello")
+				+	fmt.Println("goodbye")
+			`),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, versionChanges(tt.diff))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package deps
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	bumpCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/deps/bump"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdDeps(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deps <command>",
+		Short: "Automate routine dependency work",
+		Long: heredoc.Doc(`
+			Automate routine dependency work, like turning an update command into
+			a reviewable pull request.
+		`),
+	}
+
+	cmd.AddCommand(bumpCmd.NewCmdBump(f, nil))
+
+	return cmd
+}
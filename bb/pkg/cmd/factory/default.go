@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"slices"
+	"sync"
 	"time"
 
 	xcolor "github.com/cli/go-gh/v2/pkg/x/color"
@@ -16,6 +17,7 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/profile"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -85,23 +87,32 @@ func remotesFunc(f *cmdutil.Factory) func() (bbContext.Remotes, error) {
 }
 
 func httpClientFunc(f *cmdutil.Factory, appVersion string) func() (*http.Client, error) {
+	// Build the client once and hand the same instance to every caller, so
+	// commands that fan out across goroutines share one connection pool
+	// instead of each opening their own.
+	var once sync.Once
+	var client *http.Client
+	var clientErr error
 	return func() (*http.Client, error) {
-		io := f.IOStreams
-		cfg, err := f.Config()
-		if err != nil {
-			return nil, err
-		}
-		opts := api.HTTPClientOptions{
-			Config:      cfg.Authentication(),
-			Log:         io.ErrOut,
-			LogColorize: io.ColorEnabled(),
-			AppVersion:  appVersion,
-		}
-		client, err := api.NewHTTPClient(opts)
-		if err != nil {
-			return nil, err
-		}
-		return client, nil
+		once.Do(func() {
+			io := f.IOStreams
+			cfg, err := f.Config()
+			if err != nil {
+				clientErr = err
+				return
+			}
+			opts := api.HTTPClientOptions{
+				Config:      cfg.Authentication(),
+				Log:         io.ErrOut,
+				LogColorize: io.ColorEnabled(),
+				AppVersion:  appVersion,
+				// Route requests against mapped workspaces to the right account
+				WorkspaceAccounts: cfg.WorkspaceAccounts().All(),
+			}
+			applyTransportOptions(cfg, &opts)
+			client, clientErr = api.NewHTTPClient(opts)
+		})
+		return client, clientErr
 	}
 }
 
@@ -115,6 +126,11 @@ func plainHttpClientFunc(f *cmdutil.Factory, appVersion string) func() (*http.Cl
 			// This is required to prevent automatic setting of auth and other headers.
 			SkipDefaultHeaders: true,
 		}
+		// Proxy and TLS settings still apply; pre-auth requests such as the
+		// login flow go through the same corporate proxies.
+		if cfg, err := f.Config(); err == nil {
+			applyTransportOptions(cfg, &opts)
+		}
 		client, err := api.NewHTTPClient(opts)
 		if err != nil {
 			return nil, err
@@ -123,6 +139,26 @@ func plainHttpClientFunc(f *cmdutil.Factory, appVersion string) func() (*http.Cl
 	}
 }
 
+// applyTransportOptions resolves proxy, CA bundle, and client certificate
+// settings for the default host, configured per host in hosts.yml. The
+// --ca-cert root flag is surfaced through BB_CA_BUNDLE and wins over config.
+func applyTransportOptions(cfg gh.Config, opts *api.HTTPClientOptions) {
+	hostname, _ := cfg.Authentication().DefaultHost()
+	lookup := func(key string) string {
+		return cfg.GetOrDefault(hostname, key).UnwrapOrZero().Value
+	}
+
+	opts.CABundle = os.Getenv("BB_CA_BUNDLE")
+	if opts.CABundle == "" {
+		opts.CABundle = lookup("ca_bundle")
+	}
+	opts.ClientCert = lookup("client_cert")
+	opts.ClientKey = lookup("client_key")
+	opts.Proxy = lookup("http_proxy")
+	opts.TLSMinVersion = lookup("tls_min_version")
+	opts.TLSCipherSuites = lookup("tls_ciphers")
+}
+
 func newGitClient(f *cmdutil.Factory) *git.Client {
 	io := f.IOStreams
 	bbPath := f.Executable()
@@ -137,7 +173,11 @@ func newGitClient(f *cmdutil.Factory) *git.Client {
 
 func newBrowser(f *cmdutil.Factory) browser.Browser {
 	io := f.IOStreams
-	return browser.New("", io.Out, io.ErrOut)
+	launcher := ""
+	if cfg, err := f.Config(); err == nil {
+		launcher = cfg.Browser("").Value
+	}
+	return browser.New(launcher, io.Out, io.ErrOut)
 }
 
 func newPrompter(f *cmdutil.Factory) prompter.Prompter {
@@ -153,6 +193,7 @@ func configFunc() func() (gh.Config, error) {
 		if cachedConfig != nil || configError != nil {
 			return cachedConfig, configError
 		}
+		defer profile.Track("config load")()
 		cachedConfig, configError = config.NewConfig()
 		return cachedConfig, configError
 	}
@@ -183,6 +224,15 @@ func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 
 	falseyValues := []string{"false", "0", "no", ""}
 
+	accessibleOutputValue, accessibleOutputIsSet := os.LookupEnv("BB_ACCESSIBLE_OUTPUT")
+	if accessibleOutputIsSet {
+		if !slices.Contains(falseyValues, accessibleOutputValue) {
+			io.SetAccessibleOutputEnabled(true)
+		}
+	} else if output := cfg.GetOrDefault("", "accessible_output"); output.IsSome() && output.Unwrap().Value == "enabled" {
+		io.SetAccessibleOutputEnabled(true)
+	}
+
 	accessiblePrompterValue, accessiblePrompterIsSet := os.LookupEnv("BB_ACCESSIBLE_PROMPTER")
 	if accessiblePrompterIsSet {
 		if !slices.Contains(falseyValues, accessiblePrompterValue) {
@@ -224,6 +274,24 @@ func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 
 	io.SetAccessibleColorsEnabled(xcolor.IsAccessibleColorsEnabled())
 
+	// Color role overrides from the theme config section. Naming the
+	// "accessible" theme starts from the high-contrast preset; explicit role
+	// entries apply on top of it.
+	theme := cfg.Theme()
+	overrides := map[string]string{}
+	if theme["name"] == "accessible" {
+		overrides = iostreams.AccessibleHighContrastTheme()
+	}
+	for role, spec := range theme {
+		if role == "name" {
+			continue
+		}
+		overrides[role] = spec
+	}
+	if len(overrides) > 0 {
+		io.SetColorOverrides(overrides)
+	}
+
 	return io
 }
 
@@ -0,0 +1,98 @@
+package delete
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	Config func() (gh.Config, error)
+	IO     *iostreams.IOStreams
+
+	Name string
+	All  bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete {<filter> | --all}",
+		Short: "Delete saved filters",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && !opts.All {
+				return cmdutil.FlagErrorf("specify a filter to delete or `--all`")
+			}
+			if len(args) > 0 && opts.All {
+				return cmdutil.FlagErrorf("cannot use `--all` with filter name")
+			}
+			if len(args) > 0 {
+				opts.Name = args[0]
+			}
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Delete all saved filters")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	filterCfg := cfg.Filters()
+
+	filters := make(map[string]string)
+	if opts.All {
+		filters = filterCfg.All()
+		if len(filters) == 0 {
+			return cmdutil.NewNoResultsError("no saved filters")
+		}
+	} else {
+		expansion, err := filterCfg.Get(opts.Name)
+		if err != nil {
+			return fmt.Errorf("no such filter %s", opts.Name)
+		}
+		filters[opts.Name] = expansion
+	}
+
+	for name := range filters {
+		if err := filterCfg.Delete(name); err != nil {
+			return fmt.Errorf("failed to delete filter %s: %w", name, err)
+		}
+	}
+
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		keys := make([]string, 0, len(filters))
+		for k := range filters {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Deleted filter %s; was %s\n", cs.SuccessIconWithColor(cs.Red), k, filters[k])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package filter
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	deleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/filter/delete"
+	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/filter/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdFilter(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filter <command>",
+		Short: "Manage saved filters for list commands",
+		Long: heredoc.Doc(`
+			Saved filters store a set of list flags under a name, so a recurring
+			query becomes a single flag.
+
+			Create one with the --save-as flag of a list command and replay it
+			with --filter.
+		`),
+		Example: heredoc.Doc(`
+			# Save a filter from a list command
+			$ bb pr list --state merged --author @me --save-as my-merged
+
+			# Replay it
+			$ bb pr list --filter my-merged
+
+			# Show all saved filters
+			$ bb filter list
+
+			# Delete a saved filter
+			$ bb filter delete my-merged
+		`),
+	}
+
+	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+
+	return cmd
+}
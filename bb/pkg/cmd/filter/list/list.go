@@ -0,0 +1,57 @@
+package list
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type ListOptions struct {
+	Config func() (gh.Config, error)
+	IO     *iostreams.IOStreams
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List your saved filters",
+		Aliases: []string{"ls"},
+		Long: heredoc.Doc(`
+			This command prints out all saved filters along with the command and
+			flags each one stores.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	filterMap := cfg.Filters().All()
+
+	if len(filterMap) == 0 {
+		return cmdutil.NewNoResultsError("no saved filters")
+	}
+
+	enc := yaml.NewEncoder(opts.IO.Out)
+	return enc.Encode(filterMap)
+}
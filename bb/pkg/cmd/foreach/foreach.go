@@ -0,0 +1,232 @@
+package foreach
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/progress"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ForeachOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Executable func() string
+
+	Workspace   string
+	Match       string
+	Jobs        int
+	Concurrency int
+	Progress    string
+	Args        []string
+}
+
+func NewCmdForeach(f *cmdutil.Factory, runF func(*ForeachOptions) error) *cobra.Command {
+	opts := &ForeachOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Executable: f.Executable,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "foreach --workspace <workspace> -- <command> [flags]",
+		Short: "Run a bb command against every repository in a workspace",
+		Long: heredoc.Docf(`
+			Run a bb command against every repository in a workspace, a bounded
+			number at a time, and report the per-repository results.
+
+			Everything after %[1]s--%[1]s is invoked once per repository with
+			%[1]s--repo workspace/slug%[1]s appended, so any command that accepts the
+			repository override flag can be fanned out. Use %[1]s--match%[1]s to
+			restrict the run to repositories whose slug matches a glob.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Set a secret in every repository of a workspace
+			$ bb foreach --workspace myworkspace -- secret set API_KEY --body hunter2
+
+			# Apply a branch restriction to the service repositories only
+			$ bb foreach --workspace myworkspace --match "service-*" -- api \
+			    'repositories/{workspace}/{repo_slug}/branch-restrictions' -f kind=push -f branch_match_kind=glob -f pattern=main
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Args = args
+
+			if cmd.Flags().Changed("jobs") && opts.Jobs < 1 {
+				return cmdutil.FlagErrorf("--jobs must be at least 1")
+			}
+			if opts.Progress != "" && opts.Progress != "json" {
+				return cmdutil.FlagErrorf("invalid --progress mode %q, valid options: json", opts.Progress)
+			}
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return foreachRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace whose repositories to run against (required)")
+	cmd.Flags().StringVarP(&opts.Match, "match", "m", "", "Only run against repositories whose slug matches a glob")
+	cmd.Flags().IntVarP(&opts.Jobs, "jobs", "j", 0, "Number of repositories to run against concurrently (default: the concurrency setting)")
+	cmd.Flags().StringVar(&opts.Progress, "progress", "", "Emit machine-readable progress events to stderr: {json}")
+	_ = cmd.MarkFlagRequired("workspace")
+
+	return cmd
+}
+
+// repoResult is the outcome of one repository's invocation.
+type repoResult struct {
+	fullName string
+	output   []byte
+	err      error
+}
+
+func foreachRun(opts *ForeachOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	hostname := bbinstance.Default()
+	var cfg gh.Config
+	if c, err := opts.Config(); err == nil {
+		cfg = c
+		if defaultHost, _ := cfg.Authentication().DefaultHost(); defaultHost != "" && !strings.HasSuffix(defaultHost, "github.com") {
+			hostname = defaultHost
+		}
+	}
+	if opts.Jobs < 1 {
+		opts.Jobs = cmdutil.Concurrency(opts.Concurrency, cfg)
+	}
+
+	opts.IO.StartProgressIndicator()
+	repos, err := fetchRepositories(httpClient, hostname, opts.Workspace, opts.Match)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(repos) == 0 {
+		if opts.Match != "" {
+			return cmdutil.NewNoResultsError(fmt.Sprintf("no repositories in %s match %q", opts.Workspace, opts.Match))
+		}
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no repositories found in %s", opts.Workspace))
+	}
+
+	var emitter *progress.Emitter
+	if opts.Progress == "json" {
+		emitter = progress.New(opts.IO.ErrOut, "foreach")
+	}
+	emitter.Start(len(repos))
+
+	results := runCommands(opts, repos, emitter)
+
+	cs := opts.IO.ColorScheme()
+	failed := 0
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.FailureIcon(), cs.Bold(result.fullName))
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), cs.Bold(result.fullName))
+		}
+		if output := bytes.TrimSpace(result.output); len(output) > 0 {
+			fmt.Fprintf(opts.IO.Out, "%s\n", output)
+		}
+	}
+
+	emitter.Done(fmt.Sprintf("ran against %d repositories", len(results)))
+
+	if failed > 0 {
+		return fmt.Errorf("command failed for %d of %d repositories", failed, len(results))
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\n%s Ran against %d repositories\n", cs.SuccessIcon(), len(results))
+	}
+	return nil
+}
+
+func fetchRepositories(client *http.Client, hostname, workspace, match string) ([]string, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var repos []string
+	requestPath := fmt.Sprintf("repositories/%s?pagelen=100", workspace)
+	nextURL := ""
+	for {
+		var page api.PaginatedResponse[api.Repository]
+		var err error
+		if nextURL == "" {
+			nextURL, err = apiClient.RESTWithNext(hostname, http.MethodGet, requestPath, nil, &page)
+		} else {
+			nextURL, err = apiClient.RESTWithNextURL(http.MethodGet, nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range page.Values {
+			if match != "" {
+				if ok, err := path.Match(match, repo.Slug); err != nil {
+					return nil, cmdutil.FlagErrorf("invalid --match glob %q", match)
+				} else if !ok {
+					continue
+				}
+			}
+			repos = append(repos, fmt.Sprintf("%s/%s", workspace, repo.Slug))
+		}
+
+		if nextURL == "" {
+			return repos, nil
+		}
+	}
+}
+
+// runCommands invokes the child command for every repository, at most
+// opts.Jobs at a time, and returns the results in repository order.
+func runCommands(opts *ForeachOptions, repos []string, emitter *progress.Emitter) []repoResult {
+	results := make([]repoResult, len(repos))
+	sem := make(chan struct{}, opts.Jobs)
+	var wg sync.WaitGroup
+	var completed atomic.Int64
+
+	for i, fullName := range repos {
+		wg.Add(1)
+		go func(i int, fullName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			args := append(append([]string{}, opts.Args...), "--repo", fullName)
+			cmd := exec.Command(opts.Executable(), args...)
+			cmd.Env = os.Environ()
+			output, err := cmd.CombinedOutput()
+			results[i] = repoResult{fullName: fullName, output: output, err: err}
+
+			if err != nil {
+				emitter.Error(fullName, err.Error())
+			}
+			emitter.Progress(fullName, int(completed.Add(1)), len(repos))
+		}(i, fullName)
+	}
+
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,87 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/history"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type HistoryOptions struct {
+	IO *iostreams.IOStreams
+
+	Limit int
+}
+
+func NewCmdHistory(f *cmdutil.Factory, runF func(*HistoryOptions) error) *cobra.Command {
+	opts := &HistoryOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List recently run bb commands",
+		Long: heredoc.Doc(`
+			List the most recently run bb commands, newest first.
+
+			The number next to each command can be passed to "bb redo" to run
+			it again. Values of secret-bearing flags are redacted before the
+			history is stored.
+		`),
+		Example: heredoc.Doc(`
+			$ bb history
+			$ bb redo 3
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return historyRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 20, "Maximum number of commands to show")
+
+	return cmd
+}
+
+func historyRun(opts *HistoryOptions) error {
+	entries, err := history.List()
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return cmdutil.NewNoResultsError("no command history recorded yet")
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("N", "COMMAND", "WHEN"))
+	cs := opts.IO.ColorScheme()
+	now := time.Now()
+
+	// Newest first, numbered so "bb redo 1" is the latest command
+	for i := len(entries) - 1; i >= 0 && len(entries)-i <= opts.Limit; i-- {
+		entry := entries[i]
+		tp.AddField(fmt.Sprintf("%d", len(entries)-i), tableprinter.WithColor(cs.Cyan))
+		tp.AddField("bb " + strings.Join(entry.Args, " "))
+		if tp.IsTTY() {
+			tp.AddField(text.FuzzyAgo(now, entry.Time), tableprinter.WithColor(cs.Gray))
+		} else {
+			tp.AddField(entry.Time.Format(time.RFC3339))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,26 @@
+package hooks
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	hooksInstallCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/hooks/install"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdHooks(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks <command>",
+		Short: "Manage local git hooks",
+		Long:  `Generate local git hooks from the repository's Bitbucket configuration.`,
+		Example: heredoc.Doc(`
+			$ bb hooks install
+			$ bb hooks install --force
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(hooksInstallCmd.NewCmdInstall(f, nil))
+
+	return cmd
+}
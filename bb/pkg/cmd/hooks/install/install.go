@@ -0,0 +1,267 @@
+package install
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// hookMarker identifies hooks written by this command, so reinstalling can
+// overwrite them without clobbering hand-written hooks.
+const hookMarker = "# generated by bb hooks install"
+
+type InstallOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Force bool
+}
+
+func NewCmdInstall(f *cmdutil.Factory, runF func(*InstallOptions) error) *cobra.Command {
+	opts := &InstallOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "install",
+		Short: "Install git hooks enforcing the repository's conventions",
+		Long: heredoc.Doc(`
+			Install local git hooks generated from the repository's Bitbucket
+			configuration, keeping local conventions in sync with team policy.
+
+			Two hooks are written into the repository's hooks directory:
+
+			- commit-msg rejects commits whose message carries no issue key.
+			  The key prefix comes from the repository's project, so a repo in
+			  project PROJ expects keys like PROJ-123. Merge, revert, and
+			  fixup commits pass through.
+			- pre-push rejects pushes from branches that match none of the
+			  branch prefixes in the repository's branching model.
+
+			Existing hooks not written by this command are left alone unless
+			--force is given.
+		`),
+		Example: heredoc.Doc(`
+			$ bb hooks install
+			$ bb hooks install --force
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return installRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite existing hooks not written by bb")
+
+	return cmd
+}
+
+func installRun(opts *InstallOptions) error {
+	ctx := context.Background()
+
+	gitDir, err := opts.GitClient.GitDir(ctx)
+	if err != nil {
+		return fmt.Errorf("not in a git repository")
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	projectKey, err := fetchProjectKey(apiClient, repo)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	prefixes, err := fetchBranchPrefixes(apiClient, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	hooksDir := filepath.Join(gitDir, "hooks")
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	hooks := []struct {
+		name    string
+		content string
+	}{
+		{"commit-msg", commitMsgHook(projectKey)},
+		{"pre-push", prePushHook(prefixes)},
+	}
+
+	for _, hook := range hooks {
+		path := filepath.Join(hooksDir, hook.name)
+		if existing, err := os.ReadFile(path); err == nil {
+			if !strings.Contains(string(existing), hookMarker) && !opts.Force {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Skipping %s: an existing hook is in the way (use --force to overwrite)\n",
+					cs.WarningIcon(), hook.name)
+				continue
+			}
+		}
+		if err := os.WriteFile(path, []byte(hook.content), 0755); err != nil {
+			return fmt.Errorf("failed to write %s hook: %w", hook.name, err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Installed %s hook\n", cs.SuccessIcon(), cs.Bold(hook.name))
+		}
+	}
+
+	return nil
+}
+
+// fetchProjectKey returns the key of the project the repository belongs to,
+// or "" when it belongs to none.
+func fetchProjectKey(apiClient *api.Client, repo bbrepo.Interface) (string, error) {
+	path := fmt.Sprintf("repositories/%s/%s?fields=project.key", repo.RepoWorkspace(), repo.RepoSlug())
+
+	var response struct {
+		Project *struct {
+			Key string `json:"key"`
+		} `json:"project"`
+	}
+	if err := apiClient.Get(repo.RepoHost(), path, &response); err != nil {
+		return "", err
+	}
+	if response.Project == nil {
+		return "", nil
+	}
+	return response.Project.Key, nil
+}
+
+// fetchBranchPrefixes returns the branch type prefixes of the repository's
+// branching model, e.g. feature/ and hotfix/, plus its development and
+// production branch names so pushes from those always pass.
+func fetchBranchPrefixes(apiClient *api.Client, repo bbrepo.Interface) ([]string, error) {
+	path := fmt.Sprintf("repositories/%s/%s/branching-model", repo.RepoWorkspace(), repo.RepoSlug())
+
+	var model struct {
+		BranchTypes []struct {
+			Prefix string `json:"prefix"`
+		} `json:"branch_types"`
+		Development *struct {
+			Name string `json:"name"`
+		} `json:"development"`
+		Production *struct {
+			Name string `json:"name"`
+		} `json:"production"`
+	}
+	if err := apiClient.Get(repo.RepoHost(), path, &model); err != nil {
+		// A repository without a branching model gets no pre-push check
+		if api.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var prefixes []string
+	for _, bt := range model.BranchTypes {
+		if bt.Prefix != "" {
+			prefixes = append(prefixes, bt.Prefix)
+		}
+	}
+	if model.Development != nil && model.Development.Name != "" {
+		prefixes = append(prefixes, model.Development.Name)
+	}
+	if model.Production != nil && model.Production.Name != "" {
+		prefixes = append(prefixes, model.Production.Name)
+	}
+	return prefixes, nil
+}
+
+// commitMsgHook renders the commit-msg hook. With a project key the message
+// must carry a key like PROJ-123; without one, any Jira-style key will do.
+func commitMsgHook(projectKey string) string {
+	pattern := `[A-Z][A-Z0-9]+-[0-9]+`
+	if projectKey != "" {
+		pattern = projectKey + `-[0-9]+`
+	}
+	return fmt.Sprintf(`#!/bin/sh
+%s
+# Require an issue key in the commit message. Reinstall with: bb hooks install
+
+msg=$(cat "$1")
+
+# Let git-generated commits through
+case "$msg" in
+	Merge*|Revert*|fixup!*|squash!*) exit 0 ;;
+esac
+
+if ! printf '%%s' "$msg" | grep -qE '%s'; then
+	echo "commit-msg: message must reference an issue key (%s)" >&2
+	exit 1
+fi
+`, hookMarker, pattern, pattern)
+}
+
+// prePushHook renders the pre-push hook, or a no-op hook when the repository
+// declares no branch prefixes.
+func prePushHook(prefixes []string) string {
+	if len(prefixes) == 0 {
+		return fmt.Sprintf(`#!/bin/sh
+%s
+# The repository declares no branching model; nothing to check.
+exit 0
+`, hookMarker)
+	}
+	return fmt.Sprintf(`#!/bin/sh
+%s
+# Require branch names to follow the repository's branching model.
+# Reinstall with: bb hooks install
+
+branch=$(git symbolic-ref --short HEAD 2>/dev/null) || exit 0
+
+case "$branch" in
+	%s) exit 0 ;;
+esac
+
+echo "pre-push: branch '$branch' does not match the branching model (expected one of: %s)" >&2
+exit 1
+`, hookMarker, branchCasePatterns(prefixes), strings.Join(prefixes, ", "))
+}
+
+// branchCasePatterns turns branch prefixes into shell case patterns: a value
+// ending in "/" matches as a prefix, anything else must match exactly.
+func branchCasePatterns(prefixes []string) string {
+	patterns := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		if strings.HasSuffix(prefix, "/") {
+			patterns[i] = prefix + "*"
+		} else {
+			patterns[i] = prefix
+		}
+	}
+	return strings.Join(patterns, "|")
+}
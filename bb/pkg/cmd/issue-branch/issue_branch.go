@@ -0,0 +1,150 @@
+package issuebranch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/jira"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type IssueBranchOptions struct {
+	IO              *iostreams.IOStreams
+	PlainHttpClient func() (*http.Client, error)
+	Config          func() (gh.Config, error)
+	GitClient       *git.Client
+
+	IssueKey string
+}
+
+var issueKeyArgRE = regexp.MustCompile(`^[A-Z][A-Z0-9]+-[1-9][0-9]*$`)
+
+func NewCmdIssueBranch(f *cmdutil.Factory, runF func(*IssueBranchOptions) error) *cobra.Command {
+	opts := &IssueBranchOptions{
+		IO:              f.IOStreams,
+		PlainHttpClient: f.PlainHttpClient,
+		Config:          f.Config,
+		GitClient:       f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "issue-branch <issue-key>",
+		Short: "Create a branch named after a Jira issue",
+		Long: heredoc.Doc(`
+			Create and check out a git branch named after a Jira issue, using the
+			issue key and a slug of its summary, e.g. "PROJ-123-add-login-page".
+
+			Keeping the issue key in the branch name lets Jira and "bb pr view --jira"
+			link the work back to the issue. The Jira connection is configured with
+			the jira_base_url, jira_email, and jira_api_token config keys.
+		`),
+		Example: heredoc.Doc(`
+			# Create a branch for PROJ-123 from the current HEAD
+			$ bb issue-branch PROJ-123
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.IssueKey = strings.ToUpper(args[0])
+
+			if !issueKeyArgRE.MatchString(opts.IssueKey) {
+				return cmdutil.FlagErrorf("invalid Jira issue key %q; expected a key like PROJ-123", args[0])
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return issueBranchRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func issueBranchRun(opts *IssueBranchOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	httpClient, err := opts.PlainHttpClient()
+	if err != nil {
+		return err
+	}
+
+	client, err := jira.NewClientFromConfig(httpClient, cfg)
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.Issue(opts.IssueKey)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+	branch := branchName(issue.Key, issue.Summary)
+
+	// Switch to an existing branch rather than failing; the command is a
+	// natural way to get back to in-progress work on an issue
+	if opts.GitClient.HasLocalBranch(ctx, branch) {
+		if err := opts.GitClient.CheckoutBranch(ctx, branch); err != nil {
+			return err
+		}
+		fmt.Fprintf(opts.IO.ErrOut, "%s Switched to existing branch %s\n", cs.SuccessIcon(), cs.Bold(branch))
+		return nil
+	}
+
+	gitCmd, err := opts.GitClient.Command(ctx, "checkout", "-b", branch)
+	if err != nil {
+		return err
+	}
+	if err := gitCmd.Run(); err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", branch, err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created branch %s for %s: %s\n",
+		cs.SuccessIcon(), cs.Bold(branch), cs.Accent(issue.Key), issue.Summary)
+
+	return nil
+}
+
+// branchName builds "<KEY>-<slug>" from the issue key and summary. The key
+// keeps its casing so Jira recognizes it; the summary is lowercased, reduced
+// to alphanumeric words joined by hyphens, and truncated at a word boundary.
+func branchName(key, summary string) string {
+	const maxSlugLength = 50
+
+	var b strings.Builder
+	lastHyphen := true
+	for _, r := range strings.ToLower(summary) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			lastHyphen = false
+		case !lastHyphen:
+			b.WriteByte('-')
+			lastHyphen = true
+		}
+	}
+
+	slug := strings.Trim(b.String(), "-")
+	if len(slug) > maxSlugLength {
+		slug = slug[:maxSlugLength]
+		if i := strings.LastIndex(slug, "-"); i > 0 {
+			slug = slug[:i]
+		}
+	}
+
+	if slug == "" {
+		return key
+	}
+	return key + "-" + slug
+}
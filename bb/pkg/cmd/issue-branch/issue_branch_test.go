@@ -0,0 +1,45 @@
+package issuebranch
+
+import "testing"
+
+func TestBranchName(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		summary string
+		want    string
+	}{
+		{
+			name:    "simple summary",
+			key:     "PROJ-123",
+			summary: "Add login page",
+			want:    "PROJ-123-add-login-page",
+		},
+		{
+			name:    "punctuation collapsed",
+			key:     "PROJ-7",
+			summary: "Fix: NPE in parser (again!)",
+			want:    "PROJ-7-fix-npe-in-parser-again",
+		},
+		{
+			name:    "long summary truncated at word boundary",
+			key:     "PROJ-1",
+			summary: "A very long issue summary that keeps going well beyond the limit",
+			want:    "PROJ-1-a-very-long-issue-summary-that-keeps-going-well",
+		},
+		{
+			name:    "empty summary falls back to the key",
+			key:     "PROJ-9",
+			summary: "???",
+			want:    "PROJ-9",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := branchName(tt.key, tt.summary); got != tt.want {
+				t.Errorf("branchName(%q, %q) = %q, want %q", tt.key, tt.summary, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package assign
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/membercache"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AssignOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	SelectorArg string
+	Assignee    string
+}
+
+func NewCmdAssign(f *cmdutil.Factory, runF func(*AssignOptions) error) *cobra.Command {
+	opts := &AssignOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "assign <number> [@me | <nickname>]",
+		Short: "Assign an issue to a user",
+		Long: heredoc.Doc(`
+			Assign an issue to a user.
+
+			The assignee can be "@me" for the authenticated user, or a user's
+			nickname. Without an assignee argument, the issue is assigned to you.
+		`),
+		Example: heredoc.Doc(`
+			# Assign issue #123 to yourself
+			$ bb issue assign 123
+
+			# Assign issue #123 to yourself explicitly
+			$ bb issue assign 123 @me
+
+			# Assign issue #123 to another user
+			$ bb issue assign 123 janedoe
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+			if len(args) > 1 {
+				opts.Assignee = args[1]
+			} else {
+				opts.Assignee = "@me"
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return assignRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func assignRun(opts *AssignOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	// Parse the issue argument
+	issueID, issueRepo, err := shared.ParseIssueArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	// Use the repo from URL if provided, otherwise resolve from git remotes
+	var repo bbrepo.Interface
+	if issueRepo != nil {
+		repo = issueRepo
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+
+	var payload issueAssignPayload
+	assigneeDisplay := opts.Assignee
+	if opts.Assignee == "@me" {
+		user, err := api.CurrentUser(apiClient, repo.RepoHost())
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("failed to resolve @me: %w", err)
+		}
+		payload.Assignee = &userRef{UUID: user.UUID}
+		assigneeDisplay = user.DisplayName
+	} else {
+		// Resolve the nickname to a UUID through the member cache when possible
+		if members, err := membercache.Members(apiClient, repo.RepoHost(), repo.RepoWorkspace()); err == nil {
+			if m := membercache.Match(members, opts.Assignee); m != nil {
+				payload.Assignee = &userRef{UUID: m.UUID}
+				assigneeDisplay = m.DisplayName
+			}
+		}
+		if payload.Assignee == nil {
+			payload.Assignee = &userRef{Username: opts.Assignee}
+		}
+	}
+
+	path := fmt.Sprintf("repositories/%s/%s/issues/%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), issueID)
+	err = apiClient.Put(repo.RepoHost(), path, payload, nil)
+
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("issue #%d not found", issueID)
+		}
+		return fmt.Errorf("failed to assign issue: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Assigned issue #%d to %s\n", cs.SuccessIcon(), issueID, cs.Bold(assigneeDisplay))
+
+	return nil
+}
+
+type issueAssignPayload struct {
+	Assignee *userRef `json:"assignee"`
+}
+
+type userRef struct {
+	UUID     string `json:"uuid,omitempty"`
+	Username string `json:"username,omitempty"`
+}
@@ -143,7 +143,7 @@ func createRun(opts *CreateOptions) error {
 	}
 
 	if resp.StatusCode >= 400 {
-		return api.HandleHTTPError(resp)
+		return cmdutil.WrapWriteError(api.HandleHTTPError(resp), repo)
 	}
 
 	var issue shared.Issue
@@ -3,10 +3,12 @@ package edit
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -17,23 +19,26 @@ import (
 type EditOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
 
 	SelectorArg string
 
-	Title    string
-	Body     string
-	BodyFile string
-	State    string
-	Priority string
-	Kind     string
-	Assignee string
+	Title     string
+	Body      string
+	BodyFile  string
+	State     string
+	Priority  string
+	Kind      string
+	Assignee  string
+	UseEditor bool
 }
 
 func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
 	opts := &EditOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
 	}
 
@@ -45,6 +50,10 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 
 			You can modify the title, description, state, priority, kind, or assignee
 			of an issue.
+
+			With --editor, the issue opens in your editor as a markdown document
+			with the structured fields as front matter; the parsed changes are
+			applied on save.
 		`),
 		Example: heredoc.Doc(`
 			$ bb issue edit 123 --title "New title"
@@ -53,6 +62,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 			$ bb issue edit 123 --priority major
 			$ bb issue edit 123 --kind bug
 			$ bb issue edit 123 --assignee username
+			$ bb issue edit 123 --editor
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -74,8 +84,11 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 				opts.Kind != "" ||
 				opts.Assignee != ""
 
-			if !hasEdits {
-				return cmdutil.FlagErrorf("at least one edit flag is required: --title, --body, --body-file, --state, --priority, --kind, or --assignee")
+			if opts.UseEditor && hasEdits {
+				return cmdutil.FlagErrorf("--editor cannot be combined with other edit flags")
+			}
+			if !hasEdits && !opts.UseEditor {
+				return cmdutil.FlagErrorf("at least one edit flag is required: --title, --body, --body-file, --state, --priority, --kind, --assignee, or --editor")
 			}
 
 			// Validate state if provided
@@ -137,6 +150,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Priority, "priority", "p", "", "Set the priority (trivial, minor, major, critical, blocker)")
 	cmd.Flags().StringVarP(&opts.Kind, "kind", "k", "", "Set the kind (bug, enhancement, proposal, task)")
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Set the assignee by username")
+	cmd.Flags().BoolVarP(&opts.UseEditor, "editor", "e", false, "Edit the issue as a markdown document in your editor")
 
 	return cmd
 }
@@ -170,6 +184,17 @@ func editRun(opts *EditOptions) error {
 		return err
 	}
 
+	if opts.UseEditor {
+		changed, err := editInEditor(opts, issue)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			fmt.Fprintln(opts.IO.ErrOut, "No changes made, nothing to do")
+			return nil
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
 
 	// Build the update payload
@@ -187,12 +212,101 @@ func editRun(opts *EditOptions) error {
 	if opts.IO.IsStdoutTTY() {
 		cs := opts.IO.ColorScheme()
 		fmt.Fprintf(opts.IO.Out, "%s Edited issue #%d\n", cs.SuccessIcon(), issueID)
+		// Re-fetch the issue so the summary reflects what the server actually
+		// stored, not just what was requested
+		if updated, err := list.FetchIssue(httpClient, repo, issueID); err == nil {
+			cmdutil.PrintFieldChanges(opts.IO, issueFieldChanges(issue, updated))
+		}
 		fmt.Fprintln(opts.IO.Out, issue.HTMLURL())
 	}
 
 	return nil
 }
 
+// issueFieldChanges compares the issue before and after the edit for the
+// fields this command can modify.
+func issueFieldChanges(before, after *shared.Issue) []cmdutil.FieldChange {
+	body := func(issue *shared.Issue) string {
+		if issue.Content != nil {
+			return issue.Content.Raw
+		}
+		return ""
+	}
+	assignee := func(issue *shared.Issue) string {
+		if issue.Assignee != nil {
+			return issue.Assignee.Nickname
+		}
+		return ""
+	}
+	return []cmdutil.FieldChange{
+		{Name: "title", Before: before.Title, After: after.Title},
+		{Name: "body", Before: body(before), After: body(after)},
+		{Name: "state", Before: before.State, After: after.State},
+		{Name: "kind", Before: before.Kind, After: after.Kind},
+		{Name: "priority", Before: before.Priority, After: after.Priority},
+		{Name: "assignee", Before: assignee(before), After: assignee(after)},
+	}
+}
+
+// editInEditor round-trips the issue through the user's editor as a markdown
+// document and stores any changed values on opts, so the regular update
+// payload picks them up. It reports whether anything was changed.
+func editInEditor(opts *EditOptions, issue *shared.Issue) (bool, error) {
+	assignee := ""
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.Nickname
+	}
+	body := ""
+	if issue.Content != nil {
+		body = issue.Content.Raw
+	}
+
+	fields := []cmdutil.MarkdownDocField{
+		{Name: "state", Value: issue.State},
+		{Name: "kind", Value: issue.Kind},
+		{Name: "priority", Value: issue.Priority},
+		{Name: "assignee", Value: assignee},
+	}
+
+	edited, err := cmdutil.EditMarkdownDoc(opts.IO, opts.Config, cmdutil.RenderMarkdownDoc(fields, issue.Title, body))
+	if err != nil {
+		return false, err
+	}
+
+	parsed, title, newBody, err := cmdutil.ParseMarkdownDoc(edited)
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	if title != issue.Title {
+		opts.Title = title
+		changed = true
+	}
+	if newBody != strings.TrimRight(body, "\n") {
+		opts.Body = newBody
+		changed = true
+	}
+	if v := parsed["state"]; v != issue.State {
+		opts.State = v
+		changed = true
+	}
+	if v := parsed["kind"]; v != issue.Kind {
+		opts.Kind = v
+		changed = true
+	}
+	if v := parsed["priority"]; v != issue.Priority {
+		opts.Priority = v
+		changed = true
+	}
+	if v := parsed["assignee"]; v != assignee {
+		opts.Assignee = v
+		changed = true
+	}
+
+	return changed, nil
+}
+
 // IssueUpdatePayload represents the fields that can be updated on an issue
 type IssueUpdatePayload struct {
 	Title    string         `json:"title,omitempty"`
@@ -1,12 +1,15 @@
 package issue
 
 import (
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/assign"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/close"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/comment"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/create"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/edit"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/reopen"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/report"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/snooze"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/view"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -33,12 +36,15 @@ $ bb issue create
 	cmdutil.EnableRepoOverride(cmd, f)
 
 	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(report.NewCmdReport(f, nil))
 	cmd.AddCommand(view.NewCmdView(f, nil))
 	cmd.AddCommand(create.NewCmdCreate(f, nil))
 	cmd.AddCommand(edit.NewCmdEdit(f, nil))
 	cmd.AddCommand(close.NewCmdClose(f, nil))
 	cmd.AddCommand(reopen.NewCmdReopen(f, nil))
 	cmd.AddCommand(comment.NewCmdComment(f, nil))
+	cmd.AddCommand(assign.NewCmdAssign(f, nil))
+	cmd.AddCommand(snooze.NewCmdSnooze(f, nil))
 
 	return cmd
 }
@@ -7,14 +7,45 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
+	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 )
 
-func fetchIssues(client *http.Client, repo bbrepo.Interface, opts *ListOptions) ([]shared.Issue, error) {
-	// Build query parameters
+// issueBatch is one display batch of issues plus the cursor and total needed
+// to keep paginating interactively. rest holds rows fetched beyond the
+// display limit; feeding them back as the next batch's carried rows keeps the
+// page-boundary cursor from skipping them.
+type issueBatch struct {
+	issues []shared.Issue
+	rest   []shared.Issue
+	next   string
+	total  int
+}
+
+func fetchIssues(client *http.Client, repo bbrepo.Interface, opts *ListOptions) (issueBatch, error) {
+	query, err := issueFilterQuery(client, repo.RepoHost(), opts)
+	if err != nil {
+		return issueBatch{}, err
+	}
+
+	apiURL := fmt.Sprintf("%srepositories/%s/%s/issues?%s",
+		bbinstance.RESTPrefix(repo.RepoHost()),
+		repo.RepoWorkspace(),
+		repo.RepoSlug(),
+		query.Encode(),
+	)
+
+	return fetchIssuePages(client, apiURL, opts.Limit, nil)
+}
+
+// issueFilterQuery translates the list filters into issue endpoint query
+// parameters.
+func issueFilterQuery(client *http.Client, hostname string, opts *ListOptions) (url.Values, error) {
 	query := url.Values{}
 
 	// Build filter query
@@ -40,12 +71,36 @@ func fetchIssues(client *http.Client, repo bbrepo.Interface, opts *ListOptions)
 
 	// Assignee filter
 	if opts.Assignee != "" {
-		filters = append(filters, fmt.Sprintf(`assignee.nickname="%s"`, opts.Assignee))
+		assignee, err := api.ResolveUserNickname(api.NewClientFromHTTP(client), hostname, opts.Assignee)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, fmt.Sprintf(`assignee.nickname="%s"`, assignee))
 	}
 
 	// Reporter filter
 	if opts.Reporter != "" {
-		filters = append(filters, fmt.Sprintf(`reporter.nickname="%s"`, opts.Reporter))
+		reporter, err := api.ResolveUserNickname(api.NewClientFromHTTP(client), hostname, opts.Reporter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, fmt.Sprintf(`reporter.nickname="%s"`, reporter))
+	}
+
+	// Date filters
+	if opts.Created != "" {
+		q, err := cmdutil.BuildDateQuery("created_on", opts.Created)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, q)
+	}
+	if opts.Updated != "" {
+		q, err := cmdutil.BuildDateQuery("updated_on", opts.Updated)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, q)
 	}
 
 	if len(filters) > 0 {
@@ -53,56 +108,66 @@ func fetchIssues(client *http.Client, repo bbrepo.Interface, opts *ListOptions)
 	}
 
 	// Pagination
-	if opts.Limit > 0 && opts.Limit <= 100 {
-		query.Set("pagelen", fmt.Sprintf("%d", opts.Limit))
-	}
+	query.Set("pagelen", fmt.Sprintf("%d", min(opts.Limit, 100)))
 
 	// Sort by updated date descending
 	query.Set("sort", "-updated_on")
 
-	// Build URL
-	apiURL := fmt.Sprintf("%srepositories/%s/%s/issues",
-		bbinstance.RESTPrefix(repo.RepoHost()),
-		repo.RepoWorkspace(),
-		repo.RepoSlug(),
-	)
-	if len(query) > 0 {
-		apiURL += "?" + query.Encode()
-	}
+	return query, nil
+}
 
-	req, err := http.NewRequest("GET", apiURL, nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Accept", "application/json")
+// fetchIssuePages follows the issue endpoint's pagination until limit issues
+// have been collected or the pages are exhausted, starting from carried (rows
+// held back from the previous batch). Rows beyond the limit land in rest
+// rather than being discarded, since pages don't align with the limit.
+func fetchIssuePages(client *http.Client, apiURL string, limit int, carried []shared.Issue) (issueBatch, error) {
+	batch := issueBatch{issues: carried, next: apiURL}
+	for apiURL != "" && len(batch.issues) < limit {
+		req, err := http.NewRequest("GET", apiURL, nil)
+		if err != nil {
+			return batch, err
+		}
+		req.Header.Set("Accept", "application/json")
 
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+		resp, err := client.Do(req)
+		if err != nil {
+			return batch, err
+		}
 
-	if resp.StatusCode == 404 {
-		return nil, fmt.Errorf("issue tracker is not enabled for this repository")
-	}
+		if resp.StatusCode == 404 {
+			resp.Body.Close()
+			return batch, fmt.Errorf("issue tracker is not enabled for this repository")
+		}
 
-	if resp.StatusCode != 200 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
-	}
+		if resp.StatusCode != 200 {
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			return batch, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		}
 
-	var result shared.IssueList
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
+		var result shared.IssueList
+		// Close within the iteration: a deferred close would hold every
+		// page's body open until the last page is fetched
+		decodeErr := json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if decodeErr != nil {
+			return batch, decodeErr
+		}
 
-	// Limit results if needed
-	issues := result.Values
-	if opts.Limit > 0 && len(issues) > opts.Limit {
-		issues = issues[:opts.Limit]
+		if result.Size > 0 {
+			batch.total = result.Size
+		}
+		batch.issues = append(batch.issues, result.Values...)
+		batch.next = result.Next
+
+		apiURL = result.Next
 	}
 
-	return issues, nil
+	if len(batch.issues) > limit {
+		batch.rest = batch.issues[limit:]
+		batch.issues = batch.issues[:limit]
+	}
+	return batch, nil
 }
 
 // FetchIssue fetches a single issue by ID
@@ -142,3 +207,98 @@ func FetchIssue(client *http.Client, repo bbrepo.Interface, issueID int) (*share
 
 	return &issue, nil
 }
+
+// workspaceRepoLimit caps how many repositories with issues enabled are
+// queried in --all-repos mode.
+const workspaceRepoLimit = 100
+
+// fetchWorkspaceIssues lists issues across every repository of a workspace
+// that has its issue tracker enabled, querying the repositories concurrently.
+func fetchWorkspaceIssues(client *http.Client, hostname, workspace string, workers int, opts *ListOptions) ([]shared.Issue, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	query, err := issueFilterQuery(client, hostname, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	type repoInfo struct {
+		FullName string `json:"full_name"`
+		Name     string `json:"name"`
+	}
+
+	var repos []repoInfo
+	path := fmt.Sprintf("repositories/%s?q=has_issues=true&pagelen=100", workspace)
+	nextURL := ""
+	for {
+		var page struct {
+			Values []repoInfo `json:"values"`
+			Next   string     `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(hostname, path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, page.Values...)
+		if len(repos) >= workspaceRepoLimit || page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+	if len(repos) > workspaceRepoLimit {
+		repos = repos[:workspaceRepoLimit]
+	}
+
+	// Query each repository through a bounded worker pool, keeping the
+	// per-repo results in repository order
+	results := make([][]shared.Issue, len(repos))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				repo := repos[i]
+				apiURL := fmt.Sprintf("%srepositories/%s/issues?%s",
+					bbinstance.RESTPrefix(hostname), repo.FullName, query.Encode())
+
+				batch, err := fetchIssuePages(client, apiURL, opts.Limit, nil)
+				if err != nil {
+					continue // Skip repos where we can't fetch issues
+				}
+				for j := range batch.issues {
+					if batch.issues[j].Repository == nil {
+						batch.issues[j].Repository = &shared.IssueRepository{
+							FullName: repo.FullName,
+							Name:     repo.Name,
+						}
+					}
+				}
+				results[i] = batch.issues
+			}
+		}()
+	}
+
+	for i := range repos {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var issues []shared.Issue
+	for _, repoIssues := range results {
+		issues = append(issues, repoIssues...)
+	}
+	if len(issues) > opts.Limit {
+		issues = issues[:opts.Limit]
+	}
+
+	return issues, nil
+}
@@ -3,11 +3,15 @@ package list
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bburl"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/snooze"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -20,21 +24,43 @@ type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
+	Browser    browser.Browser
+	Exporter   cmdutil.Exporter
 
-	State    string
-	Kind     string
-	Priority string
-	Assignee string
-	Reporter string
-	Limit    int
+	Web bool
+
+	State     string
+	Kind      string
+	Priority  string
+	Assignee  string
+	Reporter  string
+	Mine      bool
+	Created   string
+	Updated   string
+	Columns   []string
+	Limit     int
+	Filter    string
+	SaveAs    string
+	Workspace string
+	AllRepos  bool
+
+	Concurrency int
 }
 
+// listColumns registers every column this command can render. The names also
+// serve as the field list for --json.
+var listColumns = cmdutil.NewColumnSet(
+	[]string{"id", "title", "state", "kind", "priority", "reporter", "updated"},
+	"assignee", "created", "repo",
+)
+
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := &ListOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
+		Browser:    f.Browser,
 	}
 
 	cmd := &cobra.Command{
@@ -57,9 +83,47 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 			# List critical issues
 			$ bb issue list --priority critical
+
+			# List issues assigned to you
+			$ bb issue list --mine
+
+			# List open issues across every repository of a workspace
+			$ bb issue list --workspace myworkspace
 		`),
 		Aliases: []string{"ls"},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Filter != "" || opts.SaveAs != "" {
+				cfg, err := opts.Config()
+				if err != nil {
+					return err
+				}
+				if opts.Filter != "" {
+					if err := cmdutil.ApplyFilter(cfg, cmd, opts.Filter); err != nil {
+						return err
+					}
+				}
+				if opts.SaveAs != "" {
+					if err := cmdutil.SaveFilter(cfg, cmd, opts.SaveAs); err != nil {
+						return err
+					}
+				}
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --mine or --assignee",
+				opts.Mine, opts.Assignee != "",
+			); err != nil {
+				return err
+			}
+			if opts.Mine {
+				opts.Assignee = "@me"
+			}
+			// Naming a workspace implies listing across its repositories
+			if opts.Workspace != "" {
+				opts.AllRepos = true
+			}
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -70,79 +134,225 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.State, "state", "s", "open", "Filter by state: {new|open|resolved|on hold|invalid|duplicate|wontfix|closed|all}")
 	cmd.Flags().StringVarP(&opts.Kind, "kind", "k", "", "Filter by kind: {bug|enhancement|proposal|task}")
 	cmd.Flags().StringVarP(&opts.Priority, "priority", "p", "", "Filter by priority: {trivial|minor|major|critical|blocker}")
-	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
-	cmd.Flags().StringVar(&opts.Reporter, "reporter", "", "Filter by reporter")
+	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee (\"@me\" for yourself)")
+	cmd.Flags().StringVar(&opts.Reporter, "reporter", "", "Filter by reporter (\"@me\" for yourself)")
+	cmd.Flags().BoolVar(&opts.Mine, "mine", false, "Show only issues assigned to you")
+	cmd.Flags().StringVar(&opts.Created, "created", "", "Filter by creation date (e.g. \">2024-01-01\", \"<7d\")")
+	cmd.Flags().StringVar(&opts.Updated, "updated", "", "Filter by last update date (e.g. \">2024-01-01\", \"<7d\")")
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of issues to fetch")
+	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "List issues across the repositories of a `workspace`")
+	cmd.Flags().BoolVar(&opts.AllRepos, "all-repos", false, "List issues from every repository in the workspace")
+	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open the issue list in the browser")
+	cmdutil.AddColumnsFlag(cmd, &opts.Columns, listColumns)
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, listColumns.Fields())
+	cmdutil.AddExportFormatFlags(cmd, &opts.Exporter, listColumns.Fields())
+	cmdutil.AddFilterFlags(cmd, &opts.Filter, &opts.SaveAs)
 
 	return cmd
 }
 
 func listRun(opts *ListOptions) error {
+	if opts.Web {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		var statuses []string
+		switch opts.State {
+		case "open":
+			// The UI splits what the CLI calls "open" into two statuses
+			statuses = []string{"new", "open"}
+		default:
+			statuses = []string{opts.State}
+		}
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, bburl.Issues(repo, statuses...), false)
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
 	}
 
-	repo, err := opts.BaseRepo()
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	columns, err := cmdutil.ResolveColumns(cfg, "issue_list_columns", opts.Columns, listColumns)
 	if err != nil {
 		return err
 	}
 
-	issues, err := fetchIssues(httpClient, repo, opts)
+	var batch issueBatch
+	snoozedRepo := ""
+	if opts.AllRepos {
+		host, _ := cfg.Authentication().DefaultHost()
+		workspace := opts.Workspace
+		if workspace == "" {
+			if repo, err := opts.BaseRepo(); err == nil {
+				workspace = repo.RepoWorkspace()
+				host = repo.RepoHost()
+			} else {
+				workspace = cfg.DefaultWorkspace(host).Value
+			}
+		}
+		if workspace == "" {
+			return fmt.Errorf("no workspace specified; pass --workspace or set one with `bb config set default_workspace <workspace>`")
+		}
+
+		// The repo column is what makes merged results readable
+		if !slices.Contains(columns, "repo") {
+			columns = append([]string{"repo"}, columns...)
+		}
+
+		// The workspace fan-out merges per-repo results, so there is no
+		// single cursor to keep paginating from
+		batch.issues, err = fetchWorkspaceIssues(httpClient, host, workspace, cmdutil.Concurrency(opts.Concurrency, cfg), opts)
+	} else {
+		var repo bbrepo.Interface
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		snoozedRepo = bbrepo.FullName(repo)
+		batch, err = fetchIssues(httpClient, repo, opts)
+	}
 	if err != nil {
 		return err
 	}
+	batch.issues = dropSnoozed(batch.issues, snoozedRepo)
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, batch.issues)
+	}
 
-	if len(issues) == 0 {
+	if len(batch.issues) == 0 {
 		fmt.Fprintln(opts.IO.ErrOut, "No issues match your search")
 		return nil
 	}
 
-	return printIssues(opts.IO, issues)
+	// Render batch by batch; when more results remain and the terminal is
+	// interactive, offer to keep going instead of silently truncating.
+	shown := 0
+	for {
+		if err := printIssues(opts.IO, batch.issues, columns); err != nil {
+			return err
+		}
+		shown += len(batch.issues)
+
+		if (batch.next == "" && len(batch.rest) == 0) || !cmdutil.LoadMore(opts.IO, shown, batch.total) {
+			return nil
+		}
+
+		opts.IO.StartProgressIndicator()
+		batch, err = fetchIssuePages(httpClient, batch.next, opts.Limit, batch.rest)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		batch.issues = dropSnoozed(batch.issues, snoozedRepo)
+		if len(batch.issues) == 0 {
+			return nil
+		}
+	}
+}
+
+// dropSnoozed filters out issues hidden by a local snooze (see bb issue
+// snooze). fallbackRepo names the repository for issues fetched without
+// repository info of their own.
+func dropSnoozed(issues []shared.Issue, fallbackRepo string) []shared.Issue {
+	entries, err := snooze.List()
+	if err != nil || len(entries) == 0 {
+		return issues
+	}
+
+	hidden := map[string]bool{}
+	for _, e := range entries {
+		hidden[fmt.Sprintf("%s#%d", e.Repo, e.ID)] = true
+	}
+
+	kept := make([]shared.Issue, 0, len(issues))
+	for _, issue := range issues {
+		repo := fallbackRepo
+		if issue.Repository != nil && issue.Repository.FullName != "" {
+			repo = issue.Repository.FullName
+		}
+		if !hidden[fmt.Sprintf("%s#%d", repo, issue.ID)] {
+			kept = append(kept, issue)
+		}
+	}
+	return kept
 }
 
-func printIssues(io *iostreams.IOStreams, issues []shared.Issue) error {
+func printIssues(io *iostreams.IOStreams, issues []shared.Issue, columns []string) error {
 	cs := io.ColorScheme()
-	tp := tableprinter.New(io, tableprinter.WithHeader("ID", "TITLE", "STATE", "KIND", "PRIORITY", "REPORTER", "UPDATED"))
+	tp := tableprinter.New(io, tableprinter.WithHeader(columns...))
 
 	for _, issue := range issues {
 		// Color state
 		stateColor := cs.ColorFromString("white")
 		switch issue.State {
 		case "new", "open":
-			stateColor = cs.ColorFromString("green")
+			stateColor = cs.RoleColor("state_open", cs.ColorFromString("green"))
 		case "resolved", "closed":
-			stateColor = cs.ColorFromString("magenta")
+			stateColor = cs.RoleColor("state_closed", cs.ColorFromString("magenta"))
 		case "on hold":
-			stateColor = cs.ColorFromString("yellow")
+			stateColor = cs.RoleColor("state_pending", cs.ColorFromString("yellow"))
 		case "invalid", "duplicate", "wontfix":
-			stateColor = cs.ColorFromString("red")
+			stateColor = cs.RoleColor("state_declined", cs.ColorFromString("red"))
 		}
 
 		// Color priority
 		priorityColor := cs.ColorFromString("white")
 		switch issue.Priority {
 		case "blocker", "critical":
-			priorityColor = cs.ColorFromString("red")
+			priorityColor = cs.RoleColor("priority_critical", cs.ColorFromString("red"))
 		case "major":
-			priorityColor = cs.ColorFromString("yellow")
+			priorityColor = cs.RoleColor("priority_major", cs.ColorFromString("yellow"))
 		}
 
-		// Format updated time
-		updatedAt := issue.UpdatedOn
-		if t, err := time.Parse(time.RFC3339, issue.UpdatedOn); err == nil {
-			updatedAt = t.Format("2006-01-02")
+		for _, col := range columns {
+			switch col {
+			case "id":
+				tp.AddField(fmt.Sprintf("#%d", issue.ID))
+			case "title":
+				tp.AddField(issue.Title, tableprinter.WithTruncate(nil))
+			case "state":
+				tp.AddField(issue.StateDisplay(), tableprinter.WithColor(stateColor))
+			case "kind":
+				tp.AddField(issue.KindDisplay())
+			case "priority":
+				tp.AddField(issue.PriorityDisplay(), tableprinter.WithColor(priorityColor))
+			case "reporter":
+				tp.AddField(issue.Reporter.DisplayName)
+			case "assignee":
+				assignee := ""
+				if issue.Assignee != nil {
+					assignee = issue.Assignee.DisplayName
+				}
+				tp.AddField(assignee)
+			case "created":
+				tp.AddField(dateStr(issue.CreatedOn))
+			case "updated":
+				tp.AddField(dateStr(issue.UpdatedOn))
+			case "repo":
+				repoName := ""
+				if issue.Repository != nil {
+					repoName = issue.Repository.FullName
+				}
+				tp.AddField(repoName)
+			}
 		}
-
-		tp.AddField(fmt.Sprintf("#%d", issue.ID))
-		tp.AddField(issue.Title, tableprinter.WithTruncate(nil))
-		tp.AddField(issue.StateDisplay(), tableprinter.WithColor(stateColor))
-		tp.AddField(issue.KindDisplay())
-		tp.AddField(issue.PriorityDisplay(), tableprinter.WithColor(priorityColor))
-		tp.AddField(issue.Reporter.DisplayName)
-		tp.AddField(updatedAt)
 		tp.EndRow()
 	}
 
 	return tp.Render()
 }
+
+// dateStr shortens an RFC 3339 timestamp to its date for table display.
+func dateStr(ts string) string {
+	if t, err := time.Parse(time.RFC3339, ts); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return ts
+}
@@ -95,6 +95,20 @@ func TestNewCmdList(t *testing.T) {
 				Limit:    30,
 			},
 		},
+		{
+			name: "with mine",
+			cli:  "--mine",
+			wants: ListOptions{
+				State:    "open",
+				Assignee: "@me",
+				Limit:    30,
+			},
+		},
+		{
+			name:     "mine and assignee",
+			cli:      "--mine --assignee johndoe",
+			wantsErr: "specify only one of --mine or --assignee",
+		},
 		{
 			name: "with limit",
 			cli:  "-L 50",
@@ -0,0 +1,392 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Format string
+	SLA    []string
+	Limit  int
+
+	now func() time.Time
+}
+
+// ageBuckets are the boundaries open issues are grouped into, oldest last.
+var ageBuckets = []struct {
+	label string
+	max   time.Duration
+}{
+	{"< 1 day", 24 * time.Hour},
+	{"1-7 days", 7 * 24 * time.Hour},
+	{"7-30 days", 30 * 24 * time.Hour},
+	{"> 30 days", 1<<63 - 1},
+}
+
+// reportData is the aggregated summary, also the shape of --format json.
+type reportData struct {
+	TotalOpen  int            `json:"total_open"`
+	ByAge      []bucket       `json:"by_age"`
+	ByPriority []bucket       `json:"by_priority"`
+	ByAssignee []bucket       `json:"by_assignee"`
+	Breaches   []breachedItem `json:"sla_breaches"`
+}
+
+type bucket struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type breachedItem struct {
+	ID       int    `json:"id"`
+	Title    string `json:"title"`
+	Priority string `json:"priority"`
+	Assignee string `json:"assignee"`
+	Age      string `json:"age"`
+	SLA      string `json:"sla"`
+}
+
+func NewCmdReport(f *cmdutil.Factory, runF func(*ReportOptions) error) *cobra.Command {
+	opts := &ReportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		now:        time.Now,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Summarize open issues by age, priority, and assignee",
+		Long: heredoc.Doc(`
+			Aggregate the open issues of a repository into a standup-ready
+			summary: counts by age bucket, priority, and assignee, plus the
+			issues breaching their SLA.
+
+			An SLA is given as priority=duration and can be repeated; an open
+			issue older than the duration for its priority counts as a breach.
+			Durations accept h (hours), d (days), and w (weeks).
+		`),
+		Example: heredoc.Doc(`
+			# Summary with the default SLAs (blocker=2d, critical=7d)
+			$ bb issue report
+
+			# Tighten the blocker SLA and emit markdown for the standup notes
+			$ bb issue report --sla blocker=1d --sla critical=3d --format markdown
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return reportRun(opts)
+		},
+	}
+
+	cmdutil.StringEnumFlag(cmd, &opts.Format, "format", "", "table", []string{"table", "json", "markdown"}, "Output format")
+	cmd.Flags().StringArrayVar(&opts.SLA, "sla", []string{"blocker=2d", "critical=7d"}, "SLA per priority as `priority=duration`")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 500, "Maximum number of open issues to aggregate")
+
+	return cmd
+}
+
+func reportRun(opts *ReportOptions) error {
+	slas, err := parseSLAs(opts.SLA)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	issues, err := fetchOpenIssues(httpClient, repo, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	data := aggregate(issues, slas, opts.now())
+
+	switch opts.Format {
+	case "json":
+		encoded, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(opts.IO.Out, string(encoded))
+		return nil
+	case "markdown":
+		return printMarkdown(opts.IO, data)
+	default:
+		return printTable(opts.IO, data)
+	}
+}
+
+// parseSLAs parses repeated priority=duration pairs, where the duration uses
+// the h/d/w units the date filters accept.
+func parseSLAs(pairs []string) (map[string]time.Duration, error) {
+	slas := make(map[string]time.Duration, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, cmdutil.FlagErrorf("invalid SLA %q: expected priority=duration", pair)
+		}
+		d, err := parseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, cmdutil.FlagErrorf("invalid SLA %q: %v", pair, err)
+		}
+		slas[strings.ToLower(strings.TrimSpace(parts[0]))] = d
+	}
+	return slas, nil
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("expected a duration like 2d")
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("expected a duration like 2d")
+	}
+	switch s[len(s)-1] {
+	case 'h':
+		return time.Duration(n) * time.Hour, nil
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, nil
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	}
+	return 0, fmt.Errorf("unknown duration unit %q", s[len(s)-1:])
+}
+
+func fetchOpenIssues(client *http.Client, repo bbrepo.Interface, limit int) ([]shared.Issue, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	params := url.Values{}
+	params.Set("pagelen", fmt.Sprintf("%d", min(limit, 100)))
+	params.Set("q", `state = "new" OR state = "open" OR state = "on hold"`)
+	path := fmt.Sprintf("repositories/%s/%s/issues?%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), params.Encode())
+
+	var issues []shared.Issue
+	nextURL := ""
+	for {
+		var page shared.IssueList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Values {
+			issues = append(issues, issue)
+			if len(issues) >= limit {
+				return issues, nil
+			}
+		}
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return issues, nil
+}
+
+func aggregate(issues []shared.Issue, slas map[string]time.Duration, now time.Time) *reportData {
+	data := &reportData{TotalOpen: len(issues)}
+
+	ages := make([]int, len(ageBuckets))
+	priorities := map[string]int{}
+	assignees := map[string]int{}
+
+	for _, issue := range issues {
+		age := issueAge(issue, now)
+
+		for i, b := range ageBuckets {
+			if age < b.max {
+				ages[i]++
+				break
+			}
+		}
+
+		priorities[issue.Priority]++
+
+		assignee := "unassigned"
+		if issue.Assignee != nil && issue.Assignee.DisplayName != "" {
+			assignee = issue.Assignee.DisplayName
+		}
+		assignees[assignee]++
+
+		if sla, ok := slas[issue.Priority]; ok && age > sla {
+			data.Breaches = append(data.Breaches, breachedItem{
+				ID:       issue.ID,
+				Title:    issue.Title,
+				Priority: issue.Priority,
+				Assignee: assignee,
+				Age:      formatAge(age),
+				SLA:      formatAge(sla),
+			})
+		}
+	}
+
+	for i, b := range ageBuckets {
+		data.ByAge = append(data.ByAge, bucket{Name: b.label, Count: ages[i]})
+	}
+	data.ByPriority = sortedBuckets(priorities)
+	data.ByAssignee = sortedBuckets(assignees)
+	sort.Slice(data.Breaches, func(i, j int) bool { return data.Breaches[i].ID < data.Breaches[j].ID })
+
+	return data
+}
+
+func issueAge(issue shared.Issue, now time.Time) time.Duration {
+	t, err := time.Parse(time.RFC3339, issue.CreatedOn)
+	if err != nil {
+		return 0
+	}
+	return now.Sub(t)
+}
+
+// sortedBuckets orders counts descending, names alphabetically on ties, so
+// the report reads stably run to run.
+func sortedBuckets(counts map[string]int) []bucket {
+	buckets := make([]bucket, 0, len(counts))
+	for name, count := range counts {
+		buckets = append(buckets, bucket{Name: name, Count: count})
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].Count != buckets[j].Count {
+			return buckets[i].Count > buckets[j].Count
+		}
+		return buckets[i].Name < buckets[j].Name
+	})
+	return buckets
+}
+
+func formatAge(d time.Duration) string {
+	days := int(d.Hours() / 24)
+	if days < 1 {
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", days)
+}
+
+func printTable(io *iostreams.IOStreams, data *reportData) error {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintf(out, "%s\n\n", cs.Bold(fmt.Sprintf("%d open issues", data.TotalOpen)))
+
+	sections := []struct {
+		title   string
+		buckets []bucket
+	}{
+		{"By age", data.ByAge},
+		{"By priority", data.ByPriority},
+		{"By assignee", data.ByAssignee},
+	}
+	for _, section := range sections {
+		fmt.Fprintln(out, cs.Bold(section.title))
+		tp := tableprinter.New(io, tableprinter.NoHeader)
+		for _, b := range section.buckets {
+			tp.AddField(b.Name)
+			tp.AddField(fmt.Sprintf("%d", b.Count))
+			tp.EndRow()
+		}
+		if err := tp.Render(); err != nil {
+			return err
+		}
+		fmt.Fprintln(out)
+	}
+
+	if len(data.Breaches) == 0 {
+		fmt.Fprintf(out, "%s No SLA breaches\n", cs.SuccessIcon())
+		return nil
+	}
+
+	fmt.Fprintln(out, cs.Bold("SLA breaches"))
+	tp := tableprinter.New(io, tableprinter.WithHeader("#", "title", "priority", "assignee", "age", "sla"))
+	for _, b := range data.Breaches {
+		tp.AddField(fmt.Sprintf("%d", b.ID))
+		tp.AddField(b.Title)
+		tp.AddField(b.Priority, tableprinter.WithColor(cs.Red))
+		tp.AddField(b.Assignee)
+		tp.AddField(b.Age)
+		tp.AddField(b.SLA)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func printMarkdown(io *iostreams.IOStreams, data *reportData) error {
+	out := io.Out
+
+	fmt.Fprintf(out, "## Open issues: %d\n", data.TotalOpen)
+
+	sections := []struct {
+		title   string
+		buckets []bucket
+	}{
+		{"By age", data.ByAge},
+		{"By priority", data.ByPriority},
+		{"By assignee", data.ByAssignee},
+	}
+	for _, section := range sections {
+		fmt.Fprintf(out, "\n### %s\n\n", section.title)
+		fmt.Fprintln(out, "| | count |")
+		fmt.Fprintln(out, "| --- | --- |")
+		for _, b := range section.buckets {
+			fmt.Fprintf(out, "| %s | %d |\n", b.Name, b.Count)
+		}
+	}
+
+	fmt.Fprintf(out, "\n### SLA breaches\n\n")
+	if len(data.Breaches) == 0 {
+		fmt.Fprintln(out, "None")
+		return nil
+	}
+	fmt.Fprintln(out, "| # | title | priority | assignee | age | sla |")
+	fmt.Fprintln(out, "| --- | --- | --- | --- | --- | --- |")
+	for _, b := range data.Breaches {
+		fmt.Fprintf(out, "| %d | %s | %s | %s | %s | %s |\n",
+			b.ID, b.Title, b.Priority, b.Assignee, b.Age, b.SLA)
+	}
+	return nil
+}
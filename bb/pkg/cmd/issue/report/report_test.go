@@ -0,0 +1,61 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseSLAs(t *testing.T) {
+	slas, err := parseSLAs([]string{"blocker=2d", "critical = 12h", "major=1w"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{
+		"blocker":  48 * time.Hour,
+		"critical": 12 * time.Hour,
+		"major":    7 * 24 * time.Hour,
+	}, slas)
+
+	_, err = parseSLAs([]string{"blocker"})
+	assert.EqualError(t, err, `invalid SLA "blocker": expected priority=duration`)
+
+	_, err = parseSLAs([]string{"blocker=2x"})
+	assert.EqualError(t, err, `invalid SLA "blocker=2x": unknown duration unit "x"`)
+}
+
+func Test_aggregate(t *testing.T) {
+	now := time.Date(2026, 8, 29, 12, 0, 0, 0, time.UTC)
+	issue := func(id int, priority, created string, assignee string) shared.Issue {
+		i := shared.Issue{ID: id, Title: "t", Priority: priority, CreatedOn: created}
+		if assignee != "" {
+			i.Assignee = &shared.User{DisplayName: assignee}
+		}
+		return i
+	}
+
+	issues := []shared.Issue{
+		issue(1, "blocker", "2026-08-25T12:00:00Z", "Ann"), // 4 days old, breaches 2d SLA
+		issue(2, "major", "2026-08-29T06:00:00Z", "Ann"),   // 6 hours old
+		issue(3, "minor", "2026-07-01T12:00:00Z", ""),      // 59 days old
+	}
+
+	data := aggregate(issues, map[string]time.Duration{"blocker": 48 * time.Hour}, now)
+
+	assert.Equal(t, 3, data.TotalOpen)
+	assert.Equal(t, []bucket{
+		{Name: "< 1 day", Count: 1},
+		{Name: "1-7 days", Count: 1},
+		{Name: "7-30 days", Count: 0},
+		{Name: "> 30 days", Count: 1},
+	}, data.ByAge)
+	assert.Equal(t, []bucket{
+		{Name: "Ann", Count: 2},
+		{Name: "unassigned", Count: 1},
+	}, data.ByAssignee)
+
+	assert.Len(t, data.Breaches, 1)
+	assert.Equal(t, 1, data.Breaches[0].ID)
+	assert.Equal(t, "4d", data.Breaches[0].Age)
+	assert.Equal(t, "2d", data.Breaches[0].SLA)
+}
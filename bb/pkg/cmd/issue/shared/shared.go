@@ -1,12 +1,10 @@
 package shared
 
 import (
-	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/selector"
 )
 
 // Issue represents a Bitbucket issue
@@ -24,6 +22,15 @@ type Issue struct {
 	Links     Links    `json:"links"`
 	Votes     int      `json:"votes"`
 	Watches   int      `json:"watches"`
+
+	Repository *IssueRepository `json:"repository,omitempty"`
+}
+
+// IssueRepository identifies the repository an issue belongs to, which matters
+// when listing issues across a workspace.
+type IssueRepository struct {
+	FullName string `json:"full_name"`
+	Name     string `json:"name"`
 }
 
 type Content struct {
@@ -52,6 +59,37 @@ type Links struct {
 	} `json:"avatar"`
 }
 
+// Comment represents a comment on an issue.
+type Comment struct {
+	ID        int      `json:"id"`
+	Content   *Content `json:"content,omitempty"`
+	User      User     `json:"user"`
+	CreatedOn string   `json:"created_on"`
+	UpdatedOn string   `json:"updated_on"`
+	Parent    *struct {
+		ID int `json:"id"`
+	} `json:"parent,omitempty"`
+	Links Links `json:"links"`
+}
+
+// CommentList represents a paginated list of issue comments
+type CommentList struct {
+	Values []Comment `json:"values"`
+	Next   string    `json:"next"`
+}
+
+// Attachment represents a file attached to an issue.
+type Attachment struct {
+	Name  string `json:"name"`
+	Links Links  `json:"links"`
+}
+
+// AttachmentList represents a paginated list of issue attachments
+type AttachmentList struct {
+	Values []Attachment `json:"values"`
+	Next   string       `json:"next"`
+}
+
 // IssueList represents a paginated list of issues
 type IssueList struct {
 	Size     int     `json:"size"`
@@ -133,28 +171,90 @@ func (i *Issue) HTMLURL() string {
 	return i.Links.HTML.Href
 }
 
-// ParseIssueArg parses an issue argument which can be a number or URL
-func ParseIssueArg(arg string) (int, bbrepo.Interface, error) {
-	// Try parsing as a number first
-	if num, err := strconv.Atoi(arg); err == nil {
-		return num, nil, nil
+// ExportData maps the issue into the fields requested with --json. Field
+// names match the column names used by issue list.
+func (i *Issue) ExportData(fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = i.ID
+		case "title":
+			data[f] = i.Title
+		case "state":
+			data[f] = i.State
+		case "kind":
+			data[f] = i.Kind
+		case "priority":
+			data[f] = i.Priority
+		case "reporter":
+			data[f] = i.Reporter.DisplayName
+		case "assignee":
+			if i.Assignee != nil {
+				data[f] = i.Assignee.DisplayName
+			} else {
+				data[f] = ""
+			}
+		case "created":
+			data[f] = i.CreatedOn
+		case "updated":
+			data[f] = i.UpdatedOn
+		case "body":
+			data[f] = i.Body()
+		case "bodyHTML":
+			if i.Content != nil {
+				data[f] = i.Content.HTML
+			} else {
+				data[f] = ""
+			}
+		case "url":
+			data[f] = i.HTMLURL()
+		case "votes":
+			data[f] = i.Votes
+		case "watches":
+			data[f] = i.Watches
+		}
 	}
+	return data
+}
 
-	// Try parsing as a URL
-	// Format: https://bitbucket.org/WORKSPACE/REPO/issues/123
-	re := regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/issues/(\d+)`)
-	matches := re.FindStringSubmatch(arg)
-	if matches != nil {
-		host := matches[1]
-		workspace := matches[2]
-		repoSlug := matches[3]
-		num, _ := strconv.Atoi(matches[4])
-
-		repo := bbrepo.NewWithHost(workspace, repoSlug, host)
-		return num, repo, nil
+// ExportData maps the comment into the shape emitted for the comments field
+// of issue view --json. Nested objects always carry their full field set.
+func (c *Comment) ExportData() map[string]interface{} {
+	data := map[string]interface{}{
+		"id":        c.ID,
+		"body":      "",
+		"bodyHTML":  "",
+		"createdOn": c.CreatedOn,
+		"updatedOn": c.UpdatedOn,
+		"user": map[string]interface{}{
+			"displayName": c.User.DisplayName,
+			"accountId":   c.User.AccountID,
+			"uuid":        c.User.UUID,
+		},
+	}
+	if c.Content != nil {
+		data["body"] = c.Content.Raw
+		data["bodyHTML"] = c.Content.HTML
+	}
+	if c.Parent != nil {
+		data["parentId"] = c.Parent.ID
 	}
+	return data
+}
+
+// ExportData maps the attachment into the shape emitted for the attachments
+// field of issue view --json.
+func (a *Attachment) ExportData() map[string]interface{} {
+	return map[string]interface{}{
+		"name": a.Name,
+		"url":  a.Links.Self.Href,
+	}
+}
 
-	return 0, nil, fmt.Errorf("invalid issue argument: %s", arg)
+// ParseIssueArg parses an issue argument which can be a number or URL
+func ParseIssueArg(arg string) (int, bbrepo.Interface, error) {
+	return selector.Issue.ParseNumber(arg)
 }
 
 // IssueStateFromString converts a string state to the Bitbucket API state format
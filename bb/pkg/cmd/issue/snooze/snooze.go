@@ -0,0 +1,134 @@
+package snooze
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/snooze"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SnoozeOptions struct {
+	IO       *iostreams.IOStreams
+	BaseRepo func() (bbrepo.Interface, error)
+
+	SelectorArg string
+	Until       string
+}
+
+func NewCmdSnooze(f *cmdutil.Factory, runF func(*SnoozeOptions) error) *cobra.Command {
+	opts := &SnoozeOptions{
+		IO:       f.IOStreams,
+		BaseRepo: f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "snooze {<number> | <url>}",
+		Short: "Hide an issue from listings for a while",
+		Long: heredoc.Doc(`
+			Snooze a Bitbucket issue so it no longer appears in the output of
+			"bb issue list" and "bb status" until the snooze expires.
+
+			Snoozes are local reminders stored on this machine; nothing changes
+			on Bitbucket. Use "bb reminders" to see upcoming ones.
+		`),
+		Example: heredoc.Doc(`
+			# Hide issue #42 for three days
+			$ bb issue snooze 42 --until 3d
+
+			# Hide issue #42 until a specific date
+			$ bb issue snooze 42 --until 2024-07-01
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot snooze issue: number or url required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return snoozeRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Until, "until", "u", "1d", "How long to snooze: a duration (12h, 3d, 2w) or a date (2006-01-02)")
+
+	return cmd
+}
+
+func snoozeRun(opts *SnoozeOptions) error {
+	until, err := parseUntil(opts.Until, time.Now())
+	if err != nil {
+		return err
+	}
+
+	issueID, issueRepo, err := shared.ParseIssueArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	var repo bbrepo.Interface
+	if issueRepo != nil {
+		repo = issueRepo
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	fullName := bbrepo.FullName(repo)
+	if err := snooze.Add(fullName, issueID, until); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Snoozed issue #%d in %s until %s\n",
+			cs.SuccessIcon(), issueID, fullName, until.Local().Format("Mon Jan 2 15:04"))
+		fmt.Fprintf(opts.IO.Out, "%s\n", cs.Muted("It will be hidden from bb issue list and bb status. See upcoming reminders with `bb reminders`."))
+	}
+	return nil
+}
+
+// untilDurationRE matches relative durations like "12h", "3d", "2w".
+var untilDurationRE = regexp.MustCompile(`^(\d+)([hdw])$`)
+
+// parseUntil resolves a --until value — a relative duration or an absolute
+// date — to the moment the snooze expires.
+func parseUntil(value string, now time.Time) (time.Time, error) {
+	if dm := untilDurationRE.FindStringSubmatch(value); dm != nil {
+		n, err := strconv.Atoi(dm[1])
+		if err != nil || n == 0 {
+			return time.Time{}, cmdutil.FlagErrorf("invalid --until value: %q", value)
+		}
+		var d time.Duration
+		switch dm[2] {
+		case "h":
+			d = time.Duration(n) * time.Hour
+		case "d":
+			d = time.Duration(n) * 24 * time.Hour
+		case "w":
+			d = time.Duration(n) * 7 * 24 * time.Hour
+		}
+		return now.Add(d), nil
+	}
+
+	var t time.Time
+	var err error
+	if t, err = time.Parse(time.RFC3339, value); err != nil {
+		if t, err = time.ParseInLocation("2006-01-02", value, time.Local); err != nil {
+			return time.Time{}, cmdutil.FlagErrorf("invalid --until value %q: expected a duration (12h, 3d, 2w) or a date (2006-01-02)", value)
+		}
+	}
+	if !t.After(now) {
+		return time.Time{}, cmdutil.FlagErrorf("--until value %q is in the past", value)
+	}
+	return t, nil
+}
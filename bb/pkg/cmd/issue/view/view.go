@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -27,8 +29,18 @@ type ViewOptions struct {
 	BaseRepo   func() (bbrepo.Interface, error)
 	Browser    browser.Browser
 
-	IssueArg string
-	Web      bool
+	IssueArg  string
+	Web       bool
+	NoBrowser bool
+	Comments  bool
+	Exporter  cmdutil.Exporter
+}
+
+// issueViewFields are the fields available to issue view --json.
+var issueViewFields = []string{
+	"id", "title", "state", "kind", "priority", "reporter", "assignee",
+	"created", "updated", "body", "bodyHTML", "url", "votes", "watches",
+	"comments", "attachments",
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -57,6 +69,12 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			# View issue by URL
 			$ bb issue view https://bitbucket.org/workspace/repo/issues/123
+
+			# View issue with comments
+			$ bb issue view 123 --comments
+
+			# Export an issue with its comments for mirroring into another system
+			$ bb issue view 123 --json id,title,body,bodyHTML,comments,attachments
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -70,6 +88,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the issue in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
+	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View issue comments")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, issueViewFields)
 
 	return cmd
 }
@@ -106,10 +127,172 @@ func viewRun(opts *ViewOptions) error {
 			url = fmt.Sprintf("https://%s/%s/%s/issues/%d",
 				repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug(), issueNum)
 		}
-		return opts.Browser.Browse(url)
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, url, opts.NoBrowser)
+	}
+
+	if opts.Exporter != nil {
+		exportable := &exportableIssue{Issue: issue}
+		fields := opts.Exporter.Fields()
+
+		// Comments and attachments each cost extra requests, so they are
+		// only fetched when the caller asked for them.
+		if slices.Contains(fields, "comments") {
+			exportable.Comments, err = fetchComments(httpClient, repo, issueNum)
+			if err != nil {
+				return err
+			}
+		}
+		if slices.Contains(fields, "attachments") {
+			exportable.Attachments, err = fetchAttachments(httpClient, repo, issueNum)
+			if err != nil {
+				return err
+			}
+		}
+
+		return opts.Exporter.Write(opts.IO, exportable)
+	}
+
+	// Watch and vote state is best-effort; don't fail the view if it can't
+	// be determined
+	watching := checkIssueFlag(httpClient, repo, issueNum, "watch")
+	voted := checkIssueFlag(httpClient, repo, issueNum, "vote")
+
+	// So is the attachment list
+	var attachments []shared.Attachment
+	if list, err := fetchAttachments(httpClient, repo, issueNum); err == nil {
+		attachments = list
+	}
+
+	if err := printIssue(opts.IO, issue, watching, voted, attachments); err != nil {
+		return err
+	}
+
+	if opts.Comments {
+		comments, err := fetchComments(httpClient, repo, issueNum)
+		if err != nil {
+			return err
+		}
+		printComments(opts.IO, comments)
+	}
+
+	return nil
+}
+
+// checkIssueFlag reports whether the authenticated user has set a per-user
+// issue flag. The watch and vote endpoints return 204 when set and 404 when
+// not.
+func checkIssueFlag(client *http.Client, repo bbrepo.Interface, issueNum int, flag string) bool {
+	apiURL := fmt.Sprintf("%srepositories/%s/%s/issues/%d/%s",
+		bbinstance.RESTPrefix(repo.RepoHost()),
+		repo.RepoWorkspace(),
+		repo.RepoSlug(),
+		issueNum,
+		flag,
+	)
+
+	req, err := http.NewRequest("GET", apiURL, nil)
+	if err != nil {
+		return false
 	}
 
-	return printIssue(opts.IO, issue)
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body) //nolint:errcheck
+
+	return resp.StatusCode >= 200 && resp.StatusCode < 300
+}
+
+// exportableIssue augments an issue with the comments and attachments that
+// --json can include but the regular view does not fetch.
+type exportableIssue struct {
+	*shared.Issue
+	Comments    []shared.Comment
+	Attachments []shared.Attachment
+}
+
+func (e *exportableIssue) ExportData(fields []string) map[string]interface{} {
+	data := e.Issue.ExportData(fields)
+	for _, f := range fields {
+		switch f {
+		case "comments":
+			comments := make([]map[string]interface{}, len(e.Comments))
+			for i, c := range e.Comments {
+				comments[i] = c.ExportData()
+			}
+			data[f] = comments
+		case "attachments":
+			attachments := make([]map[string]interface{}, len(e.Attachments))
+			for i, a := range e.Attachments {
+				attachments[i] = a.ExportData()
+			}
+			data[f] = attachments
+		}
+	}
+	return data
+}
+
+func fetchComments(client *http.Client, repo bbrepo.Interface, issueNum int) ([]shared.Comment, error) {
+	apiURL := fmt.Sprintf("%srepositories/%s/%s/issues/%d/comments?pagelen=100",
+		bbinstance.RESTPrefix(repo.RepoHost()),
+		repo.RepoWorkspace(),
+		repo.RepoSlug(),
+		issueNum,
+	)
+
+	var comments []shared.Comment
+	for apiURL != "" {
+		var page shared.CommentList
+		if err := fetchJSON(client, apiURL, &page); err != nil {
+			return nil, err
+		}
+		comments = append(comments, page.Values...)
+		apiURL = page.Next
+	}
+	return comments, nil
+}
+
+func fetchAttachments(client *http.Client, repo bbrepo.Interface, issueNum int) ([]shared.Attachment, error) {
+	apiURL := fmt.Sprintf("%srepositories/%s/%s/issues/%d/attachments?pagelen=100",
+		bbinstance.RESTPrefix(repo.RepoHost()),
+		repo.RepoWorkspace(),
+		repo.RepoSlug(),
+		issueNum,
+	)
+
+	var attachments []shared.Attachment
+	for apiURL != "" {
+		var page shared.AttachmentList
+		if err := fetchJSON(client, apiURL, &page); err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, page.Values...)
+		apiURL = page.Next
+	}
+	return attachments, nil
+}
+
+func fetchJSON(client *http.Client, url string, data interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(data)
 }
 
 func fetchIssue(client *http.Client, repo bbrepo.Interface, issueNum int) (*shared.Issue, error) {
@@ -149,7 +332,7 @@ func fetchIssue(client *http.Client, repo bbrepo.Interface, issueNum int) (*shar
 	return &issue, nil
 }
 
-func printIssue(io *iostreams.IOStreams, issue *shared.Issue) error {
+func printIssue(io *iostreams.IOStreams, issue *shared.Issue, watching, voted bool, attachments []shared.Attachment) error {
 	cs := io.ColorScheme()
 	out := io.Out
 
@@ -204,13 +387,25 @@ func printIssue(io *iostreams.IOStreams, issue *shared.Issue) error {
 	}
 
 	// Votes and watches
-	if issue.Votes > 0 || issue.Watches > 0 {
+	if issue.Votes > 0 || issue.Watches > 0 || watching || voted {
 		var stats []string
 		if issue.Votes > 0 {
-			stats = append(stats, fmt.Sprintf("%d votes", issue.Votes))
+			stat := fmt.Sprintf("%d votes", issue.Votes)
+			if voted {
+				stat += " (including yours)"
+			}
+			stats = append(stats, stat)
+		} else if voted {
+			stats = append(stats, "you voted")
 		}
 		if issue.Watches > 0 {
-			stats = append(stats, fmt.Sprintf("%d watchers", issue.Watches))
+			stat := fmt.Sprintf("%d watchers", issue.Watches)
+			if watching {
+				stat += " (including you)"
+			}
+			stats = append(stats, stat)
+		} else if watching {
+			stats = append(stats, "you are watching")
 		}
 		fmt.Fprintf(out, "%s\n", strings.Join(stats, " • "))
 	}
@@ -230,9 +425,62 @@ func printIssue(io *iostreams.IOStreams, issue *shared.Issue) error {
 		fmt.Fprint(out, body)
 	}
 
+	// Attachments
+	if len(attachments) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%s\n", cs.Bold("Attachments:"))
+		for _, a := range attachments {
+			fmt.Fprintf(out, "  %s\n", a.Name)
+			if url := a.Links.Self.Href; url != "" {
+				fmt.Fprintf(out, "    %s\n", cs.Gray("download: curl -L -o "+a.Name+" "+url))
+			}
+		}
+	}
+
 	// URL
 	fmt.Fprintln(out)
 	fmt.Fprintf(out, "%s\n", cs.Gray(issue.HTMLURL()))
 
 	return nil
 }
+
+func printComments(io *iostreams.IOStreams, comments []shared.Comment) {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%s\n", cs.Bold("── Comments ──"))
+	fmt.Fprintln(out)
+
+	if len(comments) == 0 {
+		fmt.Fprintf(out, "%s\n", cs.Gray("No comments"))
+		return
+	}
+
+	for _, comment := range comments {
+		// Parse and format the timestamp
+		timestamp := comment.CreatedOn
+		if t, err := time.Parse(time.RFC3339, comment.CreatedOn); err == nil {
+			timestamp = text.FuzzyAgo(time.Now(), t)
+		}
+
+		fmt.Fprintf(out, "%s %s\n", cs.Bold(comment.User.DisplayName), cs.Gray("commented "+timestamp))
+
+		var content string
+		if comment.Content != nil {
+			content = comment.Content.Raw
+		}
+		if content != "" {
+			if io.IsStdoutTTY() {
+				rendered, err := markdown.Render(content,
+					markdown.WithTheme(io.TerminalTheme()),
+					markdown.WithWrap(io.TerminalWidth()))
+				if err == nil {
+					content = rendered
+				}
+			}
+			fmt.Fprintln(out, content)
+		}
+		fmt.Fprintln(out)
+	}
+}
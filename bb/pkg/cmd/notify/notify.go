@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/notify/shared"
+	testCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/notify/test"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type NotifyOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (gh.Config, error)
+
+	SlackWebhook string
+	TeamsWebhook string
+	On           string
+	Repo         string
+}
+
+func NewCmdNotify(f *cmdutil.Factory, runF func(*NotifyOptions) error) *cobra.Command {
+	opts := &NotifyOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "notify --slack-webhook <url> --on <event> --repo <workspace/repo>",
+		Short: "Register a chat notification for repository events",
+		Long: heredoc.Docf(`
+			Register a Slack or Microsoft Teams notification for a repository
+			event. The rule is stored in your bb configuration; matching events
+			are formatted into the provider's incoming-webhook payload and
+			posted to the given URL.
+
+			Supported events: %s.
+
+			Use %[2]sbb notify test%[2]s to send a sample message and verify the
+			webhook URL before relying on the rule.
+		`, strings.Join(shared.KnownEvents, ", "), "`"),
+		Example: heredoc.Doc(`
+			# Post to Slack when a pipeline fails
+			$ bb notify --slack-webhook https://hooks.slack.com/services/T000/B000/XXXX \
+			    --on "pipeline failed" --repo myworkspace/myrepo
+
+			# Post to Teams when a pull request is merged
+			$ bb notify --teams-webhook https://example.webhook.office.com/webhookb2/... \
+			    --on "pr merged" --repo myworkspace/myrepo
+
+			# Send a sample message for a registered rule
+			$ bb notify test --on "pipeline failed" --repo myworkspace/myrepo
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --slack-webhook or --teams-webhook",
+				opts.SlackWebhook != "", opts.TeamsWebhook != "",
+			); err != nil {
+				return err
+			}
+			if opts.SlackWebhook == "" && opts.TeamsWebhook == "" {
+				return cmdutil.FlagErrorf("one of --slack-webhook or --teams-webhook is required")
+			}
+			if opts.On == "" {
+				return cmdutil.FlagErrorf("--on is required")
+			}
+			if !shared.IsKnownEvent(opts.On) {
+				return cmdutil.FlagErrorf("unknown event %q: valid events are %s",
+					opts.On, strings.Join(shared.KnownEvents, ", "))
+			}
+			if opts.Repo == "" {
+				return cmdutil.FlagErrorf("--repo is required")
+			}
+			if !strings.Contains(opts.Repo, "/") {
+				return cmdutil.FlagErrorf("--repo must be in workspace/repo format")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return notifyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.SlackWebhook, "slack-webhook", "", "Slack incoming webhook URL that receives the notifications")
+	cmd.Flags().StringVar(&opts.TeamsWebhook, "teams-webhook", "", "Microsoft Teams incoming webhook URL that receives the notifications")
+	cmd.Flags().StringVar(&opts.On, "on", "", "Event to notify about (e.g. \"pipeline failed\")")
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository the rule applies to, in workspace/repo format")
+
+	cmd.AddCommand(testCmd.NewCmdTest(f, nil))
+
+	return cmd
+}
+
+func notifyRun(opts *NotifyOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	provider := "slack"
+	webhookURL := opts.SlackWebhook
+	if opts.TeamsWebhook != "" {
+		provider = "teams"
+		webhookURL = opts.TeamsWebhook
+	}
+
+	cfg.Notifications().Add(shared.RuleKey(opts.Repo, opts.On), provider+" "+webhookURL)
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Registered %s notification for %q in %s\n",
+		cs.SuccessIcon(), provider, opts.On, cs.Bold(opts.Repo))
+	fmt.Fprintf(opts.IO.Out, "Run %s to send a sample message\n",
+		cs.Cyan(fmt.Sprintf("`bb notify test --on %q --repo %s`", opts.On, opts.Repo)))
+
+	return nil
+}
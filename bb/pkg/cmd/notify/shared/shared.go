@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// KnownEvents are the friendly event names a notification rule can subscribe
+// to, as accepted by the --on flag.
+var KnownEvents = []string{
+	"pipeline failed",
+	"pipeline succeeded",
+	"pr created",
+	"pr merged",
+	"pr declined",
+	"issue created",
+}
+
+// IsKnownEvent reports whether event is one of the KnownEvents names.
+func IsKnownEvent(event string) bool {
+	for _, known := range KnownEvents {
+		if event == known {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleKey is the name a notification rule is stored under in the config;
+// one rule per repository and event.
+func RuleKey(repo, event string) string {
+	return fmt.Sprintf("%s on %s", repo, event)
+}
+
+// SampleMessage renders the message a rule would send for its event, marked
+// as a test delivery.
+func SampleMessage(event, repo string) string {
+	switch event {
+	case "pipeline failed":
+		return fmt.Sprintf("[test] Pipeline #42 failed in %s on branch main", repo)
+	case "pipeline succeeded":
+		return fmt.Sprintf("[test] Pipeline #42 succeeded in %s on branch main", repo)
+	case "pr created":
+		return fmt.Sprintf("[test] Pull request #7 opened in %s: Add sample feature", repo)
+	case "pr merged":
+		return fmt.Sprintf("[test] Pull request #7 merged in %s: Add sample feature", repo)
+	case "pr declined":
+		return fmt.Sprintf("[test] Pull request #7 declined in %s: Add sample feature", repo)
+	case "issue created":
+		return fmt.Sprintf("[test] Issue #13 opened in %s: Sample issue title", repo)
+	}
+	return fmt.Sprintf("[test] %s in %s", event, repo)
+}
+
+// Payload encodes a message in the JSON shape the provider's incoming
+// webhook expects: a bare text object for Slack, a MessageCard for Teams.
+func Payload(provider, text string) ([]byte, error) {
+	switch provider {
+	case "slack":
+		return json.Marshal(map[string]string{"text": text})
+	case "teams":
+		return json.Marshal(map[string]string{
+			"@type":    "MessageCard",
+			"@context": "http://schema.org/extensions",
+			"summary":  text,
+			"text":     text,
+		})
+	}
+	return nil, fmt.Errorf("unknown notification provider %q", provider)
+}
+
+// Send posts a formatted message to the provider's incoming webhook.
+func Send(provider, webhookURL, text string) error {
+	payload, err := Payload(provider, text)
+	if err != nil {
+		return err
+	}
+
+	// The webhook is not a Bitbucket host, so use a plain client rather
+	// than one carrying our auth headers.
+	resp, err := http.Post(webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to deliver notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("notification webhook responded with HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// ParseRule splits a stored rule expansion back into provider and webhook
+// URL.
+func ParseRule(expansion string) (provider, webhookURL string, err error) {
+	parts := strings.SplitN(expansion, " ", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("malformed notification rule %q", expansion)
+	}
+	return parts[0], parts[1], nil
+}
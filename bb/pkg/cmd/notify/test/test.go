@@ -0,0 +1,122 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/notify/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type TestOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (gh.Config, error)
+
+	SlackWebhook string
+	TeamsWebhook string
+	On           string
+	Repo         string
+}
+
+func NewCmdTest(f *cmdutil.Factory, runF func(*TestOptions) error) *cobra.Command {
+	opts := &TestOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "test --on <event> --repo <workspace/repo>",
+		Short: "Send a sample notification message",
+		Long: heredoc.Doc(`
+			Send a sample message for a notification rule, to verify the
+			webhook URL and see how the event is formatted.
+
+			With only --on and --repo, the webhook URL comes from the rule
+			registered with "bb notify"; pass --slack-webhook or
+			--teams-webhook to test a URL before registering it.
+		`),
+		Example: heredoc.Doc(`
+			# Test a registered rule
+			$ bb notify test --on "pipeline failed" --repo myworkspace/myrepo
+
+			# Test a webhook URL directly
+			$ bb notify test --slack-webhook https://hooks.slack.com/services/T000/B000/XXXX \
+			    --on "pr merged" --repo myworkspace/myrepo
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --slack-webhook or --teams-webhook",
+				opts.SlackWebhook != "", opts.TeamsWebhook != "",
+			); err != nil {
+				return err
+			}
+			if opts.On == "" {
+				return cmdutil.FlagErrorf("--on is required")
+			}
+			if !shared.IsKnownEvent(opts.On) {
+				return cmdutil.FlagErrorf("unknown event %q: valid events are %s",
+					opts.On, strings.Join(shared.KnownEvents, ", "))
+			}
+			if opts.Repo == "" {
+				return cmdutil.FlagErrorf("--repo is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return testRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.SlackWebhook, "slack-webhook", "", "Slack incoming webhook URL to test instead of a registered rule")
+	cmd.Flags().StringVar(&opts.TeamsWebhook, "teams-webhook", "", "Microsoft Teams incoming webhook URL to test instead of a registered rule")
+	cmd.Flags().StringVar(&opts.On, "on", "", "Event to send a sample message for (e.g. \"pipeline failed\")")
+	cmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository the sample message refers to, in workspace/repo format")
+
+	return cmd
+}
+
+func testRun(opts *TestOptions) error {
+	provider := "slack"
+	webhookURL := opts.SlackWebhook
+	if opts.TeamsWebhook != "" {
+		provider = "teams"
+		webhookURL = opts.TeamsWebhook
+	}
+
+	if webhookURL == "" {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		expansion, err := cfg.Notifications().Get(shared.RuleKey(opts.Repo, opts.On))
+		if err != nil {
+			return fmt.Errorf("no notification registered for %q in %s; register one with `bb notify` or pass a webhook URL", opts.On, opts.Repo)
+		}
+		provider, webhookURL, err = shared.ParseRule(expansion)
+		if err != nil {
+			return err
+		}
+	}
+
+	message := shared.SampleMessage(opts.On, opts.Repo)
+
+	opts.IO.StartProgressIndicator()
+	err := shared.Send(provider, webhookURL, message)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Sent sample %s message: %s\n",
+		cs.SuccessIcon(), provider, message)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package open
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/selector"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type OpenOptions struct {
+	IO *iostreams.IOStreams
+
+	Refs []string
+
+	// Dispatch re-runs the root command with the given arguments. It is a
+	// field so tests can capture the routed commands without executing them.
+	Dispatch func([]string) error
+}
+
+func NewCmdOpen(f *cmdutil.Factory, runF func(*OpenOptions) error) *cobra.Command {
+	opts := &OpenOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "open {<url> | <reference> | -}...",
+		Short: "View whatever a Bitbucket URL or reference points at",
+		Long: heredoc.Docf(`
+			Route Bitbucket URLs and references to the view command that can
+			display them: pull request and pipeline URLs to %[1]sbb pr view%[1]s and
+			%[1]sbb pipeline view%[1]s, issue URLs and %[1]sworkspace/repo#123%[1]s references to
+			%[1]sbb issue view%[1]s, and bare %[1]sworkspace/repo%[1]s names to %[1]sbb repo view%[1]s.
+			A %[1]s#123%[1]s reference opens the issue tracker, matching how Bitbucket
+			links %[1]s#123%[1]s in comments and commit messages.
+
+			With %[1]s-%[1]s, references are read from standard input instead. Words
+			that do not look like Bitbucket references are ignored, so output
+			from grep or git log can be piped in as-is.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ bb open https://bitbucket.org/myworkspace/myrepo/pull-requests/123
+			$ bb open myworkspace/myrepo#42
+			$ git log --oneline -10 | grep -o 'myworkspace/[a-z-]*#[0-9]*' | bb open -
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(c *cobra.Command, args []string) error {
+			opts.Refs = args
+			if opts.Dispatch == nil {
+				root := c.Root()
+				opts.Dispatch = func(viewArgs []string) error {
+					root.SetArgs(viewArgs)
+					return root.Execute()
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return openRun(opts)
+		},
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	return cmd
+}
+
+func openRun(opts *OpenOptions) error {
+	fromStdin := false
+	var tokens []string
+	for _, ref := range opts.Refs {
+		if ref == "-" && !fromStdin {
+			fromStdin = true
+			scanner := bufio.NewScanner(opts.IO.In)
+			scanner.Split(bufio.ScanWords)
+			for scanner.Scan() {
+				tokens = append(tokens, scanner.Text())
+			}
+			if err := scanner.Err(); err != nil {
+				return fmt.Errorf("failed to read standard input: %w", err)
+			}
+			continue
+		}
+		tokens = append(tokens, ref)
+	}
+
+	opened := 0
+	for _, token := range tokens {
+		viewArgs, ok := route(token)
+		if !ok {
+			// Piped input is expected to contain surrounding noise
+			if fromStdin {
+				continue
+			}
+			return cmdutil.FlagErrorf("cannot determine what %q refers to", token)
+		}
+		if err := opts.Dispatch(viewArgs); err != nil {
+			return err
+		}
+		opened++
+	}
+
+	if opened == 0 {
+		return cmdutil.NewNoResultsError("no Bitbucket references found on standard input")
+	}
+	return nil
+}
+
+var (
+	issueRefRE = regexp.MustCompile(`^([\w.-]+)/([\w.-]+)#(\d+)$`)
+	repoRefRE  = regexp.MustCompile(`^[\w.-]+/[\w.-]+$`)
+	repoURLRE  = regexp.MustCompile(`^https?://([^/]+)/([\w.-]+)/([\w.-]+)/?$`)
+)
+
+// route maps one token onto the argv of the view command that can display
+// it, or reports that the token is not a recognizable Bitbucket reference.
+func route(token string) ([]string, bool) {
+	if strings.HasPrefix(token, "http://") || strings.HasPrefix(token, "https://") {
+		if _, repo, err := selector.PullRequest.ParseNumber(token); err == nil && repo != nil {
+			return []string{"pr", "view", token}, true
+		}
+		if _, repo, err := selector.Issue.ParseNumber(token); err == nil && repo != nil {
+			return []string{"issue", "view", token}, true
+		}
+		if _, repo, err := selector.Pipeline.ParseNumber(token); err == nil && repo != nil {
+			return []string{"pipeline", "view", token}, true
+		}
+		if m := repoURLRE.FindStringSubmatch(token); m != nil {
+			return []string{"repo", "view", fmt.Sprintf("%s/%s/%s", m[1], m[2], m[3])}, true
+		}
+		return nil, false
+	}
+
+	if m := issueRefRE.FindStringSubmatch(token); m != nil {
+		return []string{"issue", "view", m[3], "-R", m[1] + "/" + m[2]}, true
+	}
+	if repoRefRE.MatchString(token) {
+		return []string{"repo", "view", token}, true
+	}
+	return nil, false
+}
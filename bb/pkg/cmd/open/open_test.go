@@ -0,0 +1,71 @@
+package open
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoute(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		wantArgs []string
+		wantOK   bool
+	}{
+		{
+			name:     "pull request URL",
+			token:    "https://bitbucket.org/ws/repo/pull-requests/123",
+			wantArgs: []string{"pr", "view", "https://bitbucket.org/ws/repo/pull-requests/123"},
+			wantOK:   true,
+		},
+		{
+			name:     "issue URL",
+			token:    "https://bitbucket.org/ws/repo/issues/42",
+			wantArgs: []string{"issue", "view", "https://bitbucket.org/ws/repo/issues/42"},
+			wantOK:   true,
+		},
+		{
+			name:     "pipeline URL",
+			token:    "https://bitbucket.org/ws/repo/pipelines/results/7",
+			wantArgs: []string{"pipeline", "view", "https://bitbucket.org/ws/repo/pipelines/results/7"},
+			wantOK:   true,
+		},
+		{
+			name:     "repository URL",
+			token:    "https://bitbucket.org/ws/repo",
+			wantArgs: []string{"repo", "view", "bitbucket.org/ws/repo"},
+			wantOK:   true,
+		},
+		{
+			name:     "issue reference",
+			token:    "ws/repo#42",
+			wantArgs: []string{"issue", "view", "42", "-R", "ws/repo"},
+			wantOK:   true,
+		},
+		{
+			name:     "repository reference",
+			token:    "ws/repo",
+			wantArgs: []string{"repo", "view", "ws/repo"},
+			wantOK:   true,
+		},
+		{
+			name:   "URL of the wrong shape",
+			token:  "https://bitbucket.org/ws/repo/src/main/README.md",
+			wantOK: false,
+		},
+		{
+			name:   "plain word",
+			token:  "deadbeef",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotArgs, gotOK := route(tt.token)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.wantArgs, gotArgs)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/config/toggle"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/config/view"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config <command>",
+		Short: "Inspect and toggle the pipelines configuration of a repository",
+		Example: heredoc.Doc(`
+			$ bb pipeline config view
+			$ bb pipeline config enable
+			$ bb pipeline config disable
+		`),
+	}
+
+	cmd.AddCommand(view.NewCmdView(f, nil))
+	cmd.AddCommand(toggle.NewCmdEnable(f, nil))
+	cmd.AddCommand(toggle.NewCmdDisable(f, nil))
+
+	return cmd
+}
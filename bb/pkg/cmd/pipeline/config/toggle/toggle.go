@@ -0,0 +1,103 @@
+package toggle
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ToggleOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Enable bool
+}
+
+func NewCmdEnable(f *cmdutil.Factory, runF func(*ToggleOptions) error) *cobra.Command {
+	return newToggleCmd(f, runF, true, "enable", heredoc.Doc(`
+		$ bb pipeline config enable
+	`))
+}
+
+func NewCmdDisable(f *cmdutil.Factory, runF func(*ToggleOptions) error) *cobra.Command {
+	return newToggleCmd(f, runF, false, "disable", heredoc.Doc(`
+		$ bb pipeline config disable
+	`))
+}
+
+func newToggleCmd(f *cmdutil.Factory, runF func(*ToggleOptions) error, enable bool, verb, example string) *cobra.Command {
+	opts := &ToggleOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Enable:     enable,
+	}
+
+	cmd := &cobra.Command{
+		Use:     verb,
+		Short:   fmt.Sprintf("%s pipelines for a repository", capitalize(verb)),
+		Example: example,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return toggleRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return string(s[0]-'a'+'A') + s[1:]
+}
+
+func toggleRun(opts *ToggleOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	path := fmt.Sprintf("repositories/%s/%s/pipelines_config", repo.RepoWorkspace(), repo.RepoSlug())
+
+	payload := struct {
+		Enabled bool `json:"enabled"`
+	}{Enabled: opts.Enable}
+
+	opts.IO.StartProgressIndicator()
+	err = apiClient.Put(repo.RepoHost(), path, payload, nil)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		state := "Disabled"
+		if opts.Enable {
+			state = "Enabled"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s pipelines for %s\n",
+			cs.SuccessIcon(), state, cs.Bold(bbrepo.FullName(repo)))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "View the pipelines configuration of a repository",
+		Example: heredoc.Doc(`
+			$ bb pipeline config view
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+// pipelinesConfig mirrors the /pipelines_config resource.
+type pipelinesConfig struct {
+	Enabled    bool `json:"enabled"`
+	Repository *struct {
+		FullName string `json:"full_name"`
+	} `json:"repository,omitempty"`
+}
+
+type buildNumber struct {
+	Next int `json:"next"`
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	base := fmt.Sprintf("repositories/%s/%s/pipelines_config", repo.RepoWorkspace(), repo.RepoSlug())
+
+	opts.IO.StartProgressIndicator()
+	var cfg pipelinesConfig
+	err = apiClient.Get(repo.RepoHost(), base, &cfg)
+
+	// The build number is a separate sub-resource; failing to read it should
+	// not hide the rest of the configuration.
+	var next buildNumber
+	if err == nil {
+		_ = apiClient.Get(repo.RepoHost(), base+"/build_number", &next)
+	}
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Bold(bbrepo.FullName(repo)))
+	if cfg.Enabled {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Pipelines:"), cs.RoleColor("success", cs.Green)("enabled"))
+	} else {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Pipelines:"), cs.Gray("disabled"))
+	}
+	if next.Next > 0 {
+		fmt.Fprintf(out, "%s %d\n", cs.Bold("Next build number:"), next.Next)
+	}
+
+	return nil
+}
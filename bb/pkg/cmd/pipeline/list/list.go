@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bburl"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/shared"
@@ -20,18 +24,37 @@ import (
 type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
-
-	Branch string
-	Status string
-	Limit  int
+	Browser    browser.Browser
+	Exporter   cmdutil.Exporter
+
+	Web     bool
+	Branch  string
+	Status  string
+	Created string
+	PR      int
+	Tag     string
+	Creator string
+	Trigger string
+	Columns []string
+	Limit   int
 }
 
+// listColumns registers every column this command can render. The names also
+// serve as the field list for --json.
+var listColumns = cmdutil.NewColumnSet(
+	[]string{"number", "status", "branch", "commit", "duration", "created"},
+	"creator",
+)
+
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := &ListOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
+		Browser:    f.Browser,
 	}
 
 	cmd := &cobra.Command{
@@ -48,8 +71,23 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			$ bb pipeline list --branch main
 			$ bb pipeline list --status successful
 			$ bb pipeline list --limit 50
+
+			# List pipelines triggered for pull request #123
+			$ bb pipeline list --pr 123
+
+			# List manually triggered pipelines you started
+			$ bb pipeline list --creator @me --trigger manual
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--branch`, `--pr`, or `--tag`",
+				opts.Branch != "",
+				opts.PR != 0,
+				opts.Tag != "",
+			); err != nil {
+				return err
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -59,12 +97,29 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Filter by branch name")
 	cmd.Flags().StringVarP(&opts.Status, "status", "s", "", "Filter by status (pending, in_progress, successful, failed, stopped)")
+	cmd.Flags().StringVar(&opts.Created, "created", "", "Filter by creation date (e.g. \">2024-01-01\", \"<7d\")")
+	cmd.Flags().IntVar(&opts.PR, "pr", 0, "Filter by the pull request `number` the pipeline ran for")
+	cmd.Flags().StringVar(&opts.Tag, "tag", "", "Filter by tag name")
+	cmd.Flags().StringVar(&opts.Creator, "creator", "", "Filter by creator (`@me` or a user UUID)")
+	cmdutil.StringEnumFlag(cmd, &opts.Trigger, "trigger", "", "", []string{"manual", "push", "schedule"}, "Filter by what triggered the pipeline")
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of pipelines to list")
+	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the pipelines page in the browser")
+	cmdutil.AddColumnsFlag(cmd, &opts.Columns, listColumns)
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, listColumns.Fields())
+	cmdutil.AddExportFormatFlags(cmd, &opts.Exporter, listColumns.Fields())
 
 	return cmd
 }
 
 func listRun(opts *ListOptions) error {
+	if opts.Web {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, bburl.Pipelines(repo, opts.Branch), false)
+	}
+
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -75,32 +130,84 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	columns, err := cmdutil.ResolveColumns(cfg, "pipeline_list_columns", opts.Columns, listColumns)
+	if err != nil {
+		return err
+	}
+
 	opts.IO.StartProgressIndicator()
-	pipelines, err := fetchPipelines(httpClient, repo, opts)
+	batch, err := fetchPipelines(httpClient, repo, opts, "", nil)
 	opts.IO.StopProgressIndicator()
 
 	if err != nil {
 		return err
 	}
 
-	if len(pipelines) == 0 {
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, batch.pipelines)
+	}
+
+	if len(batch.pipelines) == 0 {
 		if opts.IO.IsStdoutTTY() {
 			fmt.Fprintln(opts.IO.ErrOut, "No pipelines found")
 		}
 		return nil
 	}
 
-	return printPipelines(opts.IO, pipelines)
+	// Render batch by batch; when more results remain and the terminal is
+	// interactive, offer to keep going instead of silently truncating.
+	shown := 0
+	for {
+		if err := printPipelines(opts.IO, batch.pipelines, columns); err != nil {
+			return err
+		}
+		shown += len(batch.pipelines)
+
+		if (batch.next == "" && len(batch.rest) == 0) || !cmdutil.LoadMore(opts.IO, shown, batch.total) {
+			return nil
+		}
+
+		opts.IO.StartProgressIndicator()
+		batch, err = fetchPipelines(httpClient, repo, opts, batch.next, batch.rest)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		if len(batch.pipelines) == 0 {
+			return nil
+		}
+	}
+}
+
+// pipelineBatch is one display batch of pipelines plus the cursor and total
+// needed to keep paginating interactively. rest holds rows fetched beyond the
+// display limit; feeding them back as the next batch's carried rows keeps the
+// page-boundary cursor from skipping them.
+type pipelineBatch struct {
+	pipelines []shared.Pipeline
+	rest      []shared.Pipeline
+	next      string
+	total     int
 }
 
-func fetchPipelines(client *http.Client, repo bbrepo.Interface, opts *ListOptions) ([]shared.Pipeline, error) {
+// fetchPipelines collects up to opts.Limit pipelines starting from carried
+// (rows held back from the previous batch) and then startURL, or the first
+// page when startURL is empty.
+func fetchPipelines(client *http.Client, repo bbrepo.Interface, opts *ListOptions, startURL string, carried []shared.Pipeline) (pipelineBatch, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
+	if startURL != "" || len(carried) > 0 {
+		return fetchPipelinePages(apiClient, repo, "", startURL, opts.Limit, carried)
+	}
+
 	params := url.Values{}
 	params.Set("sort", "-created_on")
-	if opts.Limit > 0 {
-		params.Set("pagelen", fmt.Sprintf("%d", opts.Limit))
-	}
+	params.Set("pagelen", fmt.Sprintf("%d", min(opts.Limit, 100)))
 
 	// Build filter query
 	var filters []string
@@ -109,6 +216,40 @@ func fetchPipelines(client *http.Client, repo bbrepo.Interface, opts *ListOption
 		filters = append(filters, fmt.Sprintf(`target.ref_name="%s"`, opts.Branch))
 	}
 
+	if opts.PR != 0 {
+		filters = append(filters, fmt.Sprintf(`target.pullrequest.id=%d`, opts.PR))
+	}
+
+	if opts.Tag != "" {
+		filters = append(filters, `target.ref_type="tag"`, fmt.Sprintf(`target.ref_name="%s"`, opts.Tag))
+	}
+
+	if opts.Creator != "" {
+		uuid := opts.Creator
+		if uuid == "@me" {
+			var user struct {
+				UUID string `json:"uuid"`
+			}
+			if err := apiClient.Get(repo.RepoHost(), "user", &user); err != nil {
+				return pipelineBatch{}, fmt.Errorf("failed to get current user: %w", err)
+			}
+			uuid = user.UUID
+		}
+		filters = append(filters, fmt.Sprintf(`creator.uuid="%s"`, uuid))
+	}
+
+	if opts.Trigger != "" {
+		filters = append(filters, fmt.Sprintf(`trigger.name="%s"`, strings.ToUpper(opts.Trigger)))
+	}
+
+	if opts.Created != "" {
+		q, err := cmdutil.BuildDateQuery("created_on", opts.Created)
+		if err != nil {
+			return pipelineBatch{}, err
+		}
+		filters = append(filters, q)
+	}
+
 	if opts.Status != "" {
 		switch opts.Status {
 		case "pending":
@@ -138,36 +279,75 @@ func fetchPipelines(client *http.Client, repo bbrepo.Interface, opts *ListOption
 	path := fmt.Sprintf("repositories/%s/%s/pipelines?%s",
 		repo.RepoWorkspace(), repo.RepoSlug(), params.Encode())
 
-	var result shared.PipelineList
-	err := apiClient.Get(repo.RepoHost(), path, &result)
-	if err != nil {
-		return nil, err
+	return fetchPipelinePages(apiClient, repo, path, "", opts.Limit, nil)
+}
+
+// fetchPipelinePages follows the pipelines endpoint's pagination until limit
+// pipelines have been collected or the pages are exhausted, starting from
+// carried (rows held back from the previous batch). Rows beyond the limit
+// land in rest rather than being discarded, since pages don't align with the
+// limit.
+func fetchPipelinePages(apiClient *api.Client, repo bbrepo.Interface, path, startURL string, limit int, carried []shared.Pipeline) (pipelineBatch, error) {
+	batch := pipelineBatch{pipelines: carried, next: startURL}
+	nextURL := startURL
+	// No URL means the pages are exhausted and only carried rows remain
+	for (nextURL != "" || path != "") && len(batch.pipelines) < limit {
+		var page shared.PipelineList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return batch, err
+		}
+
+		if page.Size > 0 {
+			batch.total = page.Size
+		}
+		batch.pipelines = append(batch.pipelines, page.Values...)
+		batch.next = page.Next
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
 	}
 
-	return result.Values, nil
+	if len(batch.pipelines) > limit {
+		batch.rest = batch.pipelines[limit:]
+		batch.pipelines = batch.pipelines[:limit]
+	}
+	return batch, nil
 }
 
-func printPipelines(io *iostreams.IOStreams, pipelines []shared.Pipeline) error {
+func printPipelines(io *iostreams.IOStreams, pipelines []shared.Pipeline, columns []string) error {
 	cs := io.ColorScheme()
 
-	tp := tableprinter.New(io, tableprinter.WithHeader("#", "STATUS", "BRANCH", "COMMIT", "DURATION", "CREATED"))
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		if col == "number" {
+			headers[i] = "#"
+		} else {
+			headers[i] = col
+		}
+	}
+	tp := tableprinter.New(io, tableprinter.WithHeader(headers...))
 
 	for _, p := range pipelines {
-		tp.AddField(fmt.Sprintf("%d", p.BuildNumber))
-
 		// Status with color
-		status := p.StatusString()
 		var statusColor func(string) string
 		if p.State != nil {
 			switch p.State.Name {
 			case "COMPLETED":
 				if p.State.Result != nil && p.State.Result.Name == "SUCCESSFUL" {
-					statusColor = cs.Green
+					statusColor = cs.RoleColor("success", cs.Green)
 				} else {
-					statusColor = cs.Red
+					statusColor = cs.RoleColor("failure", cs.Red)
 				}
 			case "IN_PROGRESS":
-				statusColor = cs.Yellow
+				statusColor = cs.RoleColor("state_pending", cs.Yellow)
 			case "PENDING":
 				statusColor = cs.Gray
 			default:
@@ -176,27 +356,37 @@ func printPipelines(io *iostreams.IOStreams, pipelines []shared.Pipeline) error
 		} else {
 			statusColor = cs.Gray
 		}
-		tp.AddField(statusColor(status))
-
-		// Branch
-		tp.AddField(p.RefName())
-
-		// Commit
-		tp.AddField(p.CommitHash())
-
-		// Duration
-		if p.DurationIn > 0 {
-			duration := time.Duration(p.DurationIn) * time.Second
-			tp.AddField(duration.String())
-		} else {
-			tp.AddField("-")
-		}
 
-		// Created
-		if t, err := time.Parse(time.RFC3339, p.CreatedOn); err == nil {
-			tp.AddField(text.FuzzyAgo(time.Now(), t))
-		} else {
-			tp.AddField("-")
+		for _, col := range columns {
+			switch col {
+			case "number":
+				tp.AddField(fmt.Sprintf("%d", p.BuildNumber))
+			case "status":
+				tp.AddField(statusColor(p.StatusString()))
+			case "branch":
+				tp.AddField(p.RefName())
+			case "commit":
+				tp.AddField(p.CommitHash())
+			case "creator":
+				creator := ""
+				if p.Creator != nil {
+					creator = p.Creator.DisplayName
+				}
+				tp.AddField(creator)
+			case "duration":
+				if p.DurationIn > 0 {
+					duration := time.Duration(p.DurationIn) * time.Second
+					tp.AddField(duration.String())
+				} else {
+					tp.AddField("-")
+				}
+			case "created":
+				if t, err := time.Parse(time.RFC3339, p.CreatedOn); err == nil {
+					tp.AddField(text.FuzzyAgo(time.Now(), t))
+				} else {
+					tp.AddField("-")
+				}
+			}
 		}
 
 		tp.EndRow()
@@ -3,8 +3,10 @@ package pipeline
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/cancel"
+	configCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/config"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/run"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/schedule"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/view"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -31,6 +33,8 @@ func NewCmdPipeline(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(view.NewCmdView(f, nil))
 	cmd.AddCommand(run.NewCmdRun(f, nil))
 	cmd.AddCommand(cancel.NewCmdCancel(f, nil))
+	cmd.AddCommand(schedule.NewCmdSchedule(f))
+	cmd.AddCommand(configCmd.NewCmdConfig(f))
 
 	return cmd
 }
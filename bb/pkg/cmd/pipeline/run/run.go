@@ -1,11 +1,14 @@
 package run
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -17,6 +20,7 @@ type RunOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
 
 	Branch    string
 	Commit    string
@@ -30,6 +34,7 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
 		Variables:  make(map[string]string),
 	}
 
@@ -40,7 +45,8 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 			Trigger a new pipeline run.
 
 			By default, runs the pipeline for the current branch.
-			You can specify a branch with --branch or a specific commit with --commit.
+			You can specify a branch with --branch or a specific commit with --commit;
+			--commit HEAD resolves the local HEAD commit.
 
 			Use --pattern to run a specific pipeline configuration (e.g., for custom pipelines).
 		`),
@@ -48,6 +54,7 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 			$ bb pipeline run
 			$ bb pipeline run --branch main
 			$ bb pipeline run --commit abc1234
+			$ bb pipeline run --commit HEAD
 			$ bb pipeline run --custom --pattern deploy
 			$ bb pipeline run --variable KEY=value
 		`),
@@ -79,9 +86,21 @@ func runRun(opts *RunOptions) error {
 		return err
 	}
 
-	// Default to main/master branch if not specified
+	// Run for the current branch if neither a branch nor a commit was given
 	if opts.Branch == "" && opts.Commit == "" {
-		opts.Branch = "main"
+		opts.Branch, err = opts.GitClient.CurrentBranch(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not determine current branch: %w", err)
+		}
+	}
+
+	// Resolve a literal HEAD to the local HEAD commit
+	if strings.EqualFold(opts.Commit, "HEAD") {
+		commit, err := opts.GitClient.LastCommit(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not resolve HEAD: %w", err)
+		}
+		opts.Commit = commit.Sha
 	}
 
 	opts.IO.StartProgressIndicator()
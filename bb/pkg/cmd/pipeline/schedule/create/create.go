@@ -0,0 +1,127 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Branch   string
+	Cron     string
+	Pattern  string
+	Disabled bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a pipeline schedule",
+		Long: heredoc.Doc(`
+			Create a schedule that runs a pipeline on a branch at a cron cadence.
+
+			Use --pattern to run a custom pipeline from the branch's bitbucket-pipelines.yml
+			instead of the default one.
+		`),
+		Example: heredoc.Doc(`
+			# Run the default pipeline on main every weekday at 09:00
+			$ bb pipeline schedule create --branch main --cron "0 9 * * 1-5"
+
+			# Run a custom pipeline nightly
+			$ bb pipeline schedule create --branch main --cron "0 2 * * *" --pattern nightly
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to run the pipeline on (required)")
+	cmd.Flags().StringVar(&opts.Cron, "cron", "", "Cron pattern for the schedule (required)")
+	cmd.Flags().StringVar(&opts.Pattern, "pattern", "", "Name of a custom pipeline to run instead of the default")
+	cmd.Flags().BoolVar(&opts.Disabled, "disabled", false, "Create the schedule in a disabled state")
+	_ = cmd.MarkFlagRequired("branch")
+	_ = cmd.MarkFlagRequired("cron")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	schedule, err := createSchedule(httpClient, repo, opts)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created schedule %s on %s (%s)\n",
+			cs.SuccessIcon(), cs.Bold(schedule.UUID), cs.Accent(opts.Branch),
+			shared.DescribeCron(schedule.CronPattern))
+	}
+
+	return nil
+}
+
+func createSchedule(client *http.Client, repo bbrepo.Interface, opts *CreateOptions) (*shared.Schedule, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pipelines_config/schedules",
+		repo.RepoWorkspace(), repo.RepoSlug())
+
+	target := &shared.Target{
+		Type:    "pipeline_ref_target",
+		RefType: "branch",
+		RefName: opts.Branch,
+	}
+	if opts.Pattern != "" {
+		target.Selector = &struct {
+			Type    string `json:"type"`
+			Pattern string `json:"pattern"`
+		}{Type: "custom", Pattern: opts.Pattern}
+	}
+
+	payload := shared.Schedule{
+		Enabled:     !opts.Disabled,
+		CronPattern: opts.Cron,
+		Target:      target,
+	}
+
+	var created shared.Schedule
+	if err := apiClient.Post(repo.RepoHost(), path, payload, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
@@ -0,0 +1,116 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (bbrepo.Interface, error)
+	Prompter   prompter.Prompter
+
+	ScheduleID string
+	Confirm    bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <schedule-id>",
+		Short: "Delete a pipeline schedule",
+		Example: heredoc.Doc(`
+			$ bb pipeline schedule delete {schedule-uuid}
+			$ bb pipeline schedule delete {schedule-uuid} --yes
+		`),
+		Aliases: []string{"remove", "rm"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ScheduleID = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	// Confirm deletion
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
+		confirmed, err := opts.Prompter.Confirm(
+			fmt.Sprintf("Are you sure you want to delete schedule %q?", opts.ScheduleID), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = deleteSchedule(httpClient, repo, opts.ScheduleID)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted schedule %s\n", cs.SuccessIcon(), cs.Bold(opts.ScheduleID))
+	}
+
+	return nil
+}
+
+func deleteSchedule(client *http.Client, repo bbrepo.Interface, scheduleID string) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pipelines_config/schedules/%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), scheduleID)
+
+	return apiClient.Delete(repo.RepoHost(), path)
+}
@@ -0,0 +1,110 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List pipeline schedules for a repository",
+		Example: heredoc.Doc(`
+			$ bb pipeline schedule list
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	schedules, err := fetchSchedules(httpClient, repo)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if len(schedules) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintln(opts.IO.ErrOut, "No pipeline schedules found")
+		}
+		return nil
+	}
+
+	return printSchedules(opts.IO, schedules)
+}
+
+func fetchSchedules(client *http.Client, repo bbrepo.Interface) ([]shared.Schedule, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pipelines_config/schedules?pagelen=100",
+		repo.RepoWorkspace(), repo.RepoSlug())
+
+	var result shared.ScheduleList
+	if err := apiClient.Get(repo.RepoHost(), path, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Values, nil
+}
+
+func printSchedules(io *iostreams.IOStreams, schedules []shared.Schedule) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("ID", "BRANCH", "CRON", "SCHEDULE", "STATUS"))
+
+	for _, s := range schedules {
+		tp.AddField(s.UUID)
+		tp.AddField(s.BranchName())
+		tp.AddField(s.CronPattern)
+		tp.AddField(shared.DescribeCron(s.CronPattern))
+		if s.Enabled {
+			tp.AddField(cs.RoleColor("success", cs.Green)("enabled"))
+		} else {
+			tp.AddField(cs.Gray("disabled"))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
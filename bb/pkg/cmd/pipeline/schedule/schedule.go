@@ -0,0 +1,34 @@
+package schedule
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/schedule/create"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/schedule/delete"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/schedule/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/schedule/update"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdSchedule(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule <command>",
+		Short: "Manage pipeline schedules",
+		Long: heredoc.Doc(`
+			Manage the schedules that run pipelines on a cron cadence.
+		`),
+		Example: heredoc.Doc(`
+			$ bb pipeline schedule list
+			$ bb pipeline schedule create --branch main --cron "0 9 * * 1"
+			$ bb pipeline schedule update {schedule-uuid} --disable
+			$ bb pipeline schedule delete {schedule-uuid}
+		`),
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(update.NewCmdUpdate(f, nil))
+	cmd.AddCommand(delete.NewCmdDelete(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,135 @@
+package update
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UpdateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	ScheduleID string
+	Cron       string
+	Enable     bool
+	Disable    bool
+}
+
+func NewCmdUpdate(f *cmdutil.Factory, runF func(*UpdateOptions) error) *cobra.Command {
+	opts := &UpdateOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update <schedule-id>",
+		Short: "Update a pipeline schedule",
+		Long: heredoc.Doc(`
+			Update the cron pattern or enabled state of a pipeline schedule.
+
+			The schedule is identified by its UUID, as shown by "bb pipeline schedule list".
+		`),
+		Example: heredoc.Doc(`
+			$ bb pipeline schedule update {schedule-uuid} --cron "0 6 * * *"
+			$ bb pipeline schedule update {schedule-uuid} --disable
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ScheduleID = args[0]
+
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --enable or --disable",
+				opts.Enable,
+				opts.Disable,
+			); err != nil {
+				return err
+			}
+			if opts.Cron == "" && !opts.Enable && !opts.Disable {
+				return cmdutil.FlagErrorf("nothing to update: specify --cron, --enable, or --disable")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return updateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Cron, "cron", "", "New cron pattern for the schedule")
+	cmd.Flags().BoolVar(&opts.Enable, "enable", false, "Enable the schedule")
+	cmd.Flags().BoolVar(&opts.Disable, "disable", false, "Disable the schedule")
+
+	return cmd
+}
+
+func updateRun(opts *UpdateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	schedule, err := updateSchedule(httpClient, repo, opts)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		status := "disabled"
+		if schedule.Enabled {
+			status = "enabled"
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Updated schedule %s (%s, %s)\n",
+			cs.SuccessIcon(), cs.Bold(schedule.UUID), shared.DescribeCron(schedule.CronPattern), status)
+	}
+
+	return nil
+}
+
+func updateSchedule(client *http.Client, repo bbrepo.Interface, opts *UpdateOptions) (*shared.Schedule, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pipelines_config/schedules/%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), opts.ScheduleID)
+
+	// The update endpoint requires the full schedule, so fetch the current
+	// state and apply the requested changes to it.
+	var current shared.Schedule
+	if err := apiClient.Get(repo.RepoHost(), path, &current); err != nil {
+		return nil, err
+	}
+
+	if opts.Cron != "" {
+		current.CronPattern = opts.Cron
+	}
+	if opts.Enable {
+		current.Enabled = true
+	}
+	if opts.Disable {
+		current.Enabled = false
+	}
+
+	var updated shared.Schedule
+	if err := apiClient.Put(repo.RepoHost(), path, current, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
@@ -2,10 +2,11 @@ package shared
 
 import (
 	"fmt"
-	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/selector"
 )
 
 // Pipeline represents a Bitbucket pipeline
@@ -112,6 +113,78 @@ type Step struct {
 	Links Links `json:"links"`
 }
 
+// StatusString returns a human-readable status for the step.
+func (s *Step) StatusString() string {
+	if s.State == nil {
+		return "Unknown"
+	}
+
+	switch s.State.Name {
+	case "PENDING":
+		return "Pending"
+	case "IN_PROGRESS":
+		return "Running"
+	case "COMPLETED":
+		if s.State.Result != nil {
+			switch s.State.Result.Name {
+			case "SUCCESSFUL":
+				return "Successful"
+			case "FAILED":
+				return "Failed"
+			case "ERROR":
+				return "Error"
+			case "STOPPED":
+				return "Stopped"
+			case "EXPIRED":
+				return "Expired"
+			}
+		}
+		return "Completed"
+	default:
+		return s.State.Name
+	}
+}
+
+// ExportData maps the step into the fields requested with --json.
+func (s *Step) ExportData(fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "uuid":
+			data[f] = s.UUID
+		case "name":
+			data[f] = s.Name
+		case "status":
+			data[f] = s.StatusString()
+		case "result":
+			if s.State != nil && s.State.Result != nil {
+				data[f] = s.State.Result.Name
+			} else {
+				data[f] = ""
+			}
+		case "duration":
+			data[f] = s.DurationIn
+		case "image":
+			if s.Image != nil {
+				data[f] = s.Image.Name
+			} else {
+				data[f] = ""
+			}
+		case "script":
+			commands := make([]string, 0, len(s.ScriptCommands))
+			for _, c := range s.ScriptCommands {
+				commands = append(commands, c.Command)
+			}
+			data[f] = commands
+		case "started":
+			data[f] = s.StartedOn
+		case "completed":
+			data[f] = s.CompletedOn
+		}
+	}
+	return data
+}
+
 // StepList represents a paginated list of steps
 type StepList struct {
 	Size     int    `json:"size"`
@@ -200,26 +273,139 @@ func (p *Pipeline) CommitHash() string {
 	return hash
 }
 
+// ExportData maps the pipeline into the fields requested with --json. Field
+// names match the column names used by pipeline list.
+func (p *Pipeline) ExportData(fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "number":
+			data[f] = p.BuildNumber
+		case "status":
+			data[f] = p.StatusString()
+		case "branch":
+			data[f] = p.RefName()
+		case "commit":
+			data[f] = p.CommitHash()
+		case "creator":
+			if p.Creator != nil {
+				data[f] = p.Creator.DisplayName
+			} else {
+				data[f] = ""
+			}
+		case "duration":
+			data[f] = p.DurationIn
+		case "created":
+			data[f] = p.CreatedOn
+		}
+	}
+	return data
+}
+
 // ParsePipelineArg parses a pipeline argument which can be a build number or URL
 func ParsePipelineArg(arg string) (int, bbrepo.Interface, error) {
-	// Try parsing as a number first
-	if num, err := strconv.Atoi(arg); err == nil {
-		return num, nil, nil
+	return selector.Pipeline.ParseNumber(arg)
+}
+
+// Schedule represents a pipeline schedule
+type Schedule struct {
+	UUID        string  `json:"uuid,omitempty"`
+	Enabled     bool    `json:"enabled"`
+	CronPattern string  `json:"cron_pattern"`
+	Target      *Target `json:"target,omitempty"`
+	CreatedOn   string  `json:"created_on,omitempty"`
+	UpdatedOn   string  `json:"updated_on,omitempty"`
+}
+
+// ScheduleList represents a paginated list of schedules
+type ScheduleList struct {
+	Size     int        `json:"size"`
+	Page     int        `json:"page"`
+	PageLen  int        `json:"pagelen"`
+	Next     string     `json:"next"`
+	Previous string     `json:"previous"`
+	Values   []Schedule `json:"values"`
+}
+
+// BranchName returns the branch the schedule targets
+func (s *Schedule) BranchName() string {
+	if s.Target == nil {
+		return ""
+	}
+	return s.Target.RefName
+}
+
+var cronWeekdays = map[string]string{
+	"0": "Sunday", "1": "Monday", "2": "Tuesday", "3": "Wednesday",
+	"4": "Thursday", "5": "Friday", "6": "Saturday", "7": "Sunday",
+	"SUN": "Sunday", "MON": "Monday", "TUE": "Tuesday", "WED": "Wednesday",
+	"THU": "Thursday", "FRI": "Friday", "SAT": "Saturday",
+}
+
+// DescribeCron renders common cron patterns as a human-readable phrase,
+// falling back to the raw pattern when it is too exotic to summarize.
+// Both 5-field cron and the Quartz-style 6/7-field patterns Bitbucket
+// stores (with a leading seconds field) are understood.
+func DescribeCron(pattern string) string {
+	fields := strings.Fields(pattern)
+
+	// Strip the seconds (and optional year) fields of Quartz-style patterns.
+	if len(fields) == 6 || len(fields) == 7 {
+		fields = fields[1:6]
+	}
+	if len(fields) != 5 {
+		return pattern
+	}
+
+	minute, hour, dom, _, dow := fields[0], fields[1], fields[2], fields[3], fields[4]
+	if dow == "?" {
+		dow = "*"
+	}
+	if dom == "?" {
+		dom = "*"
+	}
+
+	if minute == "*" && hour == "*" && dom == "*" && dow == "*" {
+		return "every minute"
+	}
+
+	if n, ok := strings.CutPrefix(minute, "*/"); ok && hour == "*" && dom == "*" && dow == "*" {
+		return fmt.Sprintf("every %s minutes", n)
 	}
 
-	// Try parsing as a URL
-	// Format: https://bitbucket.org/WORKSPACE/REPO/addon/pipelines/home#!/results/123
-	re := regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/addon/pipelines/home#!/results/(\d+)`)
-	matches := re.FindStringSubmatch(arg)
-	if matches != nil {
-		host := matches[1]
-		workspace := matches[2]
-		repoSlug := matches[3]
-		num, _ := strconv.Atoi(matches[4])
+	m, err := strconv.Atoi(minute)
+	if err != nil {
+		return pattern
+	}
 
-		repo := bbrepo.NewWithHost(workspace, repoSlug, host)
-		return num, repo, nil
+	if n, ok := strings.CutPrefix(hour, "*/"); ok && dom == "*" && dow == "*" {
+		return fmt.Sprintf("every %s hours at minute %d", n, m)
 	}
 
-	return 0, nil, fmt.Errorf("invalid pipeline argument: %s", arg)
+	if hour == "*" && dom == "*" && dow == "*" {
+		return fmt.Sprintf("hourly at minute %d", m)
+	}
+
+	h, err := strconv.Atoi(hour)
+	if err != nil {
+		return pattern
+	}
+	at := fmt.Sprintf("%02d:%02d", h, m)
+
+	switch {
+	case dom == "*" && dow == "*":
+		return fmt.Sprintf("daily at %s", at)
+	case dom == "*":
+		day, ok := cronWeekdays[strings.ToUpper(dow)]
+		if !ok {
+			return pattern
+		}
+		return fmt.Sprintf("weekly on %s at %s", day, at)
+	case dow == "*":
+		if _, err := strconv.Atoi(dom); err != nil {
+			return pattern
+		}
+		return fmt.Sprintf("monthly on day %s at %s", dom, at)
+	}
+	return pattern
 }
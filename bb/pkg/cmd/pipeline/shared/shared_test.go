@@ -0,0 +1,32 @@
+package shared
+
+import "testing"
+
+func TestDescribeCron(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"* * * * *", "every minute"},
+		{"*/15 * * * *", "every 15 minutes"},
+		{"30 * * * *", "hourly at minute 30"},
+		{"0 */2 * * *", "every 2 hours at minute 0"},
+		{"0 9 * * *", "daily at 09:00"},
+		{"0 9 * * 1", "weekly on Monday at 09:00"},
+		{"0 9 * * MON", "weekly on Monday at 09:00"},
+		{"15 2 1 * *", "monthly on day 1 at 02:15"},
+		// Quartz-style pattern with a seconds field, as stored by Bitbucket
+		{"0 0 9 * * 1", "weekly on Monday at 09:00"},
+		{"0 0 9 ? * 1 *", "weekly on Monday at 09:00"},
+		// Patterns too exotic to summarize fall back to the raw string
+		{"0 9 * * 1-5", "0 9 * * 1-5"},
+		{"1,31 * * * *", "1,31 * * * *"},
+		{"not a cron", "not a cron"},
+	}
+
+	for _, tt := range tests {
+		if got := DescribeCron(tt.pattern); got != tt.want {
+			t.Errorf("DescribeCron(%q) = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package view
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -24,7 +25,18 @@ type ViewOptions struct {
 
 	SelectorArg string
 	Web         bool
+	NoBrowser   bool
 	Steps       bool
+	StepName    string
+	Annotations bool
+	Exporter    cmdutil.Exporter
+}
+
+// pipelineViewFields are available to pipeline view --json. The step fields
+// apply when --steps or --step narrows the output to steps.
+var pipelineViewFields = []string{
+	"number", "status", "branch", "commit", "creator", "duration", "created",
+	"uuid", "name", "result", "image", "script", "started", "completed",
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -43,16 +55,35 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			With --web, open the pipeline in a web browser instead.
 			With --steps, show the steps in the pipeline.
+			With --step, show the detail of a single step, including its
+			script commands.
+			With --steps --annotations, also fetch each step's test report and
+			show failed test names under the step, so a broken build can be
+			diagnosed without opening the browser.
 		`),
 		Example: heredoc.Doc(`
 			$ bb pipeline view 123
 			$ bb pipeline view 123 --web
 			$ bb pipeline view 123 --steps
+			$ bb pipeline view 123 --steps --annotations
+			$ bb pipeline view 123 --step "Build and test"
+			$ bb pipeline view 123 --steps --json name,status,duration
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.SelectorArg = args[0]
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--steps` or `--step`",
+				opts.Steps, opts.StepName != "",
+			); err != nil {
+				return err
+			}
+
+			if opts.Annotations && !opts.Steps {
+				return cmdutil.FlagErrorf("--annotations requires --steps")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -61,7 +92,11 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open pipeline in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
 	cmd.Flags().BoolVarP(&opts.Steps, "steps", "s", false, "Show pipeline steps")
+	cmd.Flags().StringVar(&opts.StepName, "step", "", "Show details of the step with this `name`")
+	cmd.Flags().BoolVar(&opts.Annotations, "annotations", false, "Show test report summaries and failed test names under each step")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, pipelineViewFields)
 
 	return cmd
 }
@@ -100,10 +135,41 @@ func viewRun(opts *ViewOptions) error {
 	openURL := pipeline.HTMLURL()
 
 	if opts.Web {
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, openURL, opts.NoBrowser)
+	}
+
+	if opts.StepName != "" {
+		opts.IO.StartProgressIndicator()
+		steps, err := fetchPipelineSteps(httpClient, repo, pipeline.UUID)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		step := findStep(steps, opts.StepName)
+		if step == nil {
+			return fmt.Errorf("no step named %q in pipeline #%d", opts.StepName, pipeline.BuildNumber)
+		}
+
+		if opts.Exporter != nil {
+			return opts.Exporter.Write(opts.IO, step)
+		}
+		printStepDetail(opts.IO, step)
+		return nil
+	}
+
+	if opts.Steps && opts.Exporter != nil {
+		opts.IO.StartProgressIndicator()
+		steps, err := fetchPipelineSteps(httpClient, repo, pipeline.UUID)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
 		}
-		return opts.Browser.Browse(openURL)
+		return opts.Exporter.Write(opts.IO, steps)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, pipeline)
 	}
 
 	if err := printPipeline(opts.IO, pipeline); err != nil {
@@ -113,18 +179,33 @@ func viewRun(opts *ViewOptions) error {
 	if opts.Steps {
 		opts.IO.StartProgressIndicator()
 		steps, err := fetchPipelineSteps(httpClient, repo, pipeline.UUID)
+
+		var reports map[string]*testReport
+		if err == nil && opts.Annotations {
+			reports = fetchTestReports(httpClient, repo, pipeline.UUID, steps)
+		}
 		opts.IO.StopProgressIndicator()
 
 		if err != nil {
 			return err
 		}
 
-		printSteps(opts.IO, steps)
+		printSteps(opts.IO, steps, reports)
 	}
 
 	return nil
 }
 
+// findStep returns the first step whose name matches, ignoring case.
+func findStep(steps []shared.Step, name string) *shared.Step {
+	for i := range steps {
+		if strings.EqualFold(steps[i].Name, name) {
+			return &steps[i]
+		}
+	}
+	return nil
+}
+
 func fetchPipeline(client *http.Client, repo bbrepo.Interface, buildNumber int) (*shared.Pipeline, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
@@ -160,6 +241,74 @@ func fetchPipelineSteps(client *http.Client, repo bbrepo.Interface, pipelineUUID
 	return result.Values, nil
 }
 
+// maxFailedTestNames bounds how many failed test names are listed under a
+// step; a full list of a very red build belongs in the browser.
+const maxFailedTestNames = 10
+
+// testReport summarizes a step's Pipelines test report.
+type testReport struct {
+	Total       int
+	Failed      int
+	FailedNames []string
+}
+
+// fetchTestReports collects the test report of every step that has one.
+// Reports are an optional Pipelines feature, so steps without one — or whose
+// report cannot be fetched — are simply absent from the result.
+func fetchTestReports(client *http.Client, repo bbrepo.Interface, pipelineUUID string, steps []shared.Step) map[string]*testReport {
+	apiClient := api.NewClientFromHTTP(client)
+	reports := map[string]*testReport{}
+
+	for _, step := range steps {
+		var summary struct {
+			Result struct {
+				Total  int `json:"number_of_test_cases"`
+				Failed int `json:"number_of_failed_test_cases"`
+				Error  int `json:"number_of_error_test_cases"`
+			} `json:"result"`
+		}
+		reportPath := fmt.Sprintf("repositories/%s/%s/pipelines/%s/steps/%s/test_reports",
+			repo.RepoWorkspace(), repo.RepoSlug(), pipelineUUID, step.UUID)
+		if err := apiClient.Get(repo.RepoHost(), reportPath, &summary); err != nil {
+			continue
+		}
+		if summary.Result.Total == 0 {
+			continue
+		}
+
+		report := &testReport{
+			Total:  summary.Result.Total,
+			Failed: summary.Result.Failed + summary.Result.Error,
+		}
+
+		if report.Failed > 0 {
+			var cases struct {
+				Values []struct {
+					Name   string `json:"name"`
+					Status string `json:"status"`
+				} `json:"values"`
+			}
+			casesPath := fmt.Sprintf("repositories/%s/%s/pipelines/%s/steps/%s/test_reports/test_cases?pagelen=100",
+				repo.RepoWorkspace(), repo.RepoSlug(), pipelineUUID, step.UUID)
+			if err := apiClient.Get(repo.RepoHost(), casesPath, &cases); err == nil {
+				for _, c := range cases.Values {
+					if c.Status != "FAILED" && c.Status != "ERROR" {
+						continue
+					}
+					report.FailedNames = append(report.FailedNames, c.Name)
+					if len(report.FailedNames) == maxFailedTestNames {
+						break
+					}
+				}
+			}
+		}
+
+		reports[step.UUID] = report
+	}
+
+	return reports
+}
+
 func printPipeline(io *iostreams.IOStreams, pipeline *shared.Pipeline) error {
 	cs := io.ColorScheme()
 	out := io.Out
@@ -246,7 +395,51 @@ func printPipeline(io *iostreams.IOStreams, pipeline *shared.Pipeline) error {
 	return nil
 }
 
-func printSteps(io *iostreams.IOStreams, steps []shared.Step) {
+func printStepDetail(io *iostreams.IOStreams, step *shared.Step) {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Step:"), step.Name)
+	fmt.Fprintln(out)
+
+	status := step.StatusString()
+	var statusColor func(string) string
+	switch status {
+	case "Successful":
+		statusColor = cs.Green
+	case "Failed", "Error":
+		statusColor = cs.Red
+	case "Running":
+		statusColor = cs.Yellow
+	default:
+		statusColor = cs.Gray
+	}
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Status:"), statusColor(status))
+
+	if step.Image != nil && step.Image.Name != "" {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Image:"), step.Image.Name)
+	}
+
+	if step.DurationIn > 0 {
+		duration := time.Duration(step.DurationIn) * time.Second
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Duration:"), duration.String())
+	}
+
+	if t, err := time.Parse(time.RFC3339, step.StartedOn); err == nil {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Started:"), text.FuzzyAgo(time.Now(), t))
+	}
+
+	if len(step.ScriptCommands) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintf(out, "%s\n", cs.Bold("── Script ──"))
+		fmt.Fprintln(out)
+		for _, c := range step.ScriptCommands {
+			fmt.Fprintf(out, "  %s\n", c.Command)
+		}
+	}
+}
+
+func printSteps(io *iostreams.IOStreams, steps []shared.Step, reports map[string]*testReport) {
 	cs := io.ColorScheme()
 	out := io.Out
 
@@ -267,24 +460,24 @@ func printSteps(io *iostreams.IOStreams, steps []shared.Step) {
 			switch step.State.Name {
 			case "COMPLETED":
 				if step.State.Result != nil && step.State.Result.Name == "SUCCESSFUL" {
-					statusIcon = "✓"
+					statusIcon = cs.WordedIcon("✓", "Passed:")
 					statusColor = cs.Green
 				} else {
-					statusIcon = "✗"
+					statusIcon = cs.WordedIcon("✗", "Failed:")
 					statusColor = cs.Red
 				}
 			case "IN_PROGRESS":
-				statusIcon = "●"
+				statusIcon = cs.WordedIcon("●", "In progress:")
 				statusColor = cs.Yellow
 			case "PENDING":
-				statusIcon = "○"
+				statusIcon = cs.WordedIcon("○", "Pending:")
 				statusColor = cs.Gray
 			default:
-				statusIcon = "○"
+				statusIcon = cs.WordedIcon("○", "Pending:")
 				statusColor = cs.Gray
 			}
 		} else {
-			statusIcon = "○"
+			statusIcon = cs.WordedIcon("○", "Pending:")
 			statusColor = cs.Gray
 		}
 
@@ -306,5 +499,20 @@ func printSteps(io *iostreams.IOStreams, steps []shared.Step) {
 		if step.Image != nil && step.Image.Name != "" {
 			fmt.Fprintf(out, "    %s\n", cs.Gray("Image: "+step.Image.Name))
 		}
+
+		// Test report
+		if report := reports[step.UUID]; report != nil {
+			if report.Failed == 0 {
+				fmt.Fprintf(out, "    %s\n", cs.Gray(fmt.Sprintf("Tests: %d run, all passed", report.Total)))
+				continue
+			}
+			fmt.Fprintf(out, "    Tests: %d run, %s\n", report.Total, cs.Red(text.Pluralize(report.Failed, "failure")))
+			for _, name := range report.FailedNames {
+				fmt.Fprintf(out, "      %s %s\n", cs.Red(cs.WordedIcon("✗", "Failed:")), name)
+			}
+			if remaining := report.Failed - len(report.FailedNames); remaining > 0 {
+				fmt.Fprintf(out, "      %s\n", cs.Gray(fmt.Sprintf("… and %d more", remaining)))
+			}
+		}
 	}
 }
@@ -0,0 +1,150 @@
+package assignreviewer
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AssignReviewerOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	SelectorArg string
+	Reviewer    string
+}
+
+func NewCmdAssignReviewer(f *cmdutil.Factory, runF func(*AssignReviewerOptions) error) *cobra.Command {
+	opts := &AssignReviewerOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "assign-reviewer <number> [@me | <uuid>]",
+		Short: "Add a reviewer to a pull request",
+		Long: heredoc.Doc(`
+			Add a reviewer to a pull request.
+
+			The reviewer can be "@me" for the authenticated user, or a user UUID.
+			Without a reviewer argument, you are added as a reviewer.
+		`),
+		Example: heredoc.Doc(`
+			# Add yourself as a reviewer on pull request #123
+			$ bb pr assign-reviewer 123
+
+			# Add yourself explicitly
+			$ bb pr assign-reviewer 123 @me
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+			if len(args) > 1 {
+				opts.Reviewer = args[1]
+			} else {
+				opts.Reviewer = "@me"
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return assignReviewerRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func assignReviewerRun(opts *AssignReviewerOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	// Parse the PR argument first to check if it contains repo info
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	// Use the repo from URL if provided, otherwise resolve from git remotes
+	var repo bbrepo.Interface
+	if prRepo != nil {
+		repo = prRepo
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	defer opts.IO.StopProgressIndicator()
+
+	reviewerUUID := opts.Reviewer
+	reviewerDisplay := opts.Reviewer
+	if api.IsSelfReference(opts.Reviewer) {
+		user, err := api.CurrentUserCached(apiClient, repo.RepoHost())
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %w", opts.Reviewer, err)
+		}
+		reviewerUUID = user.UUID
+		reviewerDisplay = user.DisplayName
+	}
+
+	pr, err := list.FetchPullRequest(httpClient, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	// Reviewers are replaced wholesale on update, so include the existing set
+	reviewers := make([]reviewerRef, 0, len(pr.Reviewers)+1)
+	for _, r := range pr.Reviewers {
+		if r.UUID == reviewerUUID {
+			opts.IO.StopProgressIndicator()
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s %s is already a reviewer on pull request #%d\n",
+				cs.WarningIcon(), cs.Bold(r.DisplayName), prID)
+			return nil
+		}
+		reviewers = append(reviewers, reviewerRef{UUID: r.UUID})
+	}
+	reviewers = append(reviewers, reviewerRef{UUID: reviewerUUID})
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+	payload := struct {
+		Title     string        `json:"title"`
+		Reviewers []reviewerRef `json:"reviewers"`
+	}{
+		// title is required by the PR update endpoint
+		Title:     pr.Title,
+		Reviewers: reviewers,
+	}
+	if err := apiClient.Put(repo.RepoHost(), path, payload, nil); err != nil {
+		return fmt.Errorf("failed to add reviewer: %w", err)
+	}
+
+	opts.IO.StopProgressIndicator()
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Added %s as a reviewer on pull request #%d\n",
+		cs.SuccessIcon(), cs.Bold(reviewerDisplay), prID)
+
+	return nil
+}
+
+type reviewerRef struct {
+	UUID string `json:"uuid"`
+}
@@ -0,0 +1,272 @@
+package backport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type BackportOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	SelectorArg string
+	Targets     []string
+	Continue    bool
+}
+
+// errConflict marks a target whose cherry-pick was left in progress for
+// manual resolution.
+var errConflict = errors.New("cherry-pick conflict")
+
+func NewCmdBackport(f *cmdutil.Factory, runF func(*BackportOptions) error) *cobra.Command {
+	opts := &BackportOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "backport <number> --to <branch> [--to <branch>]",
+		Short: "Backport a merged pull request to one or more release branches",
+		Long: heredoc.Doc(`
+			Cherry-pick the merge commit of a merged pull request onto each given
+			release branch, push a backport branch, and open a backport pull
+			request per target.
+
+			Targets are processed in order and each result is reported
+			individually. When a cherry-pick hits conflicts, it is left in
+			progress on the backport branch: resolve the conflicts, finish with
+			"git cherry-pick --continue", and rerun the command with --continue
+			to push, open the pull request, and carry on with the remaining
+			targets.
+		`),
+		Example: heredoc.Doc(`
+			# Backport pull request #123 to one release branch
+			$ bb pr backport 123 --to release/1.x
+
+			# Backport to several release branches in one go
+			$ bb pr backport 123 --to release/1.x --to release/2.x
+
+			# After resolving conflicts and running "git cherry-pick --continue"
+			$ bb pr backport 123 --to release/1.x --to release/2.x --continue
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+
+			if len(opts.Targets) == 0 {
+				return cmdutil.FlagErrorf("at least one --to branch is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return backportRun(opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.Targets, "to", nil, "Release `branch` to backport onto (can be given multiple times)")
+	cmd.Flags().BoolVar(&opts.Continue, "continue", false, "Resume after resolving cherry-pick conflicts manually")
+
+	return cmd
+}
+
+func backportRun(opts *BackportOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+	if prRepo != nil {
+		repo = prRepo
+	}
+
+	pr, err := list.FetchPullRequest(httpClient, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	if pr.State != "MERGED" {
+		return fmt.Errorf("pull request #%d is not merged (state: %s)", prID, pr.StateDisplay())
+	}
+	if pr.MergeCommit == nil || pr.MergeCommit.Hash == "" {
+		return fmt.Errorf("pull request #%d has no merge commit to cherry-pick", prID)
+	}
+
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+
+	currentBranch, err := opts.GitClient.CurrentBranch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for i, target := range opts.Targets {
+		branch := backportBranchName(prID, target)
+
+		// With --continue, the target whose backport branch is checked out had
+		// its conflicts resolved manually; push it and open the pull request.
+		resume := opts.Continue && currentBranch == branch
+
+		err := backportTarget(opts, repo, pr, target, branch, resume)
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, errConflict) {
+			failed = append(failed, target)
+			fmt.Fprintf(opts.IO.ErrOut, "%s Cherry-pick onto %s hit conflicts and was left in progress\n", cs.FailureIcon(), cs.Bold(target))
+			fmt.Fprintf(opts.IO.ErrOut, "  Resolve the conflicts, run %s, then rerun this command with %s\n",
+				cs.Bold("git cherry-pick --continue"), cs.Bold("--continue"))
+			if remaining := opts.Targets[i+1:]; len(remaining) > 0 {
+				fmt.Fprintf(opts.IO.ErrOut, "  Remaining targets not attempted: %s\n", strings.Join(remaining, ", "))
+			}
+			break
+		}
+		return err
+	}
+
+	if len(failed) > 0 {
+		return cmdutil.SilentError
+	}
+
+	// Only return to the starting branch when no cherry-pick was left behind
+	if err := opts.GitClient.CheckoutBranch(ctx, currentBranch); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Failed to return to branch %s: %v\n", cs.WarningIcon(), currentBranch, err)
+	}
+
+	return nil
+}
+
+func backportBranchName(prID int, target string) string {
+	return fmt.Sprintf("backport-%d-to-%s", prID, strings.ReplaceAll(target, "/", "-"))
+}
+
+// backportTarget cherry-picks the pull request's merge commit onto target and
+// opens the backport pull request. With resume, the cherry-pick has already
+// been completed manually on the current branch and only the push and pull
+// request remain. A conflicting cherry-pick is reported as errConflict and
+// deliberately left in progress so it can be resolved by hand.
+func backportTarget(opts *BackportOptions, repo bbrepo.Interface, pr *shared.PullRequest, target, branch string, resume bool) error {
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+	remote := "origin"
+	hash := pr.MergeCommit.Hash
+
+	if !resume {
+		// Refuse to touch a dirty working copy
+		if changes, err := opts.GitClient.UncommittedChangeCount(ctx); err != nil {
+			return err
+		} else if changes > 0 {
+			return fmt.Errorf("cannot cherry-pick with uncommitted changes; commit or stash them first")
+		}
+
+		if err := opts.GitClient.Fetch(ctx, remote, target); err != nil {
+			return fmt.Errorf("failed to fetch %s: %w", target, err)
+		}
+		if err := opts.GitClient.Fetch(ctx, remote, hash); err != nil {
+			return fmt.Errorf("failed to fetch commit %s: %w", hash, err)
+		}
+
+		if opts.GitClient.HasLocalBranch(ctx, branch) {
+			return fmt.Errorf("branch %s already exists; delete it before backporting to %s", branch, target)
+		}
+
+		if err := runGit(ctx, opts.GitClient, "checkout", "-b", branch, fmt.Sprintf("%s/%s", remote, target)); err != nil {
+			return fmt.Errorf("failed to create branch %s from %s/%s: %w", branch, remote, target, err)
+		}
+
+		fmt.Fprintf(opts.IO.ErrOut, "Cherry-picking %s onto %s...\n", hash, target)
+		// A merge commit needs -m 1 to pick the changes relative to the
+		// mainline; squash and fast-forward merges produce ordinary commits.
+		if err := runGit(ctx, opts.GitClient, "cherry-pick", "-m", "1", hash); err != nil {
+			if err := runGit(ctx, opts.GitClient, "cherry-pick", hash); err != nil {
+				return errConflict
+			}
+		}
+	} else {
+		// The resolved cherry-pick must be committed before resuming
+		if changes, err := opts.GitClient.UncommittedChangeCount(ctx); err != nil {
+			return err
+		} else if changes > 0 {
+			return fmt.Errorf("the cherry-pick on %s is not finished; run `git cherry-pick --continue` first", branch)
+		}
+	}
+
+	if err := runGit(ctx, opts.GitClient, "push", "-u", remote, branch); err != nil {
+		return fmt.Errorf("failed to push %s: %w", branch, err)
+	}
+
+	backport, err := createBackportPR(opts, repo, pr, branch, target)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created backport pull request #%d for %s\n", cs.SuccessIcon(), backport.ID, cs.Bold(target))
+	if backport.HTMLURL() != "" {
+		fmt.Fprintln(opts.IO.Out, backport.HTMLURL())
+	}
+
+	return nil
+}
+
+func runGit(ctx context.Context, client *git.Client, args ...string) error {
+	cmd, err := client.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	return cmd.Run()
+}
+
+func createBackportPR(opts *BackportOptions, repo bbrepo.Interface, pr *shared.PullRequest, branch, target string) (*shared.PullRequest, error) {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests", repo.RepoWorkspace(), repo.RepoSlug())
+	body := map[string]interface{}{
+		"title":               fmt.Sprintf("[Backport %s] %s", target, pr.Title),
+		"description":         fmt.Sprintf("Backport of #%d to `%s`.", pr.ID, target),
+		"close_source_branch": true,
+		"source": map[string]interface{}{
+			"branch": map[string]string{"name": branch},
+		},
+		"destination": map[string]interface{}{
+			"branch": map[string]string{"name": target},
+		},
+	}
+
+	var backport shared.PullRequest
+	if err := apiClient.Post(repo.RepoHost(), path, body, &backport); err != nil {
+		return nil, fmt.Errorf("failed to create backport pull request: %w", err)
+	}
+
+	return &backport, nil
+}
@@ -24,6 +24,8 @@ type CheckoutOptions struct {
 	SelectorArg string
 	BranchName  string
 	Force       bool
+	Detach      bool
+	Worktree    string
 }
 
 func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobra.Command {
@@ -48,11 +50,30 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 
 			# Check out with a custom local branch name
 			$ bb pr checkout 123 --branch my-branch
+
+			# Check out the head commit without creating a branch
+			$ bb pr checkout 123 --detach
+
+			# Review the pull request in a separate worktree
+			$ bb pr checkout 123 --worktree ../review-123
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.SelectorArg = args[0]
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--detach` or `--branch`",
+				opts.Detach, opts.BranchName != "",
+			); err != nil {
+				return err
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--detach` or `--worktree`",
+				opts.Detach, opts.Worktree != "",
+			); err != nil {
+				return err
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -62,6 +83,8 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 
 	cmd.Flags().StringVarP(&opts.BranchName, "branch", "b", "", "Local branch name to use")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Force checkout even if there are local changes")
+	cmd.Flags().BoolVar(&opts.Detach, "detach", false, "Check out the head commit without creating a branch")
+	cmd.Flags().StringVar(&opts.Worktree, "worktree", "", "Create a git worktree for the pull request in `directory`")
 
 	return cmd
 }
@@ -109,6 +132,30 @@ func checkoutRun(opts *CheckoutOptions) error {
 	// Fetch the branch
 	fmt.Fprintf(opts.IO.ErrOut, "Fetching %s from %s...\n", pr.HeadBranch(), remote)
 
+	if opts.Detach {
+		if err := opts.GitClient.Fetch(ctx, remote, pr.HeadBranch()); err != nil {
+			return fmt.Errorf("failed to fetch branch: %w", err)
+		}
+
+		checkoutArgs := []string{"checkout", "--detach"}
+		if opts.Force {
+			checkoutArgs = append(checkoutArgs, "--force")
+		}
+		checkoutArgs = append(checkoutArgs, "FETCH_HEAD")
+
+		cmd, err := opts.GitClient.Command(ctx, checkoutArgs...)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to checkout commit: %w", err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s Checked out the head commit of pull request #%d (detached HEAD)\n",
+			cs.SuccessIcon(), pr.ID)
+		return nil
+	}
+
 	// git fetch origin <branch>:<local-branch>
 	fetchRefspec := fmt.Sprintf("%s:%s", pr.HeadBranch(), branchName)
 	fetchErr := opts.GitClient.Fetch(ctx, remote, fetchRefspec)
@@ -138,6 +185,26 @@ func checkoutRun(opts *CheckoutOptions) error {
 		}
 	}
 
+	if opts.Worktree != "" {
+		worktreeArgs := []string{"worktree", "add"}
+		if opts.Force {
+			worktreeArgs = append(worktreeArgs, "--force")
+		}
+		worktreeArgs = append(worktreeArgs, opts.Worktree, branchName)
+
+		cmd, err := opts.GitClient.Command(ctx, worktreeArgs...)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to create worktree: %w", err)
+		}
+
+		fmt.Fprintf(opts.IO.Out, "%s Created worktree %s for pull request #%d on branch %s\n",
+			cs.SuccessIcon(), opts.Worktree, pr.ID, cs.Cyan(branchName))
+		return nil
+	}
+
 	// Checkout the branch
 	checkoutArgs := []string{"checkout", branchName}
 	if opts.Force {
@@ -2,7 +2,11 @@ package checks
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -11,6 +15,7 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	pipelineshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -26,6 +31,9 @@ type ChecksOptions struct {
 
 	SelectorArg string
 	Web         bool
+	NoBrowser   bool
+	Logs        bool
+	ExitStatus  bool
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
@@ -43,10 +51,18 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 			Show CI status (pipeline builds) for a pull request.
 
 			Without an argument, shows checks for the PR associated with the current branch.
+
+			Checks that originate from Bitbucket Pipelines are resolved to their
+			failing step, so the table links directly to the step's log. With
+			--logs, the logs of failed steps are printed inline.
 		`),
 		Example: heredoc.Doc(`
 			$ bb pr checks 123
 			$ bb pr checks 123 --web
+			$ bb pr checks 123 --logs
+
+			# Gate a script on the checks passing
+			$ bb pr checks 123 --exit-status && deploy
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -54,6 +70,10 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 				opts.SelectorArg = args[0]
 			}
 
+			if opts.ExitStatus && opts.Web {
+				return cmdutil.FlagErrorf("`--exit-status` cannot be used with `--web`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -62,6 +82,9 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open PR checks in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
+	cmd.Flags().BoolVar(&opts.Logs, "logs", false, "Print logs of failed pipeline steps")
+	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with a non-zero status unless all checks are successful")
 
 	return cmd
 }
@@ -121,7 +144,7 @@ func checksRun(opts *ChecksOptions) error {
 	commitHash := pr.Source.Commit.Hash
 
 	// Fetch build statuses for the commit
-	statuses, err := fetchBuildStatuses(httpClient, repo, commitHash)
+	statuses, err := FetchBuildStatuses(httpClient, repo, commitHash)
 	opts.IO.StopProgressIndicator()
 
 	if err != nil {
@@ -131,10 +154,7 @@ func checksRun(opts *ChecksOptions) error {
 	if opts.Web {
 		// Open the PR page which shows the checks
 		openURL := pr.HTMLURL()
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
-		}
-		return opts.Browser.Browse(openURL)
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, openURL, opts.NoBrowser)
 	}
 
 	if len(statuses) == 0 {
@@ -144,10 +164,42 @@ func checksRun(opts *ChecksOptions) error {
 		return nil
 	}
 
-	return printStatuses(opts.IO, statuses)
+	// Resolve failed Pipelines statuses to their failing steps so the table
+	// can link straight to the step. Best-effort: a status that can't be
+	// resolved renders like any other check.
+	details := make([]*pipelineCheck, len(statuses))
+	for i, s := range statuses {
+		if s.State != "FAILED" {
+			continue
+		}
+		if check, err := resolvePipelineCheck(httpClient, repo, s); err == nil {
+			details[i] = check
+		}
+	}
+
+	if err := printStatuses(opts.IO, repo, statuses, details); err != nil {
+		return err
+	}
+
+	if opts.Logs {
+		if err := printFailedStepLogs(opts.IO, httpClient, repo, statuses, details); err != nil {
+			return err
+		}
+	}
+
+	if opts.ExitStatus {
+		for _, s := range statuses {
+			if s.State != "SUCCESSFUL" {
+				return cmdutil.SilentError
+			}
+		}
+	}
+
+	return nil
 }
 
-func fetchBuildStatuses(client *http.Client, repo bbrepo.Interface, commitHash string) ([]BuildStatus, error) {
+// FetchBuildStatuses returns the build statuses reported against a commit.
+func FetchBuildStatuses(client *http.Client, repo bbrepo.Interface, commitHash string) ([]BuildStatus, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
 	path := fmt.Sprintf("repositories/%s/%s/commit/%s/statuses?pagelen=100",
@@ -162,15 +214,15 @@ func fetchBuildStatuses(client *http.Client, repo bbrepo.Interface, commitHash s
 	return result.Values, nil
 }
 
-func printStatuses(io *iostreams.IOStreams, statuses []BuildStatus) error {
+func printStatuses(io *iostreams.IOStreams, repo bbrepo.Interface, statuses []BuildStatus, details []*pipelineCheck) error {
 	cs := io.ColorScheme()
 
-	tp := tableprinter.New(io, tableprinter.WithHeader("STATUS", "NAME", "DESCRIPTION", "UPDATED"))
+	tp := tableprinter.New(io, tableprinter.WithHeader("STATUS", "NAME", "DESCRIPTION", "UPDATED", "LINK"))
 
 	// Count results
 	var passed, failed, pending int
 
-	for _, s := range statuses {
+	for i, s := range statuses {
 		// Status with color
 		var status string
 		var statusColor func(string) string
@@ -196,11 +248,17 @@ func printStatuses(io *iostreams.IOStreams, statuses []BuildStatus) error {
 		}
 		tp.AddField(statusColor(status))
 
-		// Name
+		// Name, with the failing step appended for resolved pipeline checks
 		name := s.Name
 		if name == "" {
 			name = s.Key
 		}
+		link := s.URL
+		if check := details[i]; check != nil && len(check.FailedSteps) > 0 {
+			step := check.FailedSteps[0]
+			name = fmt.Sprintf("%s / %s", name, step.Name)
+			link = check.StepURL(repo, step)
+		}
 		tp.AddField(name)
 
 		// Description
@@ -217,6 +275,8 @@ func printStatuses(io *iostreams.IOStreams, statuses []BuildStatus) error {
 			tp.AddField("-")
 		}
 
+		tp.AddField(cs.Gray(link))
+
 		tp.EndRow()
 	}
 
@@ -228,13 +288,145 @@ func printStatuses(io *iostreams.IOStreams, statuses []BuildStatus) error {
 	fmt.Fprintln(io.Out)
 	if failed > 0 {
 		fmt.Fprintf(io.Out, "%s %d/%d checks passing\n",
-			cs.Red("✗"), passed, len(statuses))
+			cs.Red(cs.WordedIcon("✗", "Failed:")), passed, len(statuses))
 	} else if pending > 0 {
 		fmt.Fprintf(io.Out, "%s %d/%d checks passing, %d pending\n",
-			cs.Yellow("●"), passed, len(statuses), pending)
+			cs.Yellow(cs.WordedIcon("●", "Pending:")), passed, len(statuses), pending)
 	} else {
-		fmt.Fprintf(io.Out, "%s All checks passed\n", cs.Green("✓"))
+		fmt.Fprintf(io.Out, "%s All checks passed\n", cs.Green(cs.WordedIcon("✓", "Success:")))
 	}
 
 	return nil
 }
+
+// pipelineCheck links a build status back to its Bitbucket Pipelines run.
+type pipelineCheck struct {
+	BuildNumber  int
+	PipelineUUID string
+	FailedSteps  []pipelineshared.Step
+}
+
+// StepURL is the deep link to a step of the pipeline run.
+func (c *pipelineCheck) StepURL(repo bbrepo.Interface, step pipelineshared.Step) string {
+	return bbrepo.GenerateRepoURL(repo, "addon/pipelines/home#!/results/%d/steps/%s",
+		c.BuildNumber, step.UUID)
+}
+
+// pipelineResultsRE matches the build number in a Pipelines status URL,
+// e.g. .../addon/pipelines/home#!/results/123
+var pipelineResultsRE = regexp.MustCompile(`#!/results/(\d+)`)
+
+// resolvePipelineCheck resolves a build status that originates from Bitbucket
+// Pipelines to the pipeline run and its failed steps. It returns nil for
+// statuses posted by other CI systems.
+func resolvePipelineCheck(client *http.Client, repo bbrepo.Interface, status BuildStatus) (*pipelineCheck, error) {
+	matches := pipelineResultsRE.FindStringSubmatch(status.URL)
+	if matches == nil {
+		return nil, nil
+	}
+	buildNumber, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pipelines?q=build_number=%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), buildNumber)
+	var pipelines pipelineshared.PipelineList
+	if err := apiClient.Get(repo.RepoHost(), path, &pipelines); err != nil {
+		return nil, err
+	}
+	if len(pipelines.Values) == 0 {
+		return nil, nil
+	}
+
+	check := &pipelineCheck{
+		BuildNumber:  buildNumber,
+		PipelineUUID: pipelines.Values[0].UUID,
+	}
+
+	path = fmt.Sprintf("repositories/%s/%s/pipelines/%s/steps",
+		repo.RepoWorkspace(), repo.RepoSlug(), check.PipelineUUID)
+	var steps pipelineshared.StepList
+	if err := apiClient.Get(repo.RepoHost(), path, &steps); err != nil {
+		return nil, err
+	}
+
+	for _, step := range steps.Values {
+		if step.State == nil || step.State.Result == nil {
+			continue
+		}
+		switch step.State.Result.Name {
+		case "FAILED", "ERROR":
+			check.FailedSteps = append(check.FailedSteps, step)
+		}
+	}
+
+	return check, nil
+}
+
+// printFailedStepLogs prints the logs of every failed pipeline step inline.
+func printFailedStepLogs(io *iostreams.IOStreams, client *http.Client, repo bbrepo.Interface, statuses []BuildStatus, details []*pipelineCheck) error {
+	cs := io.ColorScheme()
+	printed := 0
+
+	for i, check := range details {
+		if check == nil {
+			continue
+		}
+		name := statuses[i].Name
+		if name == "" {
+			name = statuses[i].Key
+		}
+		for _, step := range check.FailedSteps {
+			log, err := fetchStepLog(client, repo, check.PipelineUUID, step.UUID)
+			if err != nil {
+				return fmt.Errorf("could not fetch logs for step %q: %w", step.Name, err)
+			}
+			printed++
+
+			fmt.Fprintln(io.Out)
+			fmt.Fprintf(io.Out, "%s\n", cs.Bold(fmt.Sprintf("── Logs: %s / %s ──", name, step.Name)))
+			fmt.Fprintln(io.Out)
+			fmt.Fprintln(io.Out, strings.TrimRight(log, "\n"))
+		}
+	}
+
+	if printed == 0 {
+		fmt.Fprintln(io.Out)
+		fmt.Fprintf(io.Out, "%s\n", cs.Gray("No failed pipeline steps to show logs for"))
+	}
+
+	return nil
+}
+
+// fetchStepLog downloads the raw log of a pipeline step.
+func fetchStepLog(client *http.Client, repo bbrepo.Interface, pipelineUUID, stepUUID string) (string, error) {
+	url := fmt.Sprintf("%srepositories/%s/%s/pipelines/%s/steps/%s/log",
+		api.RESTPrefix(repo.RepoHost()),
+		repo.RepoWorkspace(), repo.RepoSlug(),
+		pipelineUUID, stepUUID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	log, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(log), nil
+}
@@ -142,7 +142,7 @@ func declinePR(client *http.Client, repo bbrepo.Interface, prID int) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return api.HandleHTTPError(resp)
+		return cmdutil.WrapWriteError(api.HandleHTTPError(resp), repo)
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	reactCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/comment/react"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -83,6 +84,8 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*CommentOptions) error) *cobra.
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "The comment body text")
 	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read body text from file (use \"-\" to read from standard input)")
 
+	cmd.AddCommand(reactCmd.NewCmdReact(f, nil))
+
 	return cmd
 }
 
@@ -0,0 +1,123 @@
+package react
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReactOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	SelectorArg string
+	CommentID   int
+	Remove      bool
+}
+
+func NewCmdReact(f *cmdutil.Factory, runF func(*ReactOptions) error) *cobra.Command {
+	opts := &ReactOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "react {<number> | <url>} <comment-id>",
+		Short: "Like or unlike a pull request comment",
+		Long: heredoc.Doc(`
+			Like a comment on a pull request as the authenticated user, or take
+			the like back with --remove.
+
+			Comment IDs are shown by "bb pr view --comments".
+		`),
+		Example: heredoc.Doc(`
+			# Like comment 456 on pull request #123
+			$ bb pr comment react 123 456
+
+			# Unlike it
+			$ bb pr comment react 123 456 --remove
+		`),
+		Args: cmdutil.ExactArgs(2, "cannot react: pull request and comment ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+			commentID, err := strconv.Atoi(args[1])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid comment ID: %q", args[1])
+			}
+			opts.CommentID = commentID
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return reactRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Remove, "remove", false, "Remove your like instead of adding one")
+
+	return cmd
+}
+
+func reactRun(opts *ReactOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	// Parse the PR argument first to check if it contains repo info
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	// Use the repo from URL if provided, otherwise resolve from git remotes
+	var repo bbrepo.Interface
+	if prRepo != nil {
+		repo = prRepo
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	if opts.Remove {
+		err = shared.UnlikeComment(apiClient, repo, prID, opts.CommentID)
+	} else {
+		err = shared.LikeComment(apiClient, repo, prID, opts.CommentID)
+	}
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("comment %d not found on pull request #%d", opts.CommentID, prID)
+		}
+		if opts.Remove {
+			return fmt.Errorf("failed to unlike comment: %w", err)
+		}
+		return fmt.Errorf("failed to like comment: %w", err)
+	}
+
+	if opts.Remove {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Unliked comment %d on pull request #%d\n", cs.SuccessIcon(), opts.CommentID, prID)
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Liked comment %d on pull request #%d\n", cs.SuccessIcon(), opts.CommentID, prID)
+	}
+
+	return nil
+}
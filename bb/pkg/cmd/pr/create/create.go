@@ -12,7 +12,7 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
-	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/membercache"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -33,6 +33,9 @@ type CreateOptions struct {
 	Reviewers   []string
 	CloseSource bool
 	Web         bool
+	NoBrowser   bool
+	NoVerify    bool
+	Summarize   bool
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -65,9 +68,23 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			# Create and open in browser
 			$ bb pr create --web
+
+			# Create a pull request with a generated description scaffold
+			$ bb pr create --title "Feature" --summarize
 		`),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--body` or `--summarize`",
+				opts.Body != "",
+				opts.Summarize,
+			); err != nil {
+				return err
+			}
+			if opts.Summarize && opts.Web {
+				return cmdutil.FlagErrorf("`--summarize` cannot be used with `--web`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -80,8 +97,12 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.BaseBranch, "base", "B", "", "The branch into which you want your code merged")
 	cmd.Flags().StringVarP(&opts.HeadBranch, "head", "H", "", "The branch that contains commits for your pull request")
 	cmd.Flags().StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people by their username")
+	_ = cmd.RegisterFlagCompletionFunc("reviewer", shared.ReviewerCompletionFunc(f))
 	cmd.Flags().BoolVar(&opts.CloseSource, "close-source", false, "Close source branch after merge")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the browser to create a pull request")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
+	cmd.Flags().BoolVar(&opts.NoVerify, "no-verify", false, "Skip the configured title and body lint checks")
+	cmd.Flags().BoolVar(&opts.Summarize, "summarize", false, "Prefill the body with a scaffold generated from the diff")
 
 	return cmd
 }
@@ -118,10 +139,7 @@ func createRun(opts *CreateOptions) error {
 	// Open in browser if requested
 	if opts.Web {
 		createURL := bbrepo.GenerateRepoURL(repo, "pull-requests/new?source=%s&dest=%s", headBranch, baseBranch)
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(createURL))
-		}
-		return opts.Browser.Browse(createURL)
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, createURL, opts.NoBrowser)
 	}
 
 	// Validate title
@@ -129,6 +147,20 @@ func createRun(opts *CreateOptions) error {
 		return fmt.Errorf("title is required. Use --title or --web to create interactively")
 	}
 
+	if opts.Summarize {
+		summary, err := buildSummary(context.Background(), opts.GitClient, baseBranch, headBranch)
+		if err != nil {
+			return err
+		}
+		opts.Body = summary
+	}
+
+	if !opts.NoVerify {
+		if err := shared.LintPullRequest(opts.IO, context.Background(), opts.GitClient, opts.Title, opts.Body); err != nil {
+			return err
+		}
+	}
+
 	// Create the PR
 	pr, err := createPullRequest(httpClient, repo, &createPRParams{
 		Title:       opts.Title,
@@ -146,6 +178,11 @@ func createRun(opts *CreateOptions) error {
 	fmt.Fprintf(opts.IO.Out, "%s Created pull request #%d\n", cs.SuccessIcon(), pr.ID)
 	fmt.Fprintf(opts.IO.Out, "%s\n", pr.HTMLURL())
 
+	// Size classification is best-effort; the pull request already exists
+	if stat, err := shared.FetchDiffStat(httpClient, repo, pr.ID); err == nil {
+		shared.PrintSize(opts.IO, stat, shared.SizeWarningThreshold(context.Background(), opts.GitClient))
+	}
+
 	return nil
 }
 
@@ -162,10 +199,20 @@ func createPullRequest(client *http.Client, repo bbrepo.Interface, params *creat
 	path := fmt.Sprintf("repositories/%s/%s/pullrequests", repo.RepoWorkspace(), repo.RepoSlug())
 	apiURL := api.RESTPrefix(repo.RepoHost()) + path
 
-	// Build reviewers list
+	// Build reviewers list, resolving names to UUIDs through the member cache
 	var reviewers []map[string]string
-	for _, r := range params.Reviewers {
-		reviewers = append(reviewers, map[string]string{"username": r})
+	if len(params.Reviewers) > 0 {
+		members, err := membercache.Members(api.NewClientFromHTTP(client), repo.RepoHost(), repo.RepoWorkspace())
+		if err != nil {
+			members = nil // fall back to username references
+		}
+		for _, r := range params.Reviewers {
+			if m := membercache.Match(members, r); m != nil {
+				reviewers = append(reviewers, map[string]string{"uuid": m.UUID})
+			} else {
+				reviewers = append(reviewers, map[string]string{"username": r})
+			}
+		}
 	}
 
 	body := map[string]interface{}{
@@ -207,7 +254,7 @@ func createPullRequest(client *http.Client, repo bbrepo.Interface, params *creat
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		return nil, api.HandleHTTPError(resp)
+		return nil, cmdutil.WrapWriteError(api.HandleHTTPError(resp), repo)
 	}
 
 	var pr shared.PullRequest
@@ -0,0 +1,130 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/githubtemplate"
+)
+
+// buildSummary generates a description scaffold for a pull request from the
+// local diff between baseRef and headRef: the commit list, the changed areas
+// grouped by top-level directory, and any checklist items found in the
+// repository's pull request template.
+func buildSummary(ctx context.Context, gitClient *git.Client, baseRef, headRef string) (string, error) {
+	commits, err := gitClient.Commits(ctx, baseRef, headRef)
+	if err != nil {
+		return "", fmt.Errorf("could not summarize diff: %w", err)
+	}
+
+	files, err := changedFiles(ctx, gitClient, baseRef, headRef)
+	if err != nil {
+		return "", fmt.Errorf("could not summarize diff: %w", err)
+	}
+
+	var sections []string
+
+	if areas := changedAreas(files); len(areas) > 0 {
+		var b strings.Builder
+		b.WriteString("## Changed areas\n")
+		for _, area := range areas {
+			b.WriteString(fmt.Sprintf("\n- %s", area))
+		}
+		sections = append(sections, b.String())
+	}
+
+	var b strings.Builder
+	b.WriteString("## Commits\n")
+	for _, commit := range commits {
+		b.WriteString(fmt.Sprintf("\n- %s", commit.Title))
+	}
+	sections = append(sections, b.String())
+
+	if items := templateChecklist(ctx, gitClient); len(items) > 0 {
+		var b strings.Builder
+		b.WriteString("## Checklist\n")
+		for _, item := range items {
+			b.WriteString(fmt.Sprintf("\n%s", item))
+		}
+		sections = append(sections, b.String())
+	}
+
+	return strings.Join(sections, "\n\n") + "\n", nil
+}
+
+func changedFiles(ctx context.Context, gitClient *git.Client, baseRef, headRef string) ([]string, error) {
+	cmd, err := gitClient.Command(ctx, "diff", "--name-only", fmt.Sprintf("%s...%s", baseRef, headRef))
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// changedAreas groups the changed files by top-level directory and renders one
+// line per area, e.g. "`pkg/` (3 files)".
+func changedAreas(files []string) []string {
+	counts := map[string]int{}
+	for _, file := range files {
+		area := "(root)"
+		if dir, _, ok := strings.Cut(file, "/"); ok {
+			area = fmt.Sprintf("`%s/`", dir)
+		}
+		counts[area]++
+	}
+
+	areas := make([]string, 0, len(counts))
+	for area := range counts {
+		areas = append(areas, area)
+	}
+	sort.Strings(areas)
+
+	for i, area := range areas {
+		noun := "files"
+		if counts[area] == 1 {
+			noun = "file"
+		}
+		areas[i] = fmt.Sprintf("%s (%d %s)", area, counts[area], noun)
+	}
+	return areas
+}
+
+// templateChecklist collects the checklist items of the repository's pull
+// request template, if one exists. A missing template is not an error; the
+// checklist section is simply omitted.
+func templateChecklist(ctx context.Context, gitClient *git.Client) []string {
+	root, err := gitClient.ToplevelDir(ctx)
+	if err != nil {
+		return nil
+	}
+
+	templates := githubtemplate.FindNonLegacy(root, "PULL_REQUEST_TEMPLATE")
+	if legacy := githubtemplate.FindLegacy(root, "PULL_REQUEST_TEMPLATE"); legacy != "" {
+		templates = append(templates, legacy)
+	}
+	if len(templates) == 0 {
+		return nil
+	}
+
+	var items []string
+	for _, line := range strings.Split(string(githubtemplate.ExtractContents(templates[0])), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- [ ]") || strings.HasPrefix(trimmed, "* [ ]") {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
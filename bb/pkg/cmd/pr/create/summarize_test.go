@@ -0,0 +1,26 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedAreas(t *testing.T) {
+	files := []string{
+		"pkg/cmd/pr/create/create.go",
+		"pkg/cmd/pr/create/summarize.go",
+		"api/client.go",
+		"README.md",
+	}
+
+	assert.Equal(t, []string{
+		"(root) (1 file)",
+		"`api/` (1 file)",
+		"`pkg/` (2 files)",
+	}, changedAreas(files))
+}
+
+func TestChangedAreasEmpty(t *testing.T) {
+	assert.Empty(t, changedAreas(nil))
+}
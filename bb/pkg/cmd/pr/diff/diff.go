@@ -2,20 +2,25 @@ package diff
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"regexp"
+	"net/url"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
-	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -28,11 +33,17 @@ type DiffOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (bbrepo.Interface, error)
 	Browser    browser.Browser
+	GitClient  *git.Client
 
 	SelectorArg string
 	UseColor    bool
 	NameOnly    bool
 	BrowserMode bool
+	NoBrowser   bool
+	NoTruncate  bool
+	Local       bool
+	File        string
+	Line        int
 }
 
 func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
@@ -41,6 +52,7 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 		HttpClient: f.HttpClient,
 		BaseRepo:   f.BaseRepo,
 		Browser:    f.Browser,
+		GitClient:  f.GitClient,
 	}
 
 	var colorFlag string
@@ -55,6 +67,14 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 			is displayed.
 
 			With --web flag, open the pull request diff in a web browser instead.
+			Combine it with --file (and optionally --line) to jump straight to a
+			file's diff, so a review started in the terminal can continue in the
+			browser at the same spot.
+
+			With --local, the diff is computed by git from branches available
+			locally (a three-dot merge-base diff) instead of being downloaded,
+			which is faster for large pull requests and works offline after a
+			fetch.
 		`),
 		Example: heredoc.Doc(`
 			# View diff for pull request #123
@@ -68,6 +88,16 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 
 			# Open diff in browser
 			$ bb pr diff 123 --web
+
+			# Open the browser at a specific file, or a line within it
+			$ bb pr diff 123 --web --file cmd/main.go
+			$ bb pr diff 123 --web --file cmd/main.go --line 42
+
+			# Show oversized file diffs and binary patch data in full
+			$ bb pr diff 123 --no-truncate
+
+			# Diff local branches instead of downloading the diff
+			$ bb pr diff 123 --local
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -86,6 +116,21 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 				return fmt.Errorf("unsupported color %q", colorFlag)
 			}
 
+			if opts.File != "" && !opts.BrowserMode {
+				return cmdutil.FlagErrorf("--file only makes sense with --web")
+			}
+			if opts.Local && opts.BrowserMode {
+				return cmdutil.FlagErrorf("specify only one of --local or --web")
+			}
+			if cmd.Flags().Changed("line") {
+				if opts.File == "" {
+					return cmdutil.FlagErrorf("--line requires --file")
+				}
+				if opts.Line < 1 {
+					return cmdutil.FlagErrorf("--line must be at least 1")
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -96,6 +141,11 @@ func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Comman
 	cmdutil.StringEnumFlag(cmd, &colorFlag, "color", "", "auto", []string{"always", "never", "auto"}, "Use color in diff output")
 	cmd.Flags().BoolVar(&opts.NameOnly, "name-only", false, "Display only names of changed files")
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open the pull request diff in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
+	cmd.Flags().BoolVar(&opts.NoTruncate, "no-truncate", false, "Show oversized file diffs and binary patch data in full")
+	cmd.Flags().BoolVar(&opts.Local, "local", false, "Compute the diff with git from local branches instead of downloading it")
+	cmd.Flags().StringVar(&opts.File, "file", "", "With --web, jump to the given file's diff")
+	cmd.Flags().IntVar(&opts.Line, "line", 0, "With --web and --file, jump to the given line")
 
 	return cmd
 }
@@ -124,12 +174,31 @@ func diffRun(opts *DiffOptions) error {
 	}
 
 	if opts.BrowserMode {
-		openURL := fmt.Sprintf("https://%s/%s/%s/pull-requests/%d/diff",
-			repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug(), prID)
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+		openURL := fmt.Sprintf("https://%s/%s/%s/pull-requests/%d/diff%s",
+			repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug(), prID,
+			diffFragment(opts.File, opts.Line))
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, openURL, opts.NoBrowser)
+	}
+
+	if opts.Local {
+		return localDiff(opts, httpClient, repo, prID)
+	}
+
+	// The diffstat endpoint answers --name-only without downloading the
+	// whole diff, which can run to many megabytes on large pull requests.
+	if opts.NameOnly {
+		entries, err := fetchDiffstat(httpClient, repo, prID)
+		if err != nil {
+			return fmt.Errorf("could not find pull request diffstat: %w", err)
+		}
+
+		if err := opts.IO.StartPager(); err == nil {
+			defer opts.IO.StopPager()
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
 		}
-		return opts.Browser.Browse(openURL)
+
+		return printChangedFiles(opts.IO, entries)
 	}
 
 	diffReadCloser, err := fetchDiff(httpClient, repo, prID)
@@ -146,19 +215,97 @@ func diffRun(opts *DiffOptions) error {
 	if err := opts.IO.StartPager(); err == nil {
 		defer opts.IO.StopPager()
 	} else {
-		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+		fmt.Fprint(opts.IO.ErrOut, i18n.T("failed to start pager: %v\n", err))
+	}
+
+	if opts.NoTruncate && !opts.UseColor {
+		_, err = io.Copy(opts.IO.Out, diff)
+		return err
+	}
+
+	return processDiffLines(opts.IO.Out, diff, opts.UseColor, opts.NoTruncate)
+}
+
+// localDiff renders the pull request's diff with git from refs available
+// locally; only the PR's branch names are fetched from the API. The three-dot
+// form diffs against the merge base, matching what the PR page shows.
+func localDiff(opts *DiffOptions, httpClient *http.Client, repo bbrepo.Interface, prID int) error {
+	pr, err := list.FetchPullRequest(httpClient, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	baseRef, err := resolveLocalRef(ctx, opts.GitClient, pr.BaseBranch())
+	if err != nil {
+		return err
+	}
+	headRef, err := resolveLocalRef(ctx, opts.GitClient, pr.HeadBranch())
+	if err != nil {
+		return err
 	}
 
+	args := []string{"diff"}
+	if opts.UseColor {
+		args = append(args, "--color=always")
+	} else {
+		args = append(args, "--color=never")
+	}
 	if opts.NameOnly {
-		return changedFilesNames(opts.IO.Out, diff)
+		args = append(args, "--name-only")
 	}
+	args = append(args, baseRef+"..."+headRef)
 
-	if !opts.UseColor {
-		_, err = io.Copy(opts.IO.Out, diff)
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprint(opts.IO.ErrOut, i18n.T("failed to start pager: %v\n", err))
+	}
+
+	gitCmd, err := opts.GitClient.Command(ctx, args...)
+	if err != nil {
 		return err
 	}
+	gitCmd.Stdout = opts.IO.Out
+	gitCmd.Stderr = opts.IO.ErrOut
+	return gitCmd.Run()
+}
+
+// resolveLocalRef picks the local branch of that name when it exists, falling
+// back to any remote-tracking ref; fetching is deliberately left to the user
+// so --local stays offline.
+func resolveLocalRef(ctx context.Context, gc *git.Client, branch string) (string, error) {
+	if gc.HasLocalBranch(ctx, branch) {
+		return branch, nil
+	}
+
+	cmd, err := gc.Command(ctx, "for-each-ref", "--format=%(refname:short)", "--count=1", "refs/remotes/*/"+branch)
+	if err != nil {
+		return "", err
+	}
+	if out, err := cmd.Output(); err == nil {
+		if ref := strings.TrimSpace(string(out)); ref != "" {
+			return ref, nil
+		}
+	}
 
-	return colorDiffLines(opts.IO.Out, diff)
+	return "", fmt.Errorf("branch %q not found locally; fetch it with `git fetch origin %s` and try again", branch, branch)
+}
+
+// diffFragment builds the Bitbucket UI fragment that anchors the diff view to
+// a file, or to a line within it: #chg-<path> for the file, #L<path>T<line>
+// for a line on the changed side.
+func diffFragment(file string, line int) string {
+	if file == "" {
+		return ""
+	}
+	// Fragment escaping keeps slashes in paths intact
+	if line > 0 {
+		u := url.URL{Fragment: fmt.Sprintf("L%sT%d", file, line)}
+		return "#" + u.EscapedFragment()
+	}
+	u := url.URL{Fragment: "chg-" + file}
+	return "#" + u.EscapedFragment()
 }
 
 func fetchDiff(httpClient *http.Client, repo bbrepo.Interface, prID int) (io.ReadCloser, error) {
@@ -195,6 +342,11 @@ func fetchDiff(httpClient *http.Client, repo bbrepo.Interface, prID int) (io.Rea
 
 const lineBufferSize = 4096
 
+// maxSectionLines bounds how many lines of a single file's diff are printed
+// before the rest is summarized. Generated files and vendored trees routinely
+// exceed it; --no-truncate lifts the bound.
+const maxSectionLines = 2000
+
 var (
 	colorHeader   = []byte("\x1b[1;38m")
 	colorAddition = []byte("\x1b[32m")
@@ -202,10 +354,26 @@ var (
 	colorReset    = []byte("\x1b[m")
 )
 
-func colorDiffLines(w io.Writer, r io.Reader) error {
+// processDiffLines streams the diff to w one line at a time, optionally
+// colorized. Unless noTruncate is set, git binary patch data is replaced with
+// a one-line summary and per-file sections are cut off after maxSectionLines.
+func processDiffLines(w io.Writer, r io.Reader, useColor, noTruncate bool) error {
 	diffLines := bufio.NewReaderSize(r, lineBufferSize)
 	wasPrefix := false
 	needsReset := false
+	sectionLines := 0
+	binary := false
+	truncated := false
+
+	// endSection reports a section that was cut off once its true size is
+	// known
+	endSection := func() error {
+		if !truncated {
+			return nil
+		}
+		_, err := fmt.Fprintf(w, "Diff too large: %d lines, use --no-truncate to see it in full\n", sectionLines)
+		return err
+	}
 
 	for {
 		diffLine, isPrefix, err := diffLines.ReadLine()
@@ -216,8 +384,39 @@ func colorDiffLines(w io.Writer, r io.Reader) error {
 			return fmt.Errorf("error reading pull request diff: %w", err)
 		}
 
+		newLine := !wasPrefix
+		wasPrefix = isPrefix
+
+		if newLine && strings.HasPrefix(string(diffLine), "diff ") {
+			if err := endSection(); err != nil {
+				return err
+			}
+			sectionLines = 0
+			binary = false
+			truncated = false
+		} else if newLine {
+			sectionLines++
+		}
+
+		if !noTruncate {
+			if binary {
+				continue
+			}
+			if newLine && string(diffLine) == "GIT binary patch" && !isPrefix {
+				binary = true
+				if _, err := fmt.Fprintln(w, i18n.T("Binary file changed")); err != nil {
+					return err
+				}
+				continue
+			}
+			if sectionLines > maxSectionLines {
+				truncated = true
+				continue
+			}
+		}
+
 		var color []byte
-		if !wasPrefix {
+		if useColor && newLine {
 			if isHeaderLine(diffLine) {
 				color = colorHeader
 			} else if isAdditionLine(diffLine) {
@@ -249,9 +448,9 @@ func colorDiffLines(w io.Writer, r io.Reader) error {
 				return err
 			}
 		}
-		wasPrefix = isPrefix
 	}
-	return nil
+
+	return endSection()
 }
 
 var diffHeaderPrefixes = []string{"+++", "---", "diff", "index"}
@@ -274,23 +473,95 @@ func isRemovalLine(l []byte) bool {
 	return len(l) > 0 && l[0] == '-'
 }
 
-func changedFilesNames(w io.Writer, r io.Reader) error {
-	diff, err := io.ReadAll(r)
-	if err != nil {
-		return err
-	}
+// diffstatEntry is one changed file of the diffstat endpoint.
+type diffstatEntry struct {
+	Status string `json:"status"`
+	Old    *struct {
+		Path string `json:"path"`
+	} `json:"old,omitempty"`
+	New *struct {
+		Path string `json:"path"`
+	} `json:"new,omitempty"`
+}
 
-	pattern := regexp.MustCompile(`(?:^|\n)diff\s--git.*\s(["]?)b/(.*)`)
-	matches := pattern.FindAllStringSubmatch(string(diff), -1)
+type diffstatList struct {
+	Values []diffstatEntry `json:"values"`
+	Next   string          `json:"next"`
+}
 
-	for _, val := range matches {
-		name := strings.TrimSpace(val[1] + val[2])
-		if _, err := w.Write([]byte(name + "\n")); err != nil {
-			return err
+func fetchDiffstat(httpClient *http.Client, repo bbrepo.Interface, prID int) ([]diffstatEntry, error) {
+	url := fmt.Sprintf("%srepositories/%s/%s/pullrequests/%d/diffstat?pagelen=500",
+		api.RESTPrefix(repo.RepoHost()),
+		repo.RepoWorkspace(),
+		repo.RepoSlug(),
+		prID,
+	)
+
+	var entries []diffstatEntry
+	for url != "" {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return nil, fmt.Errorf("pull request #%d not found", prID)
+		}
+		if resp.StatusCode != http.StatusOK {
+			defer resp.Body.Close()
+			return nil, api.HandleHTTPError(resp)
+		}
+
+		var page diffstatList
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
 		}
+
+		entries = append(entries, page.Values...)
+		url = page.Next
 	}
 
-	return nil
+	return entries, nil
+}
+
+func printChangedFiles(io *iostreams.IOStreams, entries []diffstatEntry) error {
+	tp := tableprinter.New(io, tableprinter.WithHeader("STATUS", "FILE"))
+
+	for _, entry := range entries {
+		tp.AddField(entry.Status)
+		tp.AddField(entry.Path())
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// Path renders the file the entry refers to, spelling out renames.
+func (e diffstatEntry) Path() string {
+	oldPath, newPath := "", ""
+	if e.Old != nil {
+		oldPath = e.Old.Path
+	}
+	if e.New != nil {
+		newPath = e.New.Path
+	}
+
+	if oldPath != "" && newPath != "" && oldPath != newPath {
+		return oldPath + " -> " + newPath
+	}
+	if newPath != "" {
+		return newPath
+	}
+	return oldPath
 }
 
 func sanitizedReader(r io.Reader) io.Reader {
@@ -0,0 +1,73 @@
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_processDiffLines_binary(t *testing.T) {
+	input := strings.Join([]string{
+		"diff --git a/logo.png b/logo.png",
+		"index 1111111..2222222 100644",
+		"GIT binary patch",
+		"literal 1024",
+		"zcmV;~0+ab",
+		"",
+		"diff --git a/main.go b/main.go",
+		"+added",
+	}, "\n")
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, processDiffLines(out, strings.NewReader(input), false, false))
+
+	want := strings.Join([]string{
+		"diff --git a/logo.png b/logo.png",
+		"index 1111111..2222222 100644",
+		"Binary file changed",
+		"diff --git a/main.go b/main.go",
+		"+added",
+	}, "\n") + "\n"
+	assert.Equal(t, want, out.String())
+}
+
+func Test_processDiffLines_truncation(t *testing.T) {
+	var sb strings.Builder
+	sb.WriteString("diff --git a/big.txt b/big.txt\n")
+	for i := 0; i < maxSectionLines+5; i++ {
+		fmt.Fprintf(&sb, "+line %d\n", i)
+	}
+	sb.WriteString("diff --git a/small.txt b/small.txt\n")
+	sb.WriteString("+kept\n")
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, processDiffLines(out, strings.NewReader(sb.String()), false, false))
+
+	lines := strings.Split(strings.TrimRight(out.String(), "\n"), "\n")
+	assert.Equal(t, maxSectionLines+4, len(lines))
+	assert.Equal(t, fmt.Sprintf("Diff too large: %d lines, use --no-truncate to see it in full", maxSectionLines+5), lines[maxSectionLines+1])
+	assert.Equal(t, "diff --git a/small.txt b/small.txt", lines[maxSectionLines+2])
+	assert.Equal(t, "+kept", lines[maxSectionLines+3])
+}
+
+func Test_processDiffLines_noTruncate(t *testing.T) {
+	input := strings.Join([]string{
+		"diff --git a/logo.png b/logo.png",
+		"GIT binary patch",
+		"literal 1024",
+	}, "\n") + "\n"
+
+	out := &bytes.Buffer{}
+	assert.NoError(t, processDiffLines(out, strings.NewReader(input), false, true))
+	assert.Equal(t, input, out.String())
+}
+
+func Test_diffFragment(t *testing.T) {
+	assert.Equal(t, "", diffFragment("", 0))
+	assert.Equal(t, "#chg-cmd/main.go", diffFragment("cmd/main.go", 0))
+	assert.Equal(t, "#Lcmd/main.goT42", diffFragment("cmd/main.go", 42))
+	assert.Equal(t, "#chg-docs/release%20notes.md", diffFragment("docs/release notes.md", 0))
+}
@@ -1,12 +1,18 @@
 package edit
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/membercache"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -17,13 +23,17 @@ import (
 type EditOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
 
 	SelectorArg string
 
 	Title       string
 	Description string
 	BodyFile    string
+	NoVerify    bool
+	UseEditor   bool
 
 	AddReviewer    []string
 	RemoveReviewer []string
@@ -36,7 +46,9 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	opts := &EditOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
 	}
 
 	var closeSourceBranch bool
@@ -50,6 +62,10 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 
 			Without an argument, the pull request that belongs to the current branch
 			is selected.
+
+			With --editor, the pull request opens in your editor as a markdown
+			document with the structured fields as front matter; the parsed
+			changes are applied on save.
 		`),
 		Example: heredoc.Doc(`
 			$ bb pr edit 23 --title "Updated title"
@@ -58,6 +74,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 			$ bb pr edit 23 --remove-reviewer user3
 			$ bb pr edit 23 --destination main
 			$ bb pr edit 23 --close-source-branch
+			$ bb pr edit 23 --editor
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -92,8 +109,11 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 				opts.DestinationBranch != "" ||
 				opts.CloseSourceBranch != nil
 
-			if !hasEdits {
-				return cmdutil.FlagErrorf("at least one edit flag is required: --title, --body, --body-file, --add-reviewer, --remove-reviewer, --destination, --close-source-branch, or --keep-source-branch")
+			if opts.UseEditor && hasEdits {
+				return cmdutil.FlagErrorf("--editor cannot be combined with other edit flags")
+			}
+			if !hasEdits && !opts.UseEditor {
+				return cmdutil.FlagErrorf("at least one edit flag is required: --title, --body, --body-file, --add-reviewer, --remove-reviewer, --destination, --close-source-branch, --keep-source-branch, or --editor")
 			}
 
 			if runF != nil {
@@ -107,10 +127,13 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Description, "body", "b", "", "Set the new description")
 	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read description from `file` (use \"-\" for stdin)")
 	cmd.Flags().StringSliceVar(&opts.AddReviewer, "add-reviewer", nil, "Add reviewers by username")
+	_ = cmd.RegisterFlagCompletionFunc("add-reviewer", shared.ReviewerCompletionFunc(f))
 	cmd.Flags().StringSliceVar(&opts.RemoveReviewer, "remove-reviewer", nil, "Remove reviewers by username")
 	cmd.Flags().StringVarP(&opts.DestinationBranch, "destination", "d", "", "Change the destination branch")
 	cmd.Flags().BoolVar(&closeSourceBranch, "close-source-branch", false, "Delete source branch after merge")
 	cmd.Flags().BoolVar(&keepSourceBranch, "keep-source-branch", false, "Keep source branch after merge")
+	cmd.Flags().BoolVar(&opts.NoVerify, "no-verify", false, "Skip the configured title and body lint checks")
+	cmd.Flags().BoolVarP(&opts.UseEditor, "editor", "e", false, "Edit the pull request as a markdown document in your editor")
 
 	return cmd
 }
@@ -144,10 +167,49 @@ func editRun(opts *EditOptions) error {
 		return err
 	}
 
+	if opts.UseEditor {
+		changed, err := editInEditor(opts, pr)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			fmt.Fprintln(opts.IO.ErrOut, "No changes made, nothing to do")
+			return nil
+		}
+	}
+
+	// Lint the title and body as they will read after the edit
+	if !opts.NoVerify && (opts.Title != "" || opts.Description != "") {
+		title := opts.Title
+		if title == "" {
+			title = pr.Title
+		}
+		body := opts.Description
+		if body == "" {
+			body = pr.Description
+		}
+		if err := shared.LintPullRequest(opts.IO, context.Background(), opts.GitClient, title, body); err != nil {
+			return err
+		}
+	}
+
+	// Resolve added reviewers to UUIDs through the member cache
+	var members []membercache.Member
+	if len(opts.AddReviewer) > 0 {
+		members, err = membercache.Members(api.NewClientFromHTTP(httpClient), repo.RepoHost(), repo.RepoWorkspace())
+		if err != nil {
+			return err
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
 
 	// Build the update payload
-	update := buildUpdatePayload(opts, pr)
+	update, err := buildUpdatePayload(opts, pr, members)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
 
 	// Update the PR
 	err = updatePullRequest(httpClient, repo, prID, update)
@@ -161,12 +223,106 @@ func editRun(opts *EditOptions) error {
 	if opts.IO.IsStdoutTTY() {
 		cs := opts.IO.ColorScheme()
 		fmt.Fprintf(opts.IO.Out, "%s Edited pull request #%d\n", cs.SuccessIcon(), prID)
+		// Re-fetch the pull request so the summary reflects what the server
+		// actually stored, not just what was requested
+		if updated, err := list.FetchPullRequest(httpClient, repo, prID); err == nil {
+			cmdutil.PrintFieldChanges(opts.IO, prFieldChanges(pr, updated))
+		}
 		fmt.Fprintln(opts.IO.Out, pr.HTMLURL())
 	}
 
 	return nil
 }
 
+// prFieldChanges compares the pull request before and after the edit for the
+// fields this command can modify.
+func prFieldChanges(before, after *shared.PullRequest) []cmdutil.FieldChange {
+	reviewers := func(pr *shared.PullRequest) string {
+		var names []string
+		for _, r := range pr.Reviewers {
+			names = append(names, r.Nickname)
+		}
+		sort.Strings(names)
+		return strings.Join(names, ", ")
+	}
+	closeSource := func(pr *shared.PullRequest) string {
+		if pr.CloseSource {
+			return "yes"
+		}
+		return "no"
+	}
+	return []cmdutil.FieldChange{
+		{Name: "title", Before: before.Title, After: after.Title},
+		{Name: "body", Before: before.Description, After: after.Description},
+		{Name: "reviewers", Before: reviewers(before), After: reviewers(after)},
+		{Name: "destination", Before: before.BaseBranch(), After: after.BaseBranch()},
+		{Name: "close source branch", Before: closeSource(before), After: closeSource(after)},
+	}
+}
+
+// editInEditor round-trips the pull request through the user's editor as a
+// markdown document and stores any changed values on opts, so the regular
+// update payload picks them up. It reports whether anything was changed.
+func editInEditor(opts *EditOptions, pr *shared.PullRequest) (bool, error) {
+	var reviewers []string
+	for _, r := range pr.Reviewers {
+		reviewers = append(reviewers, r.Nickname)
+	}
+
+	fields := []cmdutil.MarkdownDocField{
+		{Name: "reviewers", Value: strings.Join(reviewers, ", ")},
+		{Name: "destination", Value: pr.BaseBranch()},
+	}
+
+	edited, err := cmdutil.EditMarkdownDoc(opts.IO, opts.Config, cmdutil.RenderMarkdownDoc(fields, pr.Title, pr.Description))
+	if err != nil {
+		return false, err
+	}
+
+	parsed, title, body, err := cmdutil.ParseMarkdownDoc(edited)
+	if err != nil {
+		return false, err
+	}
+
+	changed := false
+	if title != pr.Title {
+		opts.Title = title
+		changed = true
+	}
+	if body != strings.TrimRight(pr.Description, "\n") {
+		opts.Description = body
+		changed = true
+	}
+	if v := parsed["destination"]; v != pr.BaseBranch() {
+		opts.DestinationBranch = v
+		changed = true
+	}
+
+	// Diff the edited reviewer list against the current one
+	newSet := map[string]bool{}
+	for _, r := range strings.Split(parsed["reviewers"], ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			newSet[r] = true
+		}
+	}
+	oldSet := map[string]bool{}
+	for _, r := range reviewers {
+		oldSet[r] = true
+		if !newSet[r] {
+			opts.RemoveReviewer = append(opts.RemoveReviewer, r)
+			changed = true
+		}
+	}
+	for _, r := range strings.Split(parsed["reviewers"], ",") {
+		if r = strings.TrimSpace(r); r != "" && !oldSet[r] {
+			opts.AddReviewer = append(opts.AddReviewer, r)
+			changed = true
+		}
+	}
+
+	return changed, nil
+}
+
 // PRUpdatePayload represents the fields that can be updated on a PR
 type PRUpdatePayload struct {
 	Title             string     `json:"title,omitempty"`
@@ -188,7 +344,7 @@ type BranchName struct {
 	Name string `json:"name"`
 }
 
-func buildUpdatePayload(opts *EditOptions, pr *shared.PullRequest) PRUpdatePayload {
+func buildUpdatePayload(opts *EditOptions, pr *shared.PullRequest, members []membercache.Member) (PRUpdatePayload, error) {
 	update := PRUpdatePayload{}
 
 	if opts.Title != "" {
@@ -231,14 +387,22 @@ func buildUpdatePayload(opts *EditOptions, pr *shared.PullRequest) PRUpdatePaylo
 			}
 		}
 
-		// Note: Adding reviewers by username requires looking up their UUID
-		// For now, we'll only support removing reviewers and keep existing ones
-		// Adding new reviewers would require a user lookup API call
+		// Add new reviewers, resolved to UUIDs through the member cache
+		for _, r := range opts.AddReviewer {
+			m := membercache.Match(members, r)
+			if m == nil {
+				return update, fmt.Errorf("no workspace member matches %q", r)
+			}
+			if !reviewerMap[m.UUID] {
+				newReviewers = append(newReviewers, UserRef{UUID: m.UUID})
+				reviewerMap[m.UUID] = true
+			}
+		}
 
 		update.Reviewers = newReviewers
 	}
 
-	return update
+	return update, nil
 }
 
 func updatePullRequest(client *http.Client, repo bbrepo.Interface, prID int, payload PRUpdatePayload) error {
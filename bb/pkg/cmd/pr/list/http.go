@@ -5,18 +5,83 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 )
 
-func fetchPullRequests(client *http.Client, repo bbrepo.Interface, opts *ListOptions) ([]shared.PullRequest, error) {
+// prBatch is one display batch of pull requests plus the cursor and total
+// needed to keep paginating interactively. rest holds rows fetched beyond the
+// display limit; feeding them back as the next batch's carried rows keeps the
+// page-boundary cursor from skipping them.
+type prBatch struct {
+	prs   []shared.PullRequest
+	rest  []shared.PullRequest
+	next  string
+	total int
+}
+
+// fetchPullRequests collects up to opts.Limit pull requests starting from
+// carried (rows held back from the previous batch) and then startURL, or the
+// first page when startURL is empty. Rows beyond the limit land in rest
+// rather than being discarded, since pages don't align with the limit.
+func fetchPullRequests(client *http.Client, repo bbrepo.Interface, opts *ListOptions, startURL string, carried []shared.PullRequest) (prBatch, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
+	batch := prBatch{prs: carried, next: startURL}
+	nextURL := startURL
+	path := ""
+	if startURL == "" && len(carried) == 0 {
+		var err error
+		path, err = pullRequestListPath(apiClient, repo, opts)
+		if err != nil {
+			return batch, err
+		}
+	}
+
+	// No URL means the pages are exhausted and only carried rows remain
+	for (nextURL != "" || path != "") && len(batch.prs) < opts.Limit {
+		var page shared.PullRequestList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return batch, err
+		}
+
+		if page.Size > 0 {
+			batch.total = page.Size
+		}
+		batch.prs = append(batch.prs, page.Values...)
+		batch.next = page.Next
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	if len(batch.prs) > opts.Limit {
+		batch.rest = batch.prs[opts.Limit:]
+		batch.prs = batch.prs[:opts.Limit]
+	}
+	return batch, nil
+}
+
+// pullRequestListPath translates the list filters into the first page's
+// request path.
+func pullRequestListPath(apiClient *api.Client, repo bbrepo.Interface, opts *ListOptions) (string, error) {
 	// Build query parameters
 	params := url.Values{}
-	params.Set("pagelen", fmt.Sprintf("%d", opts.Limit))
+	params.Set("pagelen", fmt.Sprintf("%d", min(opts.Limit, 100)))
 
 	// Build query string for filtering
 	var queryParts []string
@@ -29,7 +94,27 @@ func fetchPullRequests(client *http.Client, repo bbrepo.Interface, opts *ListOpt
 
 	// Filter by author
 	if opts.Author != "" {
-		queryParts = append(queryParts, fmt.Sprintf(`author.nickname="%s"`, opts.Author))
+		author, err := api.ResolveUserNickname(apiClient, repo.RepoHost(), opts.Author)
+		if err != nil {
+			return "", err
+		}
+		queryParts = append(queryParts, fmt.Sprintf(`author.nickname="%s"`, author))
+	}
+
+	// Date filters
+	if opts.Created != "" {
+		q, err := cmdutil.BuildDateQuery("created_on", opts.Created)
+		if err != nil {
+			return "", err
+		}
+		queryParts = append(queryParts, q)
+	}
+	if opts.Updated != "" {
+		q, err := cmdutil.BuildDateQuery("updated_on", opts.Updated)
+		if err != nil {
+			return "", err
+		}
+		queryParts = append(queryParts, q)
 	}
 
 	if len(queryParts) > 0 {
@@ -43,16 +128,8 @@ func fetchPullRequests(client *http.Client, repo bbrepo.Interface, opts *ListOpt
 		params.Set("q", q)
 	}
 
-	path := fmt.Sprintf("repositories/%s/%s/pullrequests?%s",
-		repo.RepoWorkspace(), repo.RepoSlug(), params.Encode())
-
-	var result shared.PullRequestList
-	err := apiClient.Get(repo.RepoHost(), path, &result)
-	if err != nil {
-		return nil, err
-	}
-
-	return result.Values, nil
+	return fmt.Sprintf("repositories/%s/%s/pullrequests?%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), params.Encode()), nil
 }
 
 // FetchPullRequest fetches a single pull request by ID
@@ -127,3 +204,110 @@ func FetchPullRequestComments(client *http.Client, repo bbrepo.Interface, prID i
 
 	return allComments, nil
 }
+
+// prExtras holds the per-pull-request cells that require extra requests.
+type prExtras struct {
+	checks  string
+	reviews string
+}
+
+// fetchPRExtras computes the checks and reviews columns for each pull
+// request, fanning the per-PR requests out across workers goroutines. Pull
+// requests whose extra requests fail get empty cells.
+func fetchPRExtras(client *http.Client, repo bbrepo.Interface, cs *iostreams.ColorScheme, prs []shared.PullRequest, workers int) []prExtras {
+	apiClient := api.NewClientFromHTTP(client)
+
+	extras := make([]prExtras, len(prs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				pr := prs[i]
+
+				statusPath := fmt.Sprintf("repositories/%s/%s/commit/%s/statuses?pagelen=100",
+					repo.RepoWorkspace(), repo.RepoSlug(), pr.Source.Commit.Hash)
+				var statuses struct {
+					Values []struct {
+						State string `json:"state"`
+					} `json:"values"`
+				}
+				if err := apiClient.Get(repo.RepoHost(), statusPath, &statuses); err == nil {
+					states := make([]string, len(statuses.Values))
+					for j, s := range statuses.Values {
+						states[j] = s.State
+					}
+					extras[i].checks = summarizeChecks(cs, states)
+				}
+
+				// The list endpoint omits participants, so review state needs
+				// the full pull request
+				if full, err := FetchPullRequest(client, repo, pr.ID); err == nil {
+					extras[i].reviews = summarizeReviews(full.Participants)
+				}
+			}
+		}()
+	}
+
+	for i := range prs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return extras
+}
+
+// summarizeChecks reduces commit statuses to one aggregated cell: failed wins
+// over pending, and pending over passed.
+func summarizeChecks(cs *iostreams.ColorScheme, states []string) string {
+	if len(states) == 0 {
+		return ""
+	}
+
+	var passed, failed, pending int
+	for _, s := range states {
+		switch s {
+		case "SUCCESSFUL":
+			passed++
+		case "FAILED", "STOPPED":
+			failed++
+		default:
+			pending++
+		}
+	}
+
+	switch {
+	case failed > 0:
+		return fmt.Sprintf("%s %d/%d", cs.WordedIcon("✗", "failed"), passed, len(states))
+	case pending > 0:
+		return fmt.Sprintf("%s %d/%d", cs.WordedIcon("●", "pending"), passed, len(states))
+	default:
+		return fmt.Sprintf("%s %d/%d", cs.WordedIcon("✓", "passed"), passed, len(states))
+	}
+}
+
+// summarizeReviews reduces participant review states to one cell.
+func summarizeReviews(participants []shared.Participant) string {
+	var approved, requested int
+	for _, p := range participants {
+		switch p.State {
+		case "approved":
+			approved++
+		case "changes_requested":
+			requested++
+		}
+	}
+
+	var parts []string
+	if approved > 0 {
+		parts = append(parts, fmt.Sprintf("%d approved", approved))
+	}
+	if requested > 0 {
+		parts = append(parts, fmt.Sprintf("%d requested changes", requested))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -3,11 +3,14 @@ package list
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -18,17 +21,35 @@ import (
 type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
+	Exporter   cmdutil.Exporter
 
-	State  string
-	Author string
-	Limit  int
+	State      string
+	Author     string
+	Created    string
+	Updated    string
+	Columns    []string
+	Limit      int
+	Filter     string
+	SaveAs     string
+	WithChecks bool
+
+	Concurrency int
 }
 
+// listColumns registers every column this command can render. The names also
+// serve as the field list for --json.
+var listColumns = cmdutil.NewColumnSet(
+	[]string{"id", "title", "branch", "author", "state"},
+	"created", "updated", "checks", "reviews",
+)
+
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := &ListOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
 	}
 
@@ -52,10 +73,48 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 
 			# List pull requests by a specific author
 			$ bb pr list --author username
+
+			# List your own pull requests
+			$ bb pr list --author @me
+
+			# List pull requests created after a date
+			$ bb pr list --created ">2024-01-01"
+
+			# List pull requests updated within the last week
+			$ bb pr list --updated "<7d"
+
+			# Show only the ID, title, and last update columns
+			$ bb pr list --columns id,title,updated
+
+			# Include aggregated check and review status columns
+			$ bb pr list --with-checks
+
+			# Save a recurring query, then replay it by name
+			$ bb pr list --state merged --author @me --save-as my-merged
+			$ bb pr list --filter my-merged
 		`),
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Filter != "" || opts.SaveAs != "" {
+				cfg, err := opts.Config()
+				if err != nil {
+					return err
+				}
+				if opts.Filter != "" {
+					if err := cmdutil.ApplyFilter(cfg, cmd, opts.Filter); err != nil {
+						return err
+					}
+				}
+				if opts.SaveAs != "" {
+					if err := cmdutil.SaveFilter(cfg, cmd, opts.SaveAs); err != nil {
+						return err
+					}
+				}
+			}
+
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -64,8 +123,15 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().StringVarP(&opts.State, "state", "s", "open", "Filter by state: {open|merged|declined|all}")
-	cmd.Flags().StringVarP(&opts.Author, "author", "a", "", "Filter by author username")
+	cmd.Flags().StringVarP(&opts.Author, "author", "a", "", "Filter by author username (\"@me\" for yourself)")
+	cmd.Flags().StringVar(&opts.Created, "created", "", "Filter by creation date (e.g. \">2024-01-01\", \"<7d\")")
+	cmd.Flags().StringVar(&opts.Updated, "updated", "", "Filter by last update date (e.g. \">2024-01-01\", \"<7d\")")
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of pull requests to list")
+	cmd.Flags().BoolVar(&opts.WithChecks, "with-checks", false, "Fetch check and review status for each pull request")
+	cmdutil.AddColumnsFlag(cmd, &opts.Columns, listColumns)
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, listColumns.Fields())
+	cmdutil.AddExportFormatFlags(cmd, &opts.Exporter, listColumns.Fields())
+	cmdutil.AddFilterFlags(cmd, &opts.Filter, &opts.SaveAs)
 
 	return cmd
 }
@@ -81,48 +147,139 @@ func listRun(opts *ListOptions) error {
 		return err
 	}
 
-	prs, err := fetchPullRequests(httpClient, repo, opts)
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	columns, err := cmdutil.ResolveColumns(cfg, "pr_list_columns", opts.Columns, listColumns)
 	if err != nil {
 		return err
 	}
 
-	if len(prs) == 0 {
+	batch, err := fetchPullRequests(httpClient, repo, opts, "", nil)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, batch.prs)
+	}
+
+	if len(batch.prs) == 0 {
 		return cmdutil.NewNoResultsError(fmt.Sprintf("no pull requests match your search in %s", bbrepo.FullName(repo)))
 	}
 
-	return printPullRequests(opts.IO, prs)
+	if opts.WithChecks {
+		for _, col := range []string{"checks", "reviews"} {
+			if !slices.Contains(columns, col) {
+				columns = append(columns, col)
+			}
+		}
+	}
+
+	// Render batch by batch; when more results remain and the terminal is
+	// interactive, offer to keep going instead of silently truncating.
+	shown := 0
+	for {
+		var extras []prExtras
+		if opts.WithChecks {
+			opts.IO.StartProgressIndicator()
+			extras = fetchPRExtras(httpClient, repo, opts.IO.ColorScheme(), batch.prs, cmdutil.Concurrency(opts.Concurrency, cfg))
+			opts.IO.StopProgressIndicator()
+		}
+
+		if err := printPullRequests(opts.IO, batch.prs, extras, columns); err != nil {
+			return err
+		}
+		shown += len(batch.prs)
+
+		if (batch.next == "" && len(batch.rest) == 0) || !cmdutil.LoadMore(opts.IO, shown, batch.total) {
+			return nil
+		}
+
+		opts.IO.StartProgressIndicator()
+		batch, err = fetchPullRequests(httpClient, repo, opts, batch.next, batch.rest)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		if len(batch.prs) == 0 {
+			return nil
+		}
+	}
 }
 
-func printPullRequests(io *iostreams.IOStreams, prs []shared.PullRequest) error {
+func printPullRequests(io *iostreams.IOStreams, prs []shared.PullRequest, extras []prExtras, columns []string) error {
 	cs := io.ColorScheme()
-	tp := tableprinter.New(io, tableprinter.WithHeader("ID", "TITLE", "BRANCH", "AUTHOR", "STATE"))
-
-	for _, pr := range prs {
-		prNum := strconv.Itoa(pr.ID)
+	tp := tableprinter.New(io, tableprinter.WithHeader(columns...))
 
+	for i, pr := range prs {
 		var stateColor func(string) string
 		switch pr.State {
 		case "OPEN":
-			stateColor = cs.Green
+			stateColor = cs.RoleColor("state_open", cs.Green)
 		case "MERGED":
-			stateColor = cs.Magenta
+			stateColor = cs.RoleColor("state_merged", cs.Magenta)
 		case "DECLINED":
-			stateColor = cs.Red
+			stateColor = cs.RoleColor("state_declined", cs.Red)
 		default:
 			stateColor = cs.Gray
 		}
 
-		tp.AddField(prNum)
-		tp.AddField(pr.Title, tableprinter.WithTruncate(nil))
-		tp.AddField(pr.HeadBranch())
-		tp.AddField(pr.Author.DisplayName)
-		tp.AddField(stateColor(pr.StateDisplay()))
+		for _, col := range columns {
+			switch col {
+			case "id":
+				tp.AddField(strconv.Itoa(pr.ID))
+			case "title":
+				tp.AddField(pr.Title, tableprinter.WithTruncate(nil))
+			case "branch":
+				tp.AddField(pr.HeadBranch())
+			case "author":
+				tp.AddField(pr.Author.DisplayName)
+			case "state":
+				tp.AddField(stateColor(pr.StateDisplay()))
+			case "created":
+				tp.AddField(dateStr(pr.CreatedOn))
+			case "updated":
+				tp.AddField(dateStr(pr.UpdatedOn))
+			case "checks":
+				cell := ""
+				if i < len(extras) {
+					cell = extras[i].checks
+				}
+				switch {
+				case strings.HasPrefix(cell, cs.WordedIcon("✓", "passed")):
+					tp.AddField(cell, tableprinter.WithColor(cs.Green))
+				case strings.HasPrefix(cell, cs.WordedIcon("✗", "failed")):
+					tp.AddField(cell, tableprinter.WithColor(cs.Red))
+				case strings.HasPrefix(cell, cs.WordedIcon("●", "pending")):
+					tp.AddField(cell, tableprinter.WithColor(cs.Yellow))
+				default:
+					tp.AddField(cell)
+				}
+			case "reviews":
+				cell := ""
+				if i < len(extras) {
+					cell = extras[i].reviews
+				}
+				tp.AddField(cell)
+			}
+		}
 		tp.EndRow()
 	}
 
 	return tp.Render()
 }
 
+// dateStr shortens an RFC 3339 timestamp to its date for table display.
+func dateStr(ts string) string {
+	if t, err := time.Parse(time.RFC3339, ts); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return ts
+}
+
 func relativeTimeStr(t time.Time) string {
 	duration := time.Since(t)
 	if duration < time.Minute {
@@ -8,7 +8,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 )
 
 func TestNewCmdList(t *testing.T) {
@@ -116,3 +118,59 @@ func TestNewCmdList(t *testing.T) {
 		})
 	}
 }
+
+func Test_summarizeChecks(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []string
+		want   string
+	}{
+		{name: "no statuses", states: nil, want: ""},
+		{name: "all passed", states: []string{"SUCCESSFUL", "SUCCESSFUL"}, want: "\u2713 2/2"},
+		{name: "failure wins", states: []string{"SUCCESSFUL", "FAILED", "INPROGRESS"}, want: "\u2717 1/3"},
+		{name: "stopped counts as failed", states: []string{"STOPPED"}, want: "\u2717 0/1"},
+		{name: "pending", states: []string{"SUCCESSFUL", "INPROGRESS"}, want: "\u25cf 1/2"},
+	}
+
+	ios, _, _, _ := iostreams.Test()
+	cs := ios.ColorScheme()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summarizeChecks(cs, tt.states))
+		})
+	}
+}
+
+func Test_summarizeReviews(t *testing.T) {
+	tests := []struct {
+		name         string
+		participants []shared.Participant
+		want         string
+	}{
+		{name: "no participants", participants: nil, want: ""},
+		{
+			name: "approvals only",
+			participants: []shared.Participant{
+				{State: "approved"},
+				{State: "approved"},
+				{State: ""},
+			},
+			want: "2 approved",
+		},
+		{
+			name: "approvals and requested changes",
+			participants: []shared.Participant{
+				{State: "approved"},
+				{State: "changes_requested"},
+			},
+			want: "1 approved, 1 requested changes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summarizeReviews(tt.participants))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package merge
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/google/shlex"
+)
+
+// postMergeHooks returns the bb commands configured to run after a successful
+// merge, one per semicolon-separated entry in the post_merge setting.
+func postMergeHooks(opts *MergeOptions, hostname string) []string {
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil
+	}
+	value := cfg.GetOrDefault(hostname, "post_merge").UnwrapOrZero().Value
+	var hooks []string
+	for _, hook := range strings.Split(value, ";") {
+		if hook = strings.TrimSpace(hook); hook != "" {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
+
+// expandHookPlaceholders substitutes the merge context into a hook command:
+// {base} and {head} are the destination and source branches, {number} is the
+// pull request ID, {repo} is workspace/slug, and {linked} is the issue key
+// carried by the source branch, if any.
+func expandHookPlaceholders(hook string, repo bbrepo.Interface, pr *shared.PullRequest) string {
+	replacer := strings.NewReplacer(
+		"{base}", pr.BaseBranch(),
+		"{head}", pr.HeadBranch(),
+		"{number}", fmt.Sprintf("%d", pr.ID),
+		"{repo}", fmt.Sprintf("%s/%s", repo.RepoWorkspace(), repo.RepoSlug()),
+		"{linked}", issueKeyRE.FindString(pr.HeadBranch()),
+	)
+	return replacer.Replace(hook)
+}
+
+// runPostMergeHooks invokes each configured hook as a bb command with --repo
+// appended, streaming its output through. Hooks whose {linked} placeholder
+// has nothing to expand to are skipped. The merge has already happened by the
+// time a hook fails, so the error says so.
+func runPostMergeHooks(opts *MergeOptions, repo bbrepo.Interface, pr *shared.PullRequest, hooks []string) error {
+	cs := opts.IO.ColorScheme()
+
+	for _, hook := range hooks {
+		if strings.Contains(hook, "{linked}") && issueKeyRE.FindString(pr.HeadBranch()) == "" {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Skipping post-merge hook %q: no linked issue on branch %s\n",
+				cs.WarningIcon(), hook, pr.HeadBranch())
+			continue
+		}
+
+		expanded := expandHookPlaceholders(hook, repo, pr)
+		args, err := shlex.Split(expanded)
+		if err != nil {
+			return fmt.Errorf("merge succeeded, but post-merge hook %q could not be parsed: %w", hook, err)
+		}
+		args = append(args, "--repo", fmt.Sprintf("%s/%s", repo.RepoWorkspace(), repo.RepoSlug()))
+
+		fmt.Fprintf(opts.IO.ErrOut, "Running post-merge hook: bb %s\n", expanded)
+		cmd := exec.Command(opts.Executable(), args...)
+		cmd.Env = os.Environ()
+		cmd.Stdout = opts.IO.Out
+		cmd.Stderr = opts.IO.ErrOut
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("merge succeeded, but post-merge hook %q failed: %w", hook, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package merge
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_expandHookPlaceholders(t *testing.T) {
+	repo, err := bbrepo.FromFullName("myworkspace/myrepo")
+	assert.NoError(t, err)
+
+	pr := &shared.PullRequest{ID: 42}
+	pr.Source.Branch.Name = "PROJ-123-fix-login"
+	pr.Destination.Branch.Name = "main"
+
+	assert.Equal(t,
+		"pipeline run --branch main --watch",
+		expandHookPlaceholders("pipeline run --branch {base} --watch", repo, pr))
+	assert.Equal(t,
+		"issue close PROJ-123",
+		expandHookPlaceholders("issue close {linked}", repo, pr))
+	assert.Equal(t,
+		"api repositories/myworkspace/myrepo/pullrequests/42",
+		expandHookPlaceholders("api repositories/{repo}/pullrequests/{number}", repo, pr))
+
+	pr.Source.Branch.Name = "no-issue-key"
+	assert.Equal(t, "issue close ", expandHookPlaceholders("issue close {linked}", repo, pr))
+}
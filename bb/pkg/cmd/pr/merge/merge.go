@@ -2,13 +2,20 @@ package merge
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	ghContext "github.com/dlbroadfoot/bitbucket-cli/context"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -19,19 +26,29 @@ import (
 type MergeOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+	Remotes    func() (ghContext.Remotes, error)
+	Executable func() string
 
 	SelectorArg   string
 	MergeStrategy string
 	Message       string
 	CloseSource   bool
+	Rebase        bool
+	NoTrailers    bool
 }
 
 func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Command {
 	opts := &MergeOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+		Remotes:    f.Remotes,
+		Executable: f.Executable,
 	}
 
 	cmd := &cobra.Command{
@@ -41,6 +58,20 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			Merge a pull request on Bitbucket.
 
 			By default, the merge strategy is "merge commit". Use --squash or --ff to change.
+
+			Bitbucket has no server-side rebase strategy. With --rebase, the source
+			branch is rebased onto the destination locally, force-pushed with a
+			lease, and the pull request is then fast-forward merged via the API.
+			A rebase that hits conflicts is aborted and leaves the branch untouched.
+
+			The merge message gets a Reviewed-by trailer per approving reviewer
+			and, when the source branch carries an issue key like PROJ-123, a
+			Refs trailer. Skip them with --no-trailers or disable them for good
+			with the merge_trailers config setting.
+
+			Commands listed in the post_merge config setting (semicolon-separated)
+			run as bb commands after a successful merge, with {base}, {head},
+			{number}, {repo}, and {linked} expanded from the merged pull request.
 		`),
 		Example: heredoc.Doc(`
 			# Merge pull request #123
@@ -54,11 +85,26 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 
 			# Merge and close source branch
 			$ bb pr merge 123 --close-source
+
+			# Rebase locally, then fast-forward merge
+			$ bb pr merge 123 --rebase
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.SelectorArg = args[0]
 
+			squash, _ := cmd.Flags().GetBool("squash")
+			ff, _ := cmd.Flags().GetBool("ff")
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --rebase, --squash, or --ff",
+				opts.Rebase, squash, ff,
+			); err != nil {
+				return err
+			}
+			if opts.Rebase && cmd.Flags().Changed("strategy") {
+				return cmdutil.FlagErrorf("--strategy cannot be combined with --rebase, which always fast-forward merges")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -69,6 +115,8 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().StringVarP(&opts.MergeStrategy, "strategy", "s", "merge_commit", "Merge strategy: merge_commit, squash, fast_forward")
 	cmd.Flags().StringVarP(&opts.Message, "message", "m", "", "Commit message for the merge")
 	cmd.Flags().BoolVar(&opts.CloseSource, "close-source", false, "Close source branch after merge")
+	cmd.Flags().BoolVar(&opts.Rebase, "rebase", false, "Rebase the source branch locally, force-push with lease, then fast-forward merge")
+	cmd.Flags().BoolVar(&opts.NoTrailers, "no-trailers", false, "Do not append Reviewed-by and Refs trailers to the merge message")
 
 	// Convenience flags
 	cmd.Flags().Bool("squash", false, "Use squash merge strategy")
@@ -109,6 +157,21 @@ func mergeRun(opts *MergeOptions) error {
 		return fmt.Errorf("pull request #%d is not open (state: %s)", prID, pr.StateDisplay())
 	}
 
+	if opts.Rebase {
+		if err := rebaseLocally(opts, repo, pr); err != nil {
+			return err
+		}
+		// The rebase made the source a descendant of the destination, so the
+		// API can fast-forward.
+		opts.MergeStrategy = "fast_forward"
+	}
+
+	if trailersEnabled(opts, repo.RepoHost()) {
+		if trailers := buildTrailers(pr); len(trailers) > 0 {
+			opts.Message = messageWithTrailers(opts.Message, pr, trailers)
+		}
+	}
+
 	// Perform the merge
 	err = mergePullRequest(httpClient, repo, prID, opts)
 	if err != nil {
@@ -116,11 +179,161 @@ func mergeRun(opts *MergeOptions) error {
 	}
 
 	cs := opts.IO.ColorScheme()
-	fmt.Fprintf(opts.IO.Out, "%s Merged pull request #%d\n", cs.SuccessIcon(), prID)
+	fmt.Fprintf(opts.IO.Out, "%s %s\n", cs.SuccessIcon(), i18n.T("Merged pull request #%d", prID))
+
+	if hooks := postMergeHooks(opts, repo.RepoHost()); len(hooks) > 0 {
+		return runPostMergeHooks(opts, repo, pr, hooks)
+	}
+
+	return nil
+}
+
+// rebaseLocally rebases the pull request's source branch onto its destination
+// using local git, then force-pushes the result with a lease. Every step that
+// can leave the working copy in a surprising state either aborts cleanly or
+// refuses to run.
+func rebaseLocally(opts *MergeOptions, repo bbrepo.Interface, pr *shared.PullRequest) error {
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+	remote := resolveRemote(opts, repo)
+	head := pr.HeadBranch()
+	base := pr.BaseBranch()
+
+	// Refuse to touch a dirty working copy
+	if changes, err := opts.GitClient.UncommittedChangeCount(ctx); err != nil {
+		return err
+	} else if changes > 0 {
+		return fmt.Errorf("cannot rebase with uncommitted changes; commit or stash them first")
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Fetching %s and %s from %s...\n", head, base, remote)
+	if err := opts.GitClient.Fetch(ctx, remote, head); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", head, err)
+	}
+	if err := opts.GitClient.Fetch(ctx, remote, base); err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", base, err)
+	}
+
+	// A local branch with unpushed commits would be clobbered by checkout -B
+	if opts.GitClient.HasLocalBranch(ctx, head) {
+		refs, err := opts.GitClient.ShowRefs(ctx, []string{
+			"refs/heads/" + head,
+			fmt.Sprintf("refs/remotes/%s/%s", remote, head),
+		})
+		if err != nil {
+			return err
+		}
+		if len(refs) == 2 && refs[0].Hash != refs[1].Hash {
+			return fmt.Errorf("local branch %s differs from %s/%s; push or reset it before rebasing", head, remote, head)
+		}
+	}
+
+	// Remember where we are so the working copy ends up back there
+	originalBranch, err := opts.GitClient.CurrentBranch(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := runGit(ctx, opts.GitClient, "checkout", "-B", head, fmt.Sprintf("%s/%s", remote, head)); err != nil {
+		return fmt.Errorf("failed to check out %s: %w", head, err)
+	}
+	if originalBranch != head {
+		defer func() {
+			if err := opts.GitClient.CheckoutBranch(ctx, originalBranch); err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "%s Failed to return to branch %s: %v\n", cs.WarningIcon(), originalBranch, err)
+			}
+		}()
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Rebasing %s onto %s/%s...\n", head, remote, base)
+	if err := runGit(ctx, opts.GitClient, "rebase", fmt.Sprintf("%s/%s", remote, base)); err != nil {
+		// Leave no half-finished rebase behind
+		if abortErr := runGit(ctx, opts.GitClient, "rebase", "--abort"); abortErr != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to abort rebase: %v\n", cs.WarningIcon(), abortErr)
+		}
+		return fmt.Errorf("rebase of %s onto %s hit conflicts and was aborted; rebase manually and merge with --strategy fast_forward", head, base)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Force-pushing %s with lease...\n", head)
+	if err := runGit(ctx, opts.GitClient, "push", "--force-with-lease", remote, head); err != nil {
+		return fmt.Errorf("failed to push %s: %w (the remote may have moved; fetch and retry)", head, err)
+	}
 
 	return nil
 }
 
+// resolveRemote picks the git remote that points at the pull request's
+// repository, falling back to origin when none of the configured remotes
+// matches.
+func resolveRemote(opts *MergeOptions, repo bbrepo.Interface) string {
+	if opts.Remotes != nil {
+		if remotes, err := opts.Remotes(); err == nil {
+			if r, err := remotes.FindByRepo(repo.RepoWorkspace(), repo.RepoSlug()); err == nil {
+				return r.Name
+			}
+		}
+	}
+	return "origin"
+}
+
+// issueKeyRE matches Jira-style issue keys like PROJ-123 in branch names.
+var issueKeyRE = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-\d+\b`)
+
+// trailersEnabled honors --no-trailers first, then the merge_trailers config
+// setting; trailers default to on.
+func trailersEnabled(opts *MergeOptions, hostname string) bool {
+	if opts.NoTrailers {
+		return false
+	}
+	cfg, err := opts.Config()
+	if err != nil {
+		return true
+	}
+	return cfg.GetOrDefault(hostname, "merge_trailers").UnwrapOrZero().Value != "disabled"
+}
+
+// buildTrailers derives commit message trailers from the pull request: one
+// Reviewed-by per approving participant, and a Refs trailer when the source
+// branch carries an issue key.
+func buildTrailers(pr *shared.PullRequest) []string {
+	var trailers []string
+	for _, p := range pr.Participants {
+		if !p.Approved {
+			continue
+		}
+		name := p.User.DisplayName
+		if name == "" {
+			name = p.User.Nickname
+		}
+		if name == "" {
+			continue
+		}
+		trailers = append(trailers, "Reviewed-by: "+name)
+	}
+	if key := issueKeyRE.FindString(pr.HeadBranch()); key != "" {
+		trailers = append(trailers, "Refs: "+key)
+	}
+	return trailers
+}
+
+// messageWithTrailers appends trailers as their own paragraph. Without a
+// custom message, the API would generate one server-side that we cannot
+// extend, so a default subject is supplied.
+func messageWithTrailers(message string, pr *shared.PullRequest, trailers []string) string {
+	if message == "" {
+		message = fmt.Sprintf("Merged in %s (pull request #%d)", pr.HeadBranch(), pr.ID)
+	}
+	return message + "\n\n" + strings.Join(trailers, "\n")
+}
+
+func runGit(ctx context.Context, client *git.Client, args ...string) error {
+	cmd, err := client.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	return cmd.Run()
+}
+
 func mergePullRequest(client *http.Client, repo bbrepo.Interface, prID int, opts *MergeOptions) error {
 	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/merge",
 		repo.RepoWorkspace(), repo.RepoSlug(), prID)
@@ -157,7 +370,7 @@ func mergePullRequest(client *http.Client, repo bbrepo.Interface, prID int, opts
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return api.HandleHTTPError(resp)
+		return cmdutil.WrapWriteError(api.HandleHTTPError(resp), repo)
 	}
 
 	return nil
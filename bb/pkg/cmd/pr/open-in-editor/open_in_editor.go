@@ -0,0 +1,232 @@
+package openineditor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type OpenInEditorOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	SelectorArg string
+	Editor      string
+	Dir         string
+	NoLaunch    bool
+}
+
+// prMetadata is written to the worktree's git directory so editor
+// integrations can recover the diff base of the checked out pull request.
+type prMetadata struct {
+	PullRequest int    `json:"pull_request"`
+	Repository  string `json:"repository"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	URL         string `json:"url"`
+}
+
+func NewCmdOpenInEditor(f *cmdutil.Factory, runF func(*OpenInEditorOptions) error) *cobra.Command {
+	opts := &OpenInEditorOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "open-in-editor {<number> | <url>}",
+		Short: "Check out a pull request into a worktree and open it in your editor",
+		Long: heredoc.Doc(`
+			Check out a pull request into a separate git worktree and launch an
+			editor on it.
+
+			The worktree's git directory gets a bb-pr-metadata file recording
+			the pull request and its diff base, so editor integrations can show
+			the pull request diff.
+
+			The editor is taken from --editor, then the editor config setting,
+			then $VISUAL or $EDITOR, and finally "code".
+		`),
+		Example: heredoc.Doc(`
+			$ bb pr open-in-editor 123
+			$ bb pr open-in-editor 123 --dir ~/review/feature
+			$ bb pr open-in-editor 123 --editor subl
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot open: pull request argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return openInEditorRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Editor, "editor", "", "Editor `command` to launch on the worktree")
+	cmd.Flags().StringVar(&opts.Dir, "dir", "", "Create the worktree in `directory` instead of a temporary one")
+	cmd.Flags().BoolVar(&opts.NoLaunch, "no-launch", false, "Prepare the worktree but do not launch the editor")
+
+	return cmd
+}
+
+func openInEditorRun(opts *OpenInEditorOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	// Parse the PR argument
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+	if prRepo != nil {
+		repo = prRepo
+	}
+
+	pr, err := list.FetchPullRequest(httpClient, repo, prID)
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	ctx := context.Background()
+	branchName := pr.HeadBranch()
+	remote := "origin"
+
+	fmt.Fprintf(opts.IO.ErrOut, "Fetching %s from %s...\n", branchName, remote)
+	if err := opts.GitClient.Fetch(ctx, remote, branchName); err != nil {
+		return fmt.Errorf("failed to fetch branch: %w", err)
+	}
+
+	dir := opts.Dir
+	if dir == "" {
+		dir, err = os.MkdirTemp("", fmt.Sprintf("bb-pr-%d-", pr.ID))
+		if err != nil {
+			return err
+		}
+		// worktree add refuses to reuse an existing directory
+		if err := os.Remove(dir); err != nil {
+			return err
+		}
+	}
+
+	worktreeCmd, err := opts.GitClient.Command(ctx, "worktree", "add", dir, "FETCH_HEAD")
+	if err != nil {
+		return err
+	}
+	if err := worktreeCmd.Run(); err != nil {
+		return fmt.Errorf("failed to create worktree: %w", err)
+	}
+
+	if err := writeMetadata(ctx, opts.GitClient, dir, pr, repo); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Created worktree %s for pull request #%d\n",
+		cs.SuccessIcon(), dir, pr.ID)
+
+	if opts.NoLaunch {
+		return nil
+	}
+
+	editor, err := resolveEditor(opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Launching %s...\n", editor)
+	editorCmd := exec.Command(editor, dir)
+	editorCmd.Stdin = opts.IO.In
+	editorCmd.Stdout = opts.IO.Out
+	editorCmd.Stderr = opts.IO.ErrOut
+	if err := editorCmd.Run(); err != nil {
+		return fmt.Errorf("failed to launch %s: %w", editor, err)
+	}
+
+	return nil
+}
+
+// writeMetadata records the pull request and its diff base in the worktree's
+// git directory, where a checkout cannot clobber it.
+func writeMetadata(ctx context.Context, gitClient *git.Client, dir string, pr *shared.PullRequest, repo bbrepo.Interface) error {
+	cmd, err := gitClient.Command(ctx, "-C", dir, "rev-parse", "--git-path", "bb-pr-metadata")
+	if err != nil {
+		return err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to locate the worktree's git directory: %w", err)
+	}
+
+	path := strings.TrimSpace(string(out))
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	metadata := prMetadata{
+		PullRequest: pr.ID,
+		Repository:  bbrepo.FullName(repo),
+		Source:      pr.HeadBranch(),
+		Destination: pr.BaseBranch(),
+		URL:         pr.HTMLURL(),
+	}
+	data, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// resolveEditor picks the editor command: the --editor flag, then the editor
+// config setting, then $VISUAL/$EDITOR, and finally code.
+func resolveEditor(opts *OpenInEditorOptions) (string, error) {
+	if opts.Editor != "" {
+		return opts.Editor, nil
+	}
+
+	editor, err := cmdutil.DetermineEditor(opts.Config)
+	if err != nil {
+		return "", err
+	}
+	if editor != "" {
+		return editor, nil
+	}
+
+	if visual := os.Getenv("VISUAL"); visual != "" {
+		return visual, nil
+	}
+	if fallback := os.Getenv("EDITOR"); fallback != "" {
+		return fallback, nil
+	}
+
+	return "code", nil
+}
@@ -2,6 +2,8 @@ package pr
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	assignReviewerCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/assign-reviewer"
+	backportCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/backport"
 	checkoutCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/checkout"
 	checksCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/checks"
 	closeCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/close"
@@ -11,8 +13,14 @@ import (
 	editCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/edit"
 	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
 	mergeCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/merge"
+	openInEditorCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/open-in-editor"
+	remindCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/remind"
+	retargetCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/retarget"
 	reviewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/review"
+	stackCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack"
 	statusCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/status"
+	subscribeCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/subscribe"
+	threadCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/thread"
 	viewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/view"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -49,10 +57,19 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(editCmd.NewCmdEdit(f, nil))
 	cmd.AddCommand(mergeCmd.NewCmdMerge(f, nil))
 	cmd.AddCommand(checkoutCmd.NewCmdCheckout(f, nil))
+	cmd.AddCommand(openInEditorCmd.NewCmdOpenInEditor(f, nil))
 	cmd.AddCommand(closeCmd.NewCmdClose(f, nil))
 	cmd.AddCommand(commentCmd.NewCmdComment(f, nil))
 	cmd.AddCommand(diffCmd.NewCmdDiff(f, nil))
 	cmd.AddCommand(reviewCmd.NewCmdReview(f, nil))
+	cmd.AddCommand(remindCmd.NewCmdRemind(f, nil))
+	cmd.AddCommand(retargetCmd.NewCmdRetarget(f, nil))
+	cmd.AddCommand(assignReviewerCmd.NewCmdAssignReviewer(f, nil))
+	cmd.AddCommand(backportCmd.NewCmdBackport(f, nil))
+	cmd.AddCommand(subscribeCmd.NewCmdSubscribe(f, nil))
+	cmd.AddCommand(subscribeCmd.NewCmdUnsubscribe(f, nil))
+	cmd.AddCommand(stackCmd.NewCmdStack(f))
+	cmd.AddCommand(threadCmd.NewCmdThread(f))
 
 	return cmd
 }
@@ -0,0 +1,276 @@
+package remind
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	notifyShared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/notify/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemindOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Hours        int
+	Comment      bool
+	Template     string
+	SlackWebhook string
+	TeamsWebhook string
+}
+
+// defaultTemplate is the reminder comment posted with --comment; {reviewers}
+// and {idle} are replaced per pull request.
+const defaultTemplate = "Friendly reminder: this pull request has been waiting on review from {reviewers} for {idle}."
+
+func NewCmdRemind(f *cmdutil.Factory, runF func(*RemindOptions) error) *cobra.Command {
+	opts := &RemindOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remind",
+		Short: "Remind reviewers about pull requests waiting on them",
+		Long: heredoc.Docf(`
+			Find open pull requests that have been waiting on one or more
+			reviewers for longer than a threshold, and report them.
+
+			By default a report is printed. With %[1]s--comment%[1]s a reminder
+			comment mentioning the pending reviewers is posted on each pull
+			request, and with %[1]s--slack-webhook%[1]s or %[1]s--teams-webhook%[1]s a
+			summary is sent to a chat incoming webhook. The command exits
+			quietly when nothing is waiting, so it is suitable for cron.
+
+			The comment template supports the %[1]s{reviewers}%[1]s and %[1]s{idle}%[1]s
+			placeholders.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Report pull requests waiting on review for more than a day
+			$ bb pr remind
+
+			# Post a reminder comment on anything idle for over 48 hours
+			$ bb pr remind --hours 48 --comment
+
+			# Send the report to Slack from cron
+			$ bb pr remind --slack-webhook https://hooks.slack.com/services/T000/B000/XXXX
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Hours <= 0 {
+				return cmdutil.FlagErrorf("--hours must be positive")
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of --slack-webhook or --teams-webhook",
+				opts.SlackWebhook != "", opts.TeamsWebhook != "",
+			); err != nil {
+				return err
+			}
+			if opts.Template != defaultTemplate && !opts.Comment {
+				return cmdutil.FlagErrorf("--template requires --comment")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return remindRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Hours, "hours", 24, "Only remind about pull requests idle for more than this many hours")
+	cmd.Flags().BoolVar(&opts.Comment, "comment", false, "Post a reminder comment on each pull request")
+	cmd.Flags().StringVar(&opts.Template, "template", defaultTemplate, "Template for the reminder comment")
+	cmd.Flags().StringVar(&opts.SlackWebhook, "slack-webhook", "", "Slack incoming webhook URL that receives the summary")
+	cmd.Flags().StringVar(&opts.TeamsWebhook, "teams-webhook", "", "Microsoft Teams incoming webhook URL that receives the summary")
+
+	return cmd
+}
+
+// waitingPR is one pull request waiting on reviewers past the threshold.
+type waitingPR struct {
+	pr        *shared.PullRequest
+	reviewers []string
+	idle      time.Duration
+}
+
+func remindRun(opts *RemindOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	waiting, err := findWaitingPRs(httpClient, repo, opts.Hours)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if len(waiting) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.ErrOut, "No pull requests waiting on review for more than %s\n",
+				text.Pluralize(opts.Hours, "hour"))
+		}
+		return nil
+	}
+
+	if opts.Comment {
+		cs := opts.IO.ColorScheme()
+		for _, w := range waiting {
+			body := renderTemplate(opts.Template, w)
+			if err := createComment(httpClient, repo, w.pr.ID, body); err != nil {
+				return fmt.Errorf("failed to comment on pull request #%d: %w", w.pr.ID, err)
+			}
+			fmt.Fprintf(opts.IO.ErrOut, "%s Reminded %s on pull request #%d\n",
+				cs.SuccessIcon(), strings.Join(w.reviewers, ", "), w.pr.ID)
+		}
+		return nil
+	}
+
+	if opts.SlackWebhook != "" || opts.TeamsWebhook != "" {
+		provider := "slack"
+		webhookURL := opts.SlackWebhook
+		if opts.TeamsWebhook != "" {
+			provider = "teams"
+			webhookURL = opts.TeamsWebhook
+		}
+		if err := notifyShared.Send(provider, webhookURL, summaryMessage(repo, waiting)); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s Sent reminder summary for %s to %s\n",
+				cs.SuccessIcon(), text.Pluralize(len(waiting), "pull request"), provider)
+		}
+		return nil
+	}
+
+	return printWaiting(opts.IO, waiting)
+}
+
+// findWaitingPRs collects open pull requests that have reviewers without a
+// review and no activity for more than hours hours. The list endpoint omits
+// participants, so each candidate costs one extra request.
+func findWaitingPRs(client *http.Client, repo bbrepo.Interface, hours int) ([]waitingPR, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	threshold := time.Duration(hours) * time.Hour
+
+	var waiting []waitingPR
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests?state=OPEN&pagelen=50",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	nextURL := ""
+	for {
+		var page shared.PullRequestList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pr := range page.Values {
+			updated, err := time.Parse(time.RFC3339, pr.UpdatedOn)
+			if err != nil {
+				continue
+			}
+			idle := time.Since(updated)
+			if idle < threshold {
+				continue
+			}
+
+			full, err := list.FetchPullRequest(client, repo, pr.ID)
+			if err != nil {
+				return nil, err
+			}
+			reviewers := pendingReviewers(full.Participants)
+			if len(reviewers) == 0 {
+				continue
+			}
+			waiting = append(waiting, waitingPR{pr: full, reviewers: reviewers, idle: idle})
+		}
+
+		if page.Next == "" {
+			return waiting, nil
+		}
+		nextURL = page.Next
+	}
+}
+
+// pendingReviewers returns the display names of reviewers who have neither
+// approved nor requested changes.
+func pendingReviewers(participants []shared.Participant) []string {
+	var pending []string
+	for _, p := range participants {
+		if p.Role == "REVIEWER" && p.State == "" {
+			pending = append(pending, p.User.DisplayName)
+		}
+	}
+	return pending
+}
+
+func renderTemplate(template string, w waitingPR) string {
+	body := strings.ReplaceAll(template, "{reviewers}", strings.Join(w.reviewers, ", "))
+	return strings.ReplaceAll(body, "{idle}", text.Pluralize(int(w.idle.Hours()), "hour"))
+}
+
+func summaryMessage(repo bbrepo.Interface, waiting []waitingPR) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s waiting on review in %s:",
+		text.Pluralize(len(waiting), "pull request"), bbrepo.FullName(repo))
+	for _, w := range waiting {
+		fmt.Fprintf(&b, "\n• #%d %s — waiting on %s for %s",
+			w.pr.ID, w.pr.Title, strings.Join(w.reviewers, ", "), text.Pluralize(int(w.idle.Hours()), "hour"))
+	}
+	return b.String()
+}
+
+func printWaiting(io *iostreams.IOStreams, waiting []waitingPR) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("ID", "TITLE", "WAITING ON", "IDLE"))
+
+	for _, w := range waiting {
+		tp.AddField(fmt.Sprintf("#%d", w.pr.ID), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(w.pr.Title, tableprinter.WithTruncate(nil))
+		tp.AddField(strings.Join(w.reviewers, ", "))
+		tp.AddField(text.FuzzyAgoAbbr(time.Now(), time.Now().Add(-w.idle)))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func createComment(client *http.Client, repo bbrepo.Interface, prID int, body string) error {
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/comments",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+
+	payload := map[string]interface{}{
+		"content": map[string]string{
+			"raw": body,
+		},
+	}
+
+	return apiClient.Post(repo.RepoHost(), path, payload, nil)
+}
@@ -0,0 +1,180 @@
+package retarget
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RetargetOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	FromBranch string
+	ToBranch   string
+	State      string
+	DryRun     bool
+}
+
+func NewCmdRetarget(f *cmdutil.Factory, runF func(*RetargetOptions) error) *cobra.Command {
+	opts := &RetargetOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "retarget --from <branch> --to <branch>",
+		Short: "Move pull requests to a new destination branch",
+		Long: heredoc.Docf(`
+			Update the destination branch of every matching pull request,
+			typically after renaming the default branch.
+
+			Use %[1]s--dry-run%[1]s to list the pull requests that would be
+			retargeted without changing anything.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# After renaming the default branch from master to main
+			$ bb pr retarget --from master --to main
+
+			# See what would change first
+			$ bb pr retarget --from master --to main --dry-run
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.FromBranch == "" || opts.ToBranch == "" {
+				return cmdutil.FlagErrorf("--from and --to are required")
+			}
+			if opts.FromBranch == opts.ToBranch {
+				return cmdutil.FlagErrorf("--from and --to must name different branches")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return retargetRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.FromBranch, "from", "", "Current destination branch of the pull requests")
+	cmd.Flags().StringVar(&opts.ToBranch, "to", "", "New destination branch")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "open", []string{"open"}, "Only retarget pull requests in this state")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List the pull requests that would be retargeted without updating them")
+
+	return cmd
+}
+
+func retargetRun(opts *RetargetOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	prs, err := fetchTargetingPRs(httpClient, repo, opts.FromBranch, opts.State)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if len(prs) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No %s pull requests target %s\n", opts.State, opts.FromBranch)
+		return nil
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.ErrOut, "Would retarget %s from %s to %s:\n",
+			text.Pluralize(len(prs), "pull request"), cs.Cyan(opts.FromBranch), cs.Cyan(opts.ToBranch))
+		for _, pr := range prs {
+			fmt.Fprintf(opts.IO.Out, "#%d\t%s\n", pr.ID, pr.Title)
+		}
+		return nil
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var failed int
+	for _, pr := range prs {
+		opts.IO.StartProgressIndicator()
+		err := retargetPR(apiClient, repo, pr.ID, opts.ToBranch)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			failed++
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to retarget pull request #%d: %v\n",
+				cs.FailureIcon(), pr.ID, err)
+			continue
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Retargeted pull request #%d to %s\n",
+			cs.SuccessIcon(), pr.ID, cs.Cyan(opts.ToBranch))
+	}
+
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+// fetchTargetingPRs lists the pull requests whose destination branch is
+// branch, in the given state.
+func fetchTargetingPRs(client *http.Client, repo bbrepo.Interface, branch, state string) ([]shared.PullRequest, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	params := url.Values{}
+	params.Set("pagelen", "50")
+	params.Set("q", fmt.Sprintf(`destination.branch.name="%s" AND state="%s"`,
+		branch, shared.PRStateFromString(state)))
+
+	var prs []shared.PullRequest
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests?%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), params.Encode())
+	nextURL := ""
+	for {
+		var page shared.PullRequestList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		prs = append(prs, page.Values...)
+		if page.Next == "" {
+			return prs, nil
+		}
+		nextURL = page.Next
+	}
+}
+
+func retargetPR(apiClient *api.Client, repo bbrepo.Interface, prID int, branch string) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+
+	payload := map[string]interface{}{
+		"destination": map[string]interface{}{
+			"branch": map[string]string{
+				"name": branch,
+			},
+		},
+	}
+
+	return apiClient.Put(repo.RepoHost(), path, payload, nil)
+}
@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/membercache"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+// ReviewerCompletionFunc completes reviewer flag values from the cached
+// member list of the base repository's workspace. Completion never hits the
+// network, so it only offers suggestions once a previous command has warmed
+// the cache.
+func ReviewerCompletionFunc(f *cmdutil.Factory) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		repo, err := f.BaseRepo()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		members, ok := membercache.New().Get(repo.RepoHost(), repo.RepoWorkspace())
+		if !ok {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var results []string
+		for _, m := range members {
+			if m.Nickname != "" && strings.HasPrefix(strings.ToLower(m.Nickname), strings.ToLower(toComplete)) {
+				results = append(results, m.Nickname)
+			}
+		}
+		return results, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,113 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// Git config keys for per-repository pull request lint rules, e.g.
+// `git config bb.pr-lint-conventional true`. Unset keys disable the
+// corresponding rule; with no rules configured, no check runs.
+const (
+	// LintConventionalConfigKey requires the title to carry a conventional
+	// commit prefix such as "feat:" or "fix(scope):".
+	LintConventionalConfigKey = "bb.pr-lint-conventional"
+	// LintTicketPatternConfigKey is a regular expression, e.g. `PROJ-\d+`,
+	// that must match the title or the body.
+	LintTicketPatternConfigKey = "bb.pr-lint-ticket-pattern"
+	// LintMinBodyLengthConfigKey is the minimum body length in characters.
+	LintMinBodyLengthConfigKey = "bb.pr-lint-min-body"
+)
+
+var conventionalTitleRE = regexp.MustCompile(`^(build|chore|ci|docs|feat|fix|perf|refactor|revert|style|test)(\([^)]+\))?!?: .+`)
+
+// LintRules are the configured pull request description checks.
+type LintRules struct {
+	Conventional  bool
+	TicketPattern *regexp.Regexp
+	MinBodyLength int
+}
+
+// Empty reports whether no rule is configured.
+func (r LintRules) Empty() bool {
+	return !r.Conventional && r.TicketPattern == nil && r.MinBodyLength <= 0
+}
+
+// Check validates title and body against the rules and returns a problem
+// description per violated rule.
+func (r LintRules) Check(title, body string) []string {
+	var problems []string
+
+	if r.Conventional && !conventionalTitleRE.MatchString(title) {
+		problems = append(problems, `title does not follow the conventional commit format, e.g. "feat(api): add pagination"`)
+	}
+
+	if r.TicketPattern != nil && !r.TicketPattern.MatchString(title) && !r.TicketPattern.MatchString(body) {
+		problems = append(problems, fmt.Sprintf("neither title nor body references a ticket matching %s", r.TicketPattern))
+	}
+
+	if r.MinBodyLength > 0 && len(strings.TrimSpace(body)) < r.MinBodyLength {
+		problems = append(problems, fmt.Sprintf("body is shorter than the required %d characters", r.MinBodyLength))
+	}
+
+	return problems
+}
+
+// LintRulesFromConfig reads the lint rules from git config. Reading is
+// best-effort: a nil client, unset keys, or unparsable values disable the
+// corresponding rule.
+func LintRulesFromConfig(ctx context.Context, gitClient *git.Client) LintRules {
+	var rules LintRules
+	if gitClient == nil {
+		return rules
+	}
+
+	if raw, err := gitClient.Config(ctx, LintConventionalConfigKey); err == nil {
+		if enabled, err := strconv.ParseBool(strings.TrimSpace(raw)); err == nil {
+			rules.Conventional = enabled
+		}
+	}
+
+	if raw, err := gitClient.Config(ctx, LintTicketPatternConfigKey); err == nil {
+		if pattern, err := regexp.Compile(strings.TrimSpace(raw)); err == nil {
+			rules.TicketPattern = pattern
+		}
+	}
+
+	if raw, err := gitClient.Config(ctx, LintMinBodyLengthConfigKey); err == nil {
+		if length, err := strconv.Atoi(strings.TrimSpace(raw)); err == nil && length > 0 {
+			rules.MinBodyLength = length
+		}
+	}
+
+	return rules
+}
+
+// LintPullRequest runs the configured lint rules against title and body and
+// reports every violation. A non-nil error means the pull request should not
+// be submitted.
+func LintPullRequest(io *iostreams.IOStreams, ctx context.Context, gitClient *git.Client, title, body string) error {
+	rules := LintRulesFromConfig(ctx, gitClient)
+	if rules.Empty() {
+		return nil
+	}
+
+	problems := rules.Check(title, body)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	cs := io.ColorScheme()
+	for _, problem := range problems {
+		fmt.Fprintf(io.ErrOut, "%s %s\n", cs.FailureIcon(), problem)
+	}
+
+	return fmt.Errorf("pull request failed %s (use --no-verify to skip)", text.Pluralize(len(problems), "lint check"))
+}
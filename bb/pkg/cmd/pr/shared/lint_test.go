@@ -0,0 +1,109 @@
+package shared
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestLintRulesCheck(t *testing.T) {
+	ticketRE := regexp.MustCompile(`PROJ-\d+`)
+
+	tests := []struct {
+		name         string
+		rules        LintRules
+		title        string
+		body         string
+		wantProblems int
+	}{
+		{
+			name:         "no rules",
+			rules:        LintRules{},
+			title:        "anything",
+			wantProblems: 0,
+		},
+		{
+			name:         "conventional title passes",
+			rules:        LintRules{Conventional: true},
+			title:        "feat(api): add pagination",
+			wantProblems: 0,
+		},
+		{
+			name:         "conventional title with breaking marker passes",
+			rules:        LintRules{Conventional: true},
+			title:        "fix!: drop legacy endpoint",
+			wantProblems: 0,
+		},
+		{
+			name:         "non-conventional title fails",
+			rules:        LintRules{Conventional: true},
+			title:        "Add pagination",
+			wantProblems: 1,
+		},
+		{
+			name:         "ticket in title passes",
+			rules:        LintRules{TicketPattern: ticketRE},
+			title:        "PROJ-123: add pagination",
+			wantProblems: 0,
+		},
+		{
+			name:         "ticket in body passes",
+			rules:        LintRules{TicketPattern: ticketRE},
+			title:        "add pagination",
+			body:         "Implements PROJ-456.",
+			wantProblems: 0,
+		},
+		{
+			name:         "missing ticket fails",
+			rules:        LintRules{TicketPattern: ticketRE},
+			title:        "add pagination",
+			body:         "no reference here",
+			wantProblems: 1,
+		},
+		{
+			name:         "body long enough passes",
+			rules:        LintRules{MinBodyLength: 10},
+			title:        "add pagination",
+			body:         "a body with plenty of detail",
+			wantProblems: 0,
+		},
+		{
+			name:         "short body fails",
+			rules:        LintRules{MinBodyLength: 10},
+			title:        "add pagination",
+			body:         "   brief   ",
+			wantProblems: 1,
+		},
+		{
+			name: "every rule violated",
+			rules: LintRules{
+				Conventional:  true,
+				TicketPattern: ticketRE,
+				MinBodyLength: 100,
+			},
+			title:        "Add pagination",
+			body:         "too short",
+			wantProblems: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems := tt.rules.Check(tt.title, tt.body)
+			if len(problems) != tt.wantProblems {
+				t.Errorf("got %d problems %v, want %d", len(problems), problems, tt.wantProblems)
+			}
+		})
+	}
+}
+
+func TestLintRulesEmpty(t *testing.T) {
+	if !(LintRules{}).Empty() {
+		t.Error("expected zero rules to be empty")
+	}
+	if (LintRules{Conventional: true}).Empty() {
+		t.Error("expected conventional rule to be non-empty")
+	}
+	if (LintRules{MinBodyLength: 1}).Empty() {
+		t.Error("expected min body rule to be non-empty")
+	}
+}
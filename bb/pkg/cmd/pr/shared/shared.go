@@ -2,11 +2,11 @@ package shared
 
 import (
 	"fmt"
-	"regexp"
-	"strconv"
 	"strings"
 
+	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/selector"
 )
 
 // PullRequest represents a Bitbucket pull request
@@ -26,8 +26,19 @@ type PullRequest struct {
 	MergeCommit *struct {
 		Hash string `json:"hash"`
 	} `json:"merge_commit,omitempty"`
-	CommentCount int `json:"comment_count"`
-	TaskCount    int `json:"task_count"`
+	CommentCount int           `json:"comment_count"`
+	TaskCount    int           `json:"task_count"`
+	Participants []Participant `json:"participants"`
+}
+
+// Participant represents a user involved in a pull request, including the
+// per-reviewer approval state.
+type Participant struct {
+	User           User   `json:"user"`
+	Role           string `json:"role"` // PARTICIPANT, REVIEWER
+	Approved       bool   `json:"approved"`
+	State          string `json:"state"` // approved, changes_requested, or empty
+	ParticipatedOn string `json:"participated_on"`
 }
 
 type User struct {
@@ -106,28 +117,71 @@ func (pr *PullRequest) HTMLURL() string {
 	return pr.Links.HTML.Href
 }
 
-// ParsePRArg parses a PR argument which can be a number or URL
-func ParsePRArg(arg string) (int, bbrepo.Interface, error) {
-	// Try parsing as a number first
-	if num, err := strconv.Atoi(arg); err == nil {
-		return num, nil, nil
+// ExportData maps the pull request into the fields requested with --json.
+// Field names match the column names used by pr list.
+func (pr *PullRequest) ExportData(fields []string) map[string]interface{} {
+	data := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data[f] = pr.ID
+		case "title":
+			data[f] = pr.Title
+		case "branch":
+			data[f] = pr.HeadBranch()
+		case "author":
+			data[f] = pr.Author.DisplayName
+		case "state":
+			data[f] = pr.State
+		case "created":
+			data[f] = pr.CreatedOn
+		case "updated":
+			data[f] = pr.UpdatedOn
+		case "body":
+			data[f] = pr.Description
+		case "baseBranch":
+			data[f] = pr.BaseBranch()
+		case "url":
+			data[f] = pr.HTMLURL()
+		case "commentCount":
+			data[f] = pr.CommentCount
+		case "taskCount":
+			data[f] = pr.TaskCount
+		case "reviewers":
+			reviewers := make([]string, len(pr.Reviewers))
+			for i, r := range pr.Reviewers {
+				reviewers[i] = r.DisplayName
+			}
+			data[f] = reviewers
+		case "participants":
+			participants := make([]map[string]interface{}, len(pr.Participants))
+			for i, p := range pr.Participants {
+				participants[i] = p.ExportData()
+			}
+			data[f] = participants
+		}
 	}
+	return data
+}
 
-	// Try parsing as a URL
-	// Format: https://bitbucket.org/WORKSPACE/REPO/pull-requests/123
-	re := regexp.MustCompile(`^https?://([^/]+)/([^/]+)/([^/]+)/pull-requests/(\d+)`)
-	matches := re.FindStringSubmatch(arg)
-	if matches != nil {
-		host := matches[1]
-		workspace := matches[2]
-		repoSlug := matches[3]
-		num, _ := strconv.Atoi(matches[4])
-
-		repo := bbrepo.NewWithHost(workspace, repoSlug, host)
-		return num, repo, nil
+// ExportData maps the participant into the shape emitted for the
+// participants field of pr view --json. Nested objects always carry their
+// full field set.
+func (p *Participant) ExportData() map[string]interface{} {
+	return map[string]interface{}{
+		"displayName":    p.User.DisplayName,
+		"accountId":      p.User.AccountID,
+		"uuid":           p.User.UUID,
+		"role":           p.Role,
+		"approved":       p.Approved,
+		"state":          p.State,
+		"participatedOn": p.ParticipatedOn,
 	}
+}
 
-	return 0, nil, fmt.Errorf("invalid pull request argument: %s", arg)
+// ParsePRArg parses a PR argument which can be a number or URL
+func ParsePRArg(arg string) (int, bbrepo.Interface, error) {
+	return selector.PullRequest.ParseNumber(arg)
 }
 
 // PRStateFromString converts a string state to the Bitbucket API state format
@@ -167,8 +221,14 @@ type Comment struct {
 	Parent *struct {
 		ID int `json:"id"`
 	} `json:"parent,omitempty"`
-	Deleted bool  `json:"deleted"`
-	Links   Links `json:"links"`
+	// Resolution is present on the root comment of resolved inline threads
+	Resolution *struct {
+		Type string `json:"type"`
+	} `json:"resolution,omitempty"`
+	// Likes is only populated where the host exposes comment reactions
+	Likes   []User `json:"likes,omitempty"`
+	Deleted bool   `json:"deleted"`
+	Links   Links  `json:"links"`
 }
 
 // CommentList represents a paginated list of comments
@@ -180,3 +240,70 @@ type CommentList struct {
 	Previous string    `json:"previous"`
 	Values   []Comment `json:"values"`
 }
+
+// ResolveComment marks an inline comment thread as resolved.
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+func ResolveComment(apiClient *api.Client, repo bbrepo.Interface, prID, commentID int) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/comments/%d/resolve",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID, commentID)
+	return apiClient.Post(repo.RepoHost(), path, nil, nil)
+}
+
+// UnresolveComment reopens a resolved inline comment thread.
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/resolve
+func UnresolveComment(apiClient *api.Client, repo bbrepo.Interface, prID, commentID int) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/comments/%d/resolve",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID, commentID)
+	return apiClient.Delete(repo.RepoHost(), path)
+}
+
+// LikeComment likes a pull request comment as the authenticated user.
+// POST /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/likes
+func LikeComment(apiClient *api.Client, repo bbrepo.Interface, prID, commentID int) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/comments/%d/likes",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID, commentID)
+	return apiClient.Post(repo.RepoHost(), path, nil, nil)
+}
+
+// UnlikeComment removes the authenticated user's like from a comment.
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/comments/{comment_id}/likes
+func UnlikeComment(apiClient *api.Client, repo bbrepo.Interface, prID, commentID int) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/comments/%d/likes",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID, commentID)
+	return apiClient.Delete(repo.RepoHost(), path)
+}
+
+// WatchPullRequest subscribes the authenticated user to notifications for a
+// pull request.
+// PUT /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/watch
+func WatchPullRequest(apiClient *api.Client, repo bbrepo.Interface, prID int) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/watch",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+	return apiClient.Put(repo.RepoHost(), path, nil, nil)
+}
+
+// UnwatchPullRequest unsubscribes the authenticated user from notifications
+// for a pull request.
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/watch
+func UnwatchPullRequest(apiClient *api.Client, repo bbrepo.Interface, prID int) error {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/watch",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+	return apiClient.Delete(repo.RepoHost(), path)
+}
+
+// IsWatchingPullRequest reports whether the authenticated user is subscribed
+// to notifications for a pull request. The watch endpoint returns 204 when
+// watching and 404 when not.
+// GET /2.0/repositories/{workspace}/{repo_slug}/pullrequests/{pull_request_id}/watch
+func IsWatchingPullRequest(apiClient *api.Client, repo bbrepo.Interface, prID int) (bool, error) {
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/watch",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+	err := apiClient.Get(repo.RepoHost(), path, nil)
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,123 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// SizeWarningConfigKey is the git config key for a per-repository pull request
+// size warning threshold in changed lines, e.g. `git config bb.pr-size-warning 400`.
+// When a pull request changes more lines than the threshold, a warning nudges
+// the author to split it. Unset means no warning.
+const SizeWarningConfigKey = "bb.pr-size-warning"
+
+// DiffStat summarizes the diff of a pull request.
+type DiffStat struct {
+	FilesChanged int
+	LinesAdded   int
+	LinesRemoved int
+}
+
+// LinesChanged is the total number of added and removed lines.
+func (d DiffStat) LinesChanged() int {
+	return d.LinesAdded + d.LinesRemoved
+}
+
+// SizeClass buckets the diff into a T-shirt size by changed lines.
+func (d DiffStat) SizeClass() string {
+	switch changed := d.LinesChanged(); {
+	case changed < 10:
+		return "XS"
+	case changed < 50:
+		return "S"
+	case changed < 250:
+		return "M"
+	case changed < 1000:
+		return "L"
+	default:
+		return "XL"
+	}
+}
+
+// FetchDiffStat sums the diffstat of a pull request across all its pages.
+func FetchDiffStat(httpClient *http.Client, repo bbrepo.Interface, prID int) (*DiffStat, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d/diffstat?pagelen=500",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+
+	var stat DiffStat
+	nextURL := ""
+	for {
+		var page struct {
+			Values []struct {
+				LinesAdded   int `json:"lines_added"`
+				LinesRemoved int `json:"lines_removed"`
+			} `json:"values"`
+			Next string `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			nextURL, err = apiClient.RESTWithNext(repo.RepoHost(), http.MethodGet, path, nil, &page)
+		} else {
+			nextURL, err = apiClient.RESTWithNextURL(http.MethodGet, nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		stat.FilesChanged += len(page.Values)
+		for _, entry := range page.Values {
+			stat.LinesAdded += entry.LinesAdded
+			stat.LinesRemoved += entry.LinesRemoved
+		}
+
+		if nextURL == "" {
+			break
+		}
+	}
+
+	return &stat, nil
+}
+
+// SizeWarningThreshold reads the per-repository threshold from local git
+// config. It returns 0 (disabled) when the key is unset or not a positive
+// number, or when gitClient is nil.
+func SizeWarningThreshold(ctx context.Context, gitClient *git.Client) int {
+	if gitClient == nil {
+		return 0
+	}
+	raw, err := gitClient.Config(ctx, SizeWarningConfigKey)
+	if err != nil {
+		return 0
+	}
+	threshold, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || threshold <= 0 {
+		return 0
+	}
+	return threshold
+}
+
+// PrintSize writes the size classification for a pull request and, when the
+// diff exceeds a positive threshold, a warning suggesting a split.
+func PrintSize(io *iostreams.IOStreams, stat *DiffStat, threshold int) {
+	cs := io.ColorScheme()
+	fmt.Fprintf(io.Out, "%s %s (%s changed across %s)\n",
+		cs.Bold("Size:"), stat.SizeClass(),
+		text.Pluralize(stat.LinesChanged(), "line"),
+		text.Pluralize(stat.FilesChanged, "file"))
+
+	if threshold > 0 && stat.LinesChanged() > threshold {
+		fmt.Fprintf(io.ErrOut, "%s This pull request changes %d lines, above the configured threshold of %d. Consider splitting it into smaller pull requests.\n",
+			cs.WarningIcon(), stat.LinesChanged(), threshold)
+	}
+}
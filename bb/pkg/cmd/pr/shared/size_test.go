@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffStatSizeClass(t *testing.T) {
+	tests := []struct {
+		name string
+		stat DiffStat
+		want string
+	}{
+		{name: "empty diff", stat: DiffStat{}, want: "XS"},
+		{name: "tiny", stat: DiffStat{LinesAdded: 5, LinesRemoved: 4}, want: "XS"},
+		{name: "small", stat: DiffStat{LinesAdded: 10}, want: "S"},
+		{name: "medium", stat: DiffStat{LinesAdded: 30, LinesRemoved: 20}, want: "M"},
+		{name: "large", stat: DiffStat{LinesAdded: 250}, want: "L"},
+		{name: "extra large", stat: DiffStat{LinesAdded: 600, LinesRemoved: 400}, want: "XL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.stat.SizeClass())
+		})
+	}
+}
+
+func TestPrintSize(t *testing.T) {
+	tests := []struct {
+		name       string
+		stat       DiffStat
+		threshold  int
+		wantOut    string
+		wantErrOut string
+	}{
+		{
+			name:    "no threshold",
+			stat:    DiffStat{FilesChanged: 3, LinesAdded: 100, LinesRemoved: 42},
+			wantOut: "Size: M (142 lines changed across 3 files)\n",
+		},
+		{
+			name:      "under threshold",
+			stat:      DiffStat{FilesChanged: 1, LinesAdded: 8},
+			threshold: 100,
+			wantOut:   "Size: XS (8 lines changed across 1 file)\n",
+		},
+		{
+			name:       "over threshold",
+			stat:       DiffStat{FilesChanged: 20, LinesAdded: 900, LinesRemoved: 300},
+			threshold:  400,
+			wantOut:    "Size: XL (1200 lines changed across 20 files)\n",
+			wantErrOut: "! This pull request changes 1200 lines, above the configured threshold of 400. Consider splitting it into smaller pull requests.\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			PrintSize(ios, &tt.stat, tt.threshold)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			assert.Equal(t, tt.wantErrOut, stderr.String())
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package retarget
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	prshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	stackshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RetargetOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Branch string
+	DryRun bool
+}
+
+func NewCmdRetarget(f *cmdutil.Factory, runF func(*RetargetOptions) error) *cobra.Command {
+	opts := &RetargetOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "retarget [<branch>]",
+		Short: "Retarget stack entries after a parent merges",
+		Long: heredoc.Doc(`
+			Find entries in the stack whose destination branch belongs to a merged
+			pull request and point them at that pull request's destination instead.
+
+			Run this after merging the bottom of a stack so the next pull request
+			targets the mainline rather than the now-merged branch.
+		`),
+		Example: heredoc.Doc(`
+			$ bb pr stack retarget
+
+			# Preview without changing anything
+			$ bb pr stack retarget --dry-run
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Branch = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return retargetRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be retargeted without updating anything")
+
+	return cmd
+}
+
+func retargetRun(opts *RetargetOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = opts.GitClient.CurrentBranch(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not determine current branch: %w", err)
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	stack, err := stackshared.BuildStack(httpClient, repo, branch)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	mergedBySource, err := fetchMergedBySource(httpClient, repo)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	retargeted := 0
+	for _, pr := range stack.Entries {
+		merged, ok := mergedBySource[pr.BaseBranch()]
+		if !ok {
+			continue
+		}
+
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.Out, "Would retarget #%d from %s to %s\n", pr.ID, pr.BaseBranch(), merged.BaseBranch())
+			retargeted++
+			continue
+		}
+
+		if err := updateDestination(httpClient, repo, pr.ID, merged.BaseBranch()); err != nil {
+			return fmt.Errorf("failed to retarget pull request #%d: %w", pr.ID, err)
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Retargeted #%d from %s to %s\n", cs.SuccessIcon(), pr.ID, pr.BaseBranch(), merged.BaseBranch())
+		retargeted++
+	}
+
+	if retargeted == 0 {
+		fmt.Fprintln(opts.IO.Out, "No stack entries need retargeting")
+	}
+
+	return nil
+}
+
+// fetchMergedBySource maps source branch names of recently merged pull
+// requests to their pull request, so a stack entry targeting a merged branch
+// can follow it to its destination.
+func fetchMergedBySource(client *http.Client, repo bbrepo.Interface) (map[string]*prshared.PullRequest, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests?state=MERGED&pagelen=50",
+		repo.RepoWorkspace(), repo.RepoSlug())
+
+	var page prshared.PullRequestList
+	err := apiClient.Get(repo.RepoHost(), path, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	bySource := make(map[string]*prshared.PullRequest, len(page.Values))
+	for i := range page.Values {
+		bySource[page.Values[i].HeadBranch()] = &page.Values[i]
+	}
+	return bySource, nil
+}
+
+type prDestinationPayload struct {
+	Destination branchRef `json:"destination"`
+}
+
+type branchRef struct {
+	Branch branchName `json:"branch"`
+}
+
+type branchName struct {
+	Name string `json:"name"`
+}
+
+func updateDestination(client *http.Client, repo bbrepo.Interface, prID int, destination string) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), prID)
+
+	payload := prDestinationPayload{
+		Destination: branchRef{Branch: branchName{Name: destination}},
+	}
+
+	return apiClient.Put(repo.RepoHost(), path, payload, nil)
+}
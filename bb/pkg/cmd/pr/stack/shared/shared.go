@@ -0,0 +1,120 @@
+package shared
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	prshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+)
+
+// Stack is a chain of open pull requests where each entry's destination is the
+// source branch of the entry below it. The bottom entry targets a branch with
+// no open pull request of its own, typically the mainline.
+type Stack struct {
+	// Entries are ordered from the bottom of the stack to the top.
+	Entries []*prshared.PullRequest
+}
+
+// BaseBranch is the branch the bottom of the stack targets.
+func (s *Stack) BaseBranch() string {
+	if len(s.Entries) == 0 {
+		return ""
+	}
+	return s.Entries[0].BaseBranch()
+}
+
+// IndexOfBranch returns the position of the entry whose source branch is
+// branch, or -1.
+func (s *Stack) IndexOfBranch(branch string) int {
+	for i, pr := range s.Entries {
+		if pr.HeadBranch() == branch {
+			return i
+		}
+	}
+	return -1
+}
+
+// BuildStack finds the stack containing the pull request whose source branch
+// is branch: it walks down destination branches to the bottom, then collects
+// the chain of dependent pull requests back up. Pull requests that share a
+// destination are included depth-first.
+func BuildStack(client *http.Client, repo bbrepo.Interface, branch string) (*Stack, error) {
+	prs, err := FetchOpenPullRequests(client, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	bySource := make(map[string]*prshared.PullRequest, len(prs))
+	for i := range prs {
+		bySource[prs[i].HeadBranch()] = &prs[i]
+	}
+
+	start, ok := bySource[branch]
+	if !ok {
+		return nil, fmt.Errorf("no open pull request found for branch %s", branch)
+	}
+
+	// Walk down to the bottom of the stack
+	bottom := start
+	seen := map[int]bool{bottom.ID: true}
+	for {
+		parent, ok := bySource[bottom.BaseBranch()]
+		if !ok || seen[parent.ID] {
+			break
+		}
+		seen[parent.ID] = true
+		bottom = parent
+	}
+
+	// Collect the chain back up, depth-first
+	stack := &Stack{}
+	var walk func(pr *prshared.PullRequest)
+	visited := map[int]bool{}
+	walk = func(pr *prshared.PullRequest) {
+		if visited[pr.ID] {
+			return
+		}
+		visited[pr.ID] = true
+		stack.Entries = append(stack.Entries, pr)
+		for i := range prs {
+			if prs[i].BaseBranch() == pr.HeadBranch() {
+				walk(&prs[i])
+			}
+		}
+	}
+	walk(bottom)
+
+	return stack, nil
+}
+
+// FetchOpenPullRequests fetches every open pull request of the repository.
+func FetchOpenPullRequests(client *http.Client, repo bbrepo.Interface) ([]prshared.PullRequest, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var prs []prshared.PullRequest
+	requestPath := fmt.Sprintf("repositories/%s/%s/pullrequests?state=OPEN&pagelen=50",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	nextURL := ""
+	for {
+		var page prshared.PullRequestList
+		var err error
+		if nextURL == "" {
+			nextURL, err = apiClient.RESTWithNext(repo.RepoHost(), http.MethodGet, requestPath, nil, &page)
+		} else {
+			nextURL, err = apiClient.RESTWithNextURL(http.MethodGet, nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		prs = append(prs, page.Values...)
+
+		if nextURL == "" {
+			break
+		}
+	}
+
+	return prs, nil
+}
@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildStack(t *testing.T) {
+	// Three stacked PRs on top of main, plus an unrelated PR
+	openPRs := `{"values": [
+		{"id": 3, "title": "Part 3", "source": {"branch": {"name": "part-3"}}, "destination": {"branch": {"name": "part-2"}}},
+		{"id": 1, "title": "Part 1", "source": {"branch": {"name": "part-1"}}, "destination": {"branch": {"name": "main"}}},
+		{"id": 9, "title": "Unrelated", "source": {"branch": {"name": "other"}}, "destination": {"branch": {"name": "main"}}},
+		{"id": 2, "title": "Part 2", "source": {"branch": {"name": "part-2"}}, "destination": {"branch": {"name": "part-1"}}}
+	]}`
+
+	tests := []struct {
+		name       string
+		branch     string
+		wantIDs    []int
+		wantBase   string
+		wantErrMsg string
+	}{
+		{
+			name:     "from the middle of the stack",
+			branch:   "part-2",
+			wantIDs:  []int{1, 2, 3},
+			wantBase: "main",
+		},
+		{
+			name:     "from the top of the stack",
+			branch:   "part-3",
+			wantIDs:  []int{1, 2, 3},
+			wantBase: "main",
+		},
+		{
+			name:     "single-entry stack",
+			branch:   "other",
+			wantIDs:  []int{9},
+			wantBase: "main",
+		},
+		{
+			name:       "no pull request for branch",
+			branch:     "missing",
+			wantErrMsg: "no open pull request found for branch missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", "2.0/repositories/acme/widgets/pullrequests"),
+				httpmock.StringResponse(openPRs),
+			)
+
+			repo := bbrepo.New("acme", "widgets")
+			stack, err := BuildStack(&http.Client{Transport: reg}, repo, tt.branch)
+
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []int
+			for _, pr := range stack.Entries {
+				ids = append(ids, pr.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantBase, stack.BaseBranch())
+		})
+	}
+}
+
+func TestStackIndexOfBranch(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "2.0/repositories/acme/widgets/pullrequests"),
+		httpmock.StringResponse(`{"values": [
+			{"id": 1, "source": {"branch": {"name": "part-1"}}, "destination": {"branch": {"name": "main"}}},
+			{"id": 2, "source": {"branch": {"name": "part-2"}}, "destination": {"branch": {"name": "part-1"}}}
+		]}`),
+	)
+
+	stack, err := BuildStack(&http.Client{Transport: reg}, bbrepo.New("acme", "widgets"), "part-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, stack.IndexOfBranch("part-1"))
+	assert.Equal(t, 1, stack.IndexOfBranch("part-2"))
+	assert.Equal(t, -1, stack.IndexOfBranch("main"))
+}
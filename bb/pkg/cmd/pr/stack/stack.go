@@ -0,0 +1,41 @@
+package stack
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	retargetCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack/retarget"
+	syncCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack/sync"
+	viewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack/view"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdStack(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stack <command>",
+		Short: "Work with stacked pull requests",
+		Long: heredoc.Doc(`
+			Work with stacked pull requests, where each pull request targets the
+			source branch of the one below it instead of the mainline.
+
+			A stack is discovered from the open pull requests of the repository:
+			starting from a branch, destinations are followed down to the bottom
+			and dependent pull requests are collected back up.
+		`),
+		Example: heredoc.Doc(`
+			# Show the stack containing the current branch
+			$ bb pr stack view
+
+			# Retarget entries after the bottom of the stack merged
+			$ bb pr stack retarget
+
+			# Add navigation links to each description in the stack
+			$ bb pr stack sync
+		`),
+	}
+
+	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
+	cmd.AddCommand(retargetCmd.NewCmdRetarget(f, nil))
+	cmd.AddCommand(syncCmd.NewCmdSync(f, nil))
+
+	return cmd
+}
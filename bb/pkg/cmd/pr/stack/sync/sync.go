@@ -0,0 +1,164 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	prshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	stackshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const (
+	stackSectionStart = "<!-- bb-stack-start -->"
+	stackSectionEnd   = "<!-- bb-stack-end -->"
+)
+
+type SyncOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Branch string
+}
+
+func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
+	opts := &SyncOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync [<branch>]",
+		Short: "Update stack navigation links in pull request descriptions",
+		Long: heredoc.Doc(`
+			Append a navigation section listing every pull request in the stack to
+			each entry's description, marking that entry's position.
+
+			The section is delimited by HTML comment markers, so running sync again
+			replaces it rather than adding another copy.
+		`),
+		Example: heredoc.Doc(`
+			$ bb pr stack sync
+			$ bb pr stack sync feature-part-2
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Branch = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return syncRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = opts.GitClient.CurrentBranch(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not determine current branch: %w", err)
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	stack, err := stackshared.BuildStack(httpClient, repo, branch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(stack.Entries) < 2 {
+		fmt.Fprintln(opts.IO.Out, "Stack has a single pull request; nothing to link")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	for i, pr := range stack.Entries {
+		description := replaceStackSection(pr.Description, stackSection(stack, i))
+		if description == pr.Description {
+			continue
+		}
+		if err := updateDescription(httpClient, repo, pr, description); err != nil {
+			return fmt.Errorf("failed to update pull request #%d: %w", pr.ID, err)
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Updated stack links in #%d\n", cs.SuccessIcon(), pr.ID)
+	}
+
+	return nil
+}
+
+// stackSection renders the navigation section for the entry at position.
+func stackSection(stack *stackshared.Stack, position int) string {
+	var sb strings.Builder
+	sb.WriteString(stackSectionStart)
+	sb.WriteString("\n---\n\n**Stack** (bottom to top):\n\n")
+	for i, pr := range stack.Entries {
+		marker := ""
+		if i == position {
+			marker = " ⬅ this PR"
+		}
+		fmt.Fprintf(&sb, "%d. [#%d %s](%s)%s\n", i+1, pr.ID, pr.Title, pr.HTMLURL(), marker)
+	}
+	sb.WriteString("\n")
+	sb.WriteString(stackSectionEnd)
+	return sb.String()
+}
+
+// replaceStackSection swaps the delimited navigation section in description
+// for section, appending it if no section exists yet.
+func replaceStackSection(description, section string) string {
+	start := strings.Index(description, stackSectionStart)
+	end := strings.Index(description, stackSectionEnd)
+	if start != -1 && end != -1 && end > start {
+		return description[:start] + section + description[end+len(stackSectionEnd):]
+	}
+
+	if strings.TrimSpace(description) == "" {
+		return section
+	}
+	return strings.TrimRight(description, "\n") + "\n\n" + section
+}
+
+type prDescriptionPayload struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+func updateDescription(client *http.Client, repo bbrepo.Interface, pr *prshared.PullRequest, description string) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests/%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), pr.ID)
+
+	// The update endpoint requires the title alongside the description
+	payload := prDescriptionPayload{Title: pr.Title, Description: description}
+	return apiClient.Put(repo.RepoHost(), path, payload, nil)
+}
@@ -0,0 +1,112 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	stackshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/stack/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Branch string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "view [<branch>]",
+		Short: "Visualize the stack containing a branch",
+		Long: heredoc.Doc(`
+			Display the stack of pull requests containing a branch, from the
+			bottom (which targets the mainline) to the top.
+
+			Without an argument, the stack of the current branch is shown.
+		`),
+		Example: heredoc.Doc(`
+			$ bb pr stack view
+			$ bb pr stack view feature-part-2
+		`),
+		Aliases: []string{"list", "ls"},
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Branch = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return viewRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func viewRun(opts *ViewOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	branch := opts.Branch
+	if branch == "" {
+		branch, err = opts.GitClient.CurrentBranch(context.Background())
+		if err != nil {
+			return fmt.Errorf("could not determine current branch: %w", err)
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	stack, err := stackshared.BuildStack(httpClient, repo, branch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "%s\n", cs.Muted(stack.BaseBranch()))
+	for i, pr := range stack.Entries {
+		marker := " "
+		if pr.HeadBranch() == branch {
+			marker = cs.Accent("*")
+		}
+		fmt.Fprintf(out, "%s%s #%d %s %s\n",
+			indent(i), marker, pr.ID, pr.Title, cs.Muted("("+pr.HeadBranch()+")"))
+	}
+
+	return nil
+}
+
+func indent(depth int) string {
+	prefix := ""
+	for i := 0; i < depth; i++ {
+		prefix += "  "
+	}
+	return prefix + "└─"
+}
@@ -0,0 +1,144 @@
+package subscribe
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SubscribeOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	SelectorArg string
+	Unsubscribe bool
+}
+
+func NewCmdSubscribe(f *cmdutil.Factory, runF func(*SubscribeOptions) error) *cobra.Command {
+	opts := &SubscribeOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "subscribe {<number> | <url>}",
+		Short: "Subscribe to notifications for a pull request",
+		Long: heredoc.Doc(`
+			Start receiving notifications for activity on a pull request.
+
+			This is the same as "watching" the pull request in the Bitbucket web UI.
+		`),
+		Example: heredoc.Doc(`
+			# Subscribe to pull request #123
+			$ bb pr subscribe 123
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot subscribe to pull request: number or url required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return subscribeRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func NewCmdUnsubscribe(f *cmdutil.Factory, runF func(*SubscribeOptions) error) *cobra.Command {
+	opts := &SubscribeOptions{
+		IO:          f.IOStreams,
+		HttpClient:  f.HttpClient,
+		BaseRepo:    f.BaseRepo,
+		Unsubscribe: true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unsubscribe {<number> | <url>}",
+		Short: "Unsubscribe from notifications for a pull request",
+		Long: heredoc.Doc(`
+			Stop receiving notifications for activity on a pull request.
+
+			This is the same as "unwatching" the pull request in the Bitbucket web UI.
+		`),
+		Example: heredoc.Doc(`
+			# Unsubscribe from pull request #123
+			$ bb pr unsubscribe 123
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot unsubscribe from pull request: number or url required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return subscribeRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func subscribeRun(opts *SubscribeOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	// Parse the PR argument first to check if it contains repo info
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	// Use the repo from URL if provided, otherwise resolve from git remotes
+	var repo bbrepo.Interface
+	if prRepo != nil {
+		repo = prRepo
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	if opts.Unsubscribe {
+		err = shared.UnwatchPullRequest(apiClient, repo, prID)
+	} else {
+		err = shared.WatchPullRequest(apiClient, repo, prID)
+	}
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("pull request #%d not found", prID)
+		}
+		if opts.Unsubscribe {
+			return fmt.Errorf("failed to unsubscribe from pull request: %w", err)
+		}
+		return fmt.Errorf("failed to subscribe to pull request: %w", err)
+	}
+
+	if opts.Unsubscribe {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Unsubscribed from pull request #%d\n", cs.SuccessIcon(), prID)
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Subscribed to pull request #%d\n", cs.SuccessIcon(), prID)
+	}
+
+	return nil
+}
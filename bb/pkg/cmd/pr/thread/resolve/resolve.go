@@ -0,0 +1,155 @@
+package resolve
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ResolveOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	SelectorArg string
+	CommentID   int
+	Unresolve   bool
+}
+
+func NewCmdResolve(f *cmdutil.Factory, runF func(*ResolveOptions) error) *cobra.Command {
+	opts := &ResolveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "resolve {<number> | <url>} <comment-id>",
+		Short: "Resolve an inline comment thread",
+		Long: heredoc.Doc(`
+			Mark an inline comment thread on a pull request as resolved.
+
+			Comment IDs are shown by "bb pr view --comments". Resolving the root
+			comment of a thread closes the whole conversation.
+		`),
+		Example: heredoc.Doc(`
+			# Resolve comment thread 456 on pull request #123
+			$ bb pr thread resolve 123 456
+		`),
+		Args: cmdutil.ExactArgs(2, "cannot resolve thread: pull request and comment ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+			commentID, err := strconv.Atoi(args[1])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid comment ID: %q", args[1])
+			}
+			opts.CommentID = commentID
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return resolveRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func NewCmdUnresolve(f *cmdutil.Factory, runF func(*ResolveOptions) error) *cobra.Command {
+	opts := &ResolveOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Unresolve:  true,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unresolve {<number> | <url>} <comment-id>",
+		Short: "Reopen a resolved inline comment thread",
+		Long: heredoc.Doc(`
+			Reopen a resolved inline comment thread on a pull request.
+		`),
+		Example: heredoc.Doc(`
+			# Reopen comment thread 456 on pull request #123
+			$ bb pr thread unresolve 123 456
+		`),
+		Args: cmdutil.ExactArgs(2, "cannot unresolve thread: pull request and comment ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SelectorArg = args[0]
+			commentID, err := strconv.Atoi(args[1])
+			if err != nil {
+				return cmdutil.FlagErrorf("invalid comment ID: %q", args[1])
+			}
+			opts.CommentID = commentID
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return resolveRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func resolveRun(opts *ResolveOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	// Parse the PR argument first to check if it contains repo info
+	prID, prRepo, err := shared.ParsePRArg(opts.SelectorArg)
+	if err != nil {
+		return err
+	}
+
+	// Use the repo from URL if provided, otherwise resolve from git remotes
+	var repo bbrepo.Interface
+	if prRepo != nil {
+		repo = prRepo
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	if opts.Unresolve {
+		err = shared.UnresolveComment(apiClient, repo, prID, opts.CommentID)
+	} else {
+		err = shared.ResolveComment(apiClient, repo, prID, opts.CommentID)
+	}
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("comment %d not found on pull request #%d", opts.CommentID, prID)
+		}
+		if opts.Unresolve {
+			return fmt.Errorf("failed to unresolve thread: %w", err)
+		}
+		return fmt.Errorf("failed to resolve thread: %w", err)
+	}
+
+	if opts.Unresolve {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Reopened thread %d on pull request #%d\n", cs.SuccessIcon(), opts.CommentID, prID)
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Resolved thread %d on pull request #%d\n", cs.SuccessIcon(), opts.CommentID, prID)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package thread
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	resolveCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/thread/resolve"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdThread(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "thread <command>",
+		Short: "Manage inline comment threads",
+		Long: heredoc.Doc(`
+			Resolve and reopen inline comment threads on a pull request.
+
+			Comment IDs are shown by "bb pr view --comments"; resolving the root
+			comment of a thread closes the whole conversation.
+		`),
+		Example: heredoc.Doc(`
+			# Resolve comment thread 456 on pull request #123
+			$ bb pr thread resolve 123 456
+
+			# Reopen it
+			$ bb pr thread unresolve 123 456
+		`),
+	}
+
+	cmd.AddCommand(resolveCmd.NewCmdResolve(f, nil))
+	cmd.AddCommand(resolveCmd.NewCmdUnresolve(f, nil))
+
+	return cmd
+}
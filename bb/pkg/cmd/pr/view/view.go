@@ -1,15 +1,21 @@
 package view
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/jira"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/checks"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -19,22 +25,42 @@ import (
 )
 
 type ViewOptions struct {
-	HttpClient func() (*http.Client, error)
-	IO         *iostreams.IOStreams
-	BaseRepo   func() (bbrepo.Interface, error)
-	Browser    browser.Browser
+	HttpClient      func() (*http.Client, error)
+	PlainHttpClient func() (*http.Client, error)
+	IO              *iostreams.IOStreams
+	BaseRepo        func() (bbrepo.Interface, error)
+	Browser         browser.Browser
+	GitClient       *git.Client
+	Config          func() (gh.Config, error)
 
 	SelectorArg string
 	Web         bool
+	NoBrowser   bool
 	Comments    bool
+	Since       string
+	Resolved    bool
+	Unresolved  bool
+	Jira        bool
+	ExitStatus  bool
+	Exporter    cmdutil.Exporter
+}
+
+// prViewFields are the fields available to pr view --json.
+var prViewFields = []string{
+	"id", "title", "state", "author", "branch", "baseBranch", "created",
+	"updated", "body", "url", "commentCount", "taskCount", "reviewers",
+	"participants",
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
 	opts := &ViewOptions{
-		IO:         f.IOStreams,
-		HttpClient: f.HttpClient,
-		BaseRepo:   f.BaseRepo,
-		Browser:    f.Browser,
+		IO:              f.IOStreams,
+		HttpClient:      f.HttpClient,
+		PlainHttpClient: f.PlainHttpClient,
+		BaseRepo:        f.BaseRepo,
+		Browser:         f.Browser,
+		GitClient:       f.GitClient,
+		Config:          f.Config,
 	}
 
 	cmd := &cobra.Command{
@@ -57,6 +83,21 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			# View pull request with comments
 			$ bb pr view 123 --comments
+
+			# Only show comments from the last two days
+			$ bb pr view 123 --comments --since 48h
+
+			# Only show unresolved inline comment threads
+			$ bb pr view 123 --comments --unresolved
+
+			# Check per-reviewer approval state from a script
+			$ bb pr view 123 --json participants
+
+			# Show the Jira issue linked from the title or source branch
+			$ bb pr view 123 --jira
+
+			# Gate a script on the pull request being open and healthy
+			$ bb pr view 123 --exit-status && deploy
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -64,6 +105,20 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				opts.SelectorArg = args[0]
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--resolved` or `--unresolved`",
+				opts.Resolved,
+				opts.Unresolved,
+			); err != nil {
+				return err
+			}
+			if !opts.Comments && (opts.Since != "" || opts.Resolved || opts.Unresolved) {
+				return cmdutil.FlagErrorf("--since, --resolved, and --unresolved require --comments")
+			}
+			if opts.ExitStatus && opts.Web {
+				return cmdutil.FlagErrorf("`--exit-status` cannot be used with `--web`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -72,7 +127,14 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open pull request in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View pull request comments")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only show comments newer than a `date` (RFC 3339, YYYY-MM-DD, or a duration like 36h)")
+	cmd.Flags().BoolVar(&opts.Resolved, "resolved", false, "Only show resolved inline comment threads")
+	cmd.Flags().BoolVar(&opts.Unresolved, "unresolved", false, "Only show unresolved inline comment threads")
+	cmd.Flags().BoolVar(&opts.Jira, "jira", false, "Show the linked Jira issue (requires the jira_* config keys)")
+	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with a non-zero status when the pull request is not open, checks are failing, or changes are requested")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, prViewFields)
 
 	return cmd
 }
@@ -108,10 +170,49 @@ func viewRun(opts *ViewOptions) error {
 	openURL := pr.HTMLURL()
 
 	if opts.Web {
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, openURL, opts.NoBrowser)
+	}
+
+	if opts.Exporter != nil {
+		if err := opts.Exporter.Write(opts.IO, pr); err != nil {
+			return err
+		}
+		if !opts.ExitStatus {
+			return nil
+		}
+		statuses, _ := checks.FetchBuildStatuses(httpClient, repo, pr.Source.Commit.Hash)
+		if failsExitStatus(pr, statuses) {
+			return cmdutil.SilentError
+		}
+		return nil
+	}
+
+	// Watch state is best-effort; don't fail the view if it can't be determined
+	watching := false
+	if watch, err := shared.IsWatchingPullRequest(api.NewClientFromHTTP(httpClient), repo, prID); err == nil {
+		watching = watch
+	}
+
+	// So is the diff size
+	var diffStat *shared.DiffStat
+	if stat, err := shared.FetchDiffStat(httpClient, repo, prID); err == nil {
+		diffStat = stat
+	}
+
+	// And the check runs
+	var buildStatuses []checks.BuildStatus
+	if statuses, err := checks.FetchBuildStatuses(httpClient, repo, pr.Source.Commit.Hash); err == nil {
+		buildStatuses = statuses
+	}
+	sizeThreshold := shared.SizeWarningThreshold(context.Background(), opts.GitClient)
+
+	// The linked Jira issue was explicitly requested, so a lookup failure is an error
+	var jiraIssue *jira.Issue
+	if opts.Jira {
+		jiraIssue, err = fetchJiraIssue(opts, pr)
+		if err != nil {
+			return err
 		}
-		return opts.Browser.Browse(openURL)
 	}
 
 	opts.IO.DetectTerminalTheme()
@@ -119,23 +220,178 @@ func viewRun(opts *ViewOptions) error {
 		defer opts.IO.StopPager()
 	}
 
-	if err := printPullRequest(opts.IO, pr); err != nil {
+	if err := printPullRequest(opts.IO, pr, watching, diffStat, sizeThreshold, buildStatuses); err != nil {
 		return err
 	}
 
+	if jiraIssue != nil {
+		printJiraIssue(opts.IO, jiraIssue)
+	}
+
 	// Fetch and display comments if requested
 	if opts.Comments && pr.CommentCount > 0 {
+		var since time.Time
+		if opts.Since != "" {
+			since, err = parseSince(time.Now(), opts.Since)
+			if err != nil {
+				return err
+			}
+		}
+
 		comments, err := list.FetchPullRequestComments(httpClient, repo, prID)
 		if err != nil {
 			return err
 		}
-		printComments(opts.IO, comments)
+
+		threads := buildThreads(filterSince(comments, since))
+		if opts.Resolved || opts.Unresolved {
+			threads = filterResolution(threads, opts.Resolved)
+		}
+		printThreads(opts.IO, threads)
+	}
+
+	if opts.ExitStatus && failsExitStatus(pr, buildStatuses) {
+		return cmdutil.SilentError
 	}
 
 	return nil
 }
 
-func printPullRequest(io *iostreams.IOStreams, pr *shared.PullRequest) error {
+// failsExitStatus reports whether --exit-status should exit non-zero: the
+// pull request is not open, a participant requested changes, or a check
+// failed.
+func failsExitStatus(pr *shared.PullRequest, statuses []checks.BuildStatus) bool {
+	if pr.State != "OPEN" {
+		return true
+	}
+	for _, p := range pr.Participants {
+		if p.State == "changes_requested" {
+			return true
+		}
+	}
+	for _, s := range statuses {
+		if s.State == "FAILED" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSince accepts an RFC 3339 timestamp, a plain date, or a duration
+// relative to now (e.g. "36h").
+func parseSince(now time.Time, value string) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, cmdutil.FlagErrorf("invalid value for --since: %q", value)
+}
+
+// commentThread is a comment with its direct replies, built from the flat
+// list via each comment's parent reference.
+type commentThread struct {
+	comment shared.Comment
+	replies []*commentThread
+}
+
+func filterSince(comments []shared.Comment, since time.Time) []shared.Comment {
+	if since.IsZero() {
+		return comments
+	}
+	var filtered []shared.Comment
+	for _, c := range comments {
+		if t, err := time.Parse(time.RFC3339, c.CreatedOn); err == nil && t.Before(since) {
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+	return filtered
+}
+
+// buildThreads nests replies under their parents. A reply whose parent was
+// deleted or filtered out is promoted to a root so it is still shown.
+func buildThreads(comments []shared.Comment) []*commentThread {
+	nodes := make(map[int]*commentThread, len(comments))
+	for _, c := range comments {
+		if c.Deleted {
+			continue
+		}
+		nodes[c.ID] = &commentThread{comment: c}
+	}
+
+	var roots []*commentThread
+	for _, c := range comments {
+		node, ok := nodes[c.ID]
+		if !ok {
+			continue
+		}
+		if c.Parent != nil {
+			if parent, ok := nodes[c.Parent.ID]; ok {
+				parent.replies = append(parent.replies, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
+
+// filterResolution keeps only inline threads whose resolution state matches.
+func filterResolution(threads []*commentThread, resolved bool) []*commentThread {
+	var filtered []*commentThread
+	for _, t := range threads {
+		if t.comment.Inline == nil {
+			continue
+		}
+		if (t.comment.Resolution != nil) == resolved {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+func fetchJiraIssue(opts *ViewOptions, pr *shared.PullRequest) (*jira.Issue, error) {
+	key := jira.FindIssueKey(pr.Title, pr.HeadBranch())
+	if key == "" {
+		return nil, fmt.Errorf("no Jira issue key found in the pull request title or source branch")
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, err
+	}
+	httpClient, err := opts.PlainHttpClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := jira.NewClientFromConfig(httpClient, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Issue(key)
+}
+
+func printJiraIssue(io *iostreams.IOStreams, issue *jira.Issue) {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintf(out, "%s %s %s\n", cs.Bold("Jira:"), cs.Accent(issue.Key), issue.Summary)
+	fmt.Fprintf(out, "%s %s\n", cs.Bold("Status:"), issue.Status)
+	if issue.Assignee != "" {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Assignee:"), issue.Assignee)
+	}
+	fmt.Fprintf(out, "%s\n", cs.Gray(issue.URL))
+	fmt.Fprintln(out)
+}
+
+func printPullRequest(io *iostreams.IOStreams, pr *shared.PullRequest, watching bool, diffStat *shared.DiffStat, sizeThreshold int, statuses []checks.BuildStatus) error {
 	cs := io.ColorScheme()
 	out := io.Out
 
@@ -156,8 +412,8 @@ func printPullRequest(io *iostreams.IOStreams, pr *shared.PullRequest) error {
 	fmt.Fprintf(out, "%s • %s wants to merge into %s from %s\n",
 		stateColor(pr.StateDisplay()),
 		pr.Author.DisplayName,
-		cs.Cyan(pr.BaseBranch()),
-		cs.Cyan(pr.HeadBranch()),
+		cs.Accent(pr.BaseBranch()),
+		cs.Accent(pr.HeadBranch()),
 	)
 	fmt.Fprintln(out)
 
@@ -194,6 +450,18 @@ func printPullRequest(io *iostreams.IOStreams, pr *shared.PullRequest) error {
 			cs.Bold("Tasks:"), pr.TaskCount)
 	}
 
+	if diffStat != nil {
+		shared.PrintSize(io, diffStat, sizeThreshold)
+	}
+
+	if len(statuses) > 0 {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Checks:"), summarizeChecks(cs, statuses))
+	}
+
+	if watching {
+		fmt.Fprintf(out, "%s yes\n", cs.Bold("Watching:"))
+	}
+
 	// URL
 	fmt.Fprintln(out)
 	fmt.Fprintf(out, "%s\n", cs.Gray(pr.HTMLURL()))
@@ -201,7 +469,40 @@ func printPullRequest(io *iostreams.IOStreams, pr *shared.PullRequest) error {
 	return nil
 }
 
-func printComments(io *iostreams.IOStreams, comments []shared.Comment) {
+// summarizeChecks renders a one-line summary of the check runs, worst news
+// first, e.g. "1 failing, 2 pending, 3 passing".
+func summarizeChecks(cs *iostreams.ColorScheme, statuses []checks.BuildStatus) string {
+	var passing, failing, pending, other int
+	for _, s := range statuses {
+		switch s.State {
+		case "SUCCESSFUL":
+			passing++
+		case "FAILED":
+			failing++
+		case "INPROGRESS":
+			pending++
+		default:
+			other++
+		}
+	}
+
+	var parts []string
+	if failing > 0 {
+		parts = append(parts, cs.Red(fmt.Sprintf("%d failing", failing)))
+	}
+	if pending > 0 {
+		parts = append(parts, cs.Yellow(fmt.Sprintf("%d pending", pending)))
+	}
+	if passing > 0 {
+		parts = append(parts, cs.Green(fmt.Sprintf("%d passing", passing)))
+	}
+	if other > 0 {
+		parts = append(parts, cs.Gray(fmt.Sprintf("%d stopped", other)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func printThreads(io *iostreams.IOStreams, threads []*commentThread) {
 	cs := io.ColorScheme()
 	out := io.Out
 
@@ -209,53 +510,65 @@ func printComments(io *iostreams.IOStreams, comments []shared.Comment) {
 	fmt.Fprintf(out, "%s\n", cs.Bold("── Comments ──"))
 	fmt.Fprintln(out)
 
-	commentCount := 0
-	for _, comment := range comments {
-		// Skip deleted comments
-		if comment.Deleted {
-			continue
-		}
+	if len(threads) == 0 {
+		fmt.Fprintf(out, "%s\n", cs.Gray("No comments to show"))
+		return
+	}
 
-		commentCount++
+	for _, thread := range threads {
+		printThread(io, thread, 0)
+	}
+}
 
-		// Parse and format the timestamp
-		timestamp := comment.CreatedOn
-		if t, err := time.Parse(time.RFC3339, comment.CreatedOn); err == nil {
-			timestamp = text.FuzzyAgo(time.Now(), t)
-		}
+func printThread(io *iostreams.IOStreams, thread *commentThread, depth int) {
+	cs := io.ColorScheme()
+	out := io.Out
+	comment := thread.comment
+	indent := strings.Repeat("  ", depth)
 
-		// Author and timestamp with optional inline location
-		if comment.Inline != nil {
-			// This is an inline/code review comment
-			location := comment.Inline.Path
-			if comment.Inline.To != nil {
-				location = fmt.Sprintf("%s:%d", comment.Inline.Path, *comment.Inline.To)
-			}
-			fmt.Fprintf(out, "%s %s %s\n",
-				cs.Bold(comment.User.DisplayName),
-				cs.Gray("commented "+timestamp),
-				cs.Cyan("on "+location))
-		} else {
-			fmt.Fprintf(out, "%s %s\n", cs.Bold(comment.User.DisplayName), cs.Gray("commented "+timestamp))
+	// Parse and format the timestamp
+	timestamp := comment.CreatedOn
+	if t, err := time.Parse(time.RFC3339, comment.CreatedOn); err == nil {
+		timestamp = text.FuzzyAgo(time.Now(), t)
+	}
+
+	// Author and timestamp with optional inline location
+	header := fmt.Sprintf("%s%s %s", indent, cs.Bold(comment.User.DisplayName), cs.Gray("commented "+timestamp))
+	if comment.Inline != nil {
+		// This is an inline/code review comment
+		location := comment.Inline.Path
+		if comment.Inline.To != nil {
+			location = fmt.Sprintf("%s:%d", comment.Inline.Path, *comment.Inline.To)
+		}
+		// The comment ID is what pr thread resolve/unresolve takes
+		header += " " + cs.Accent("on "+location) + " " + cs.Gray(fmt.Sprintf("(comment %d)", comment.ID))
+		if comment.Resolution != nil {
+			header += " " + cs.Green("[resolved]")
 		}
+	}
+	if n := len(comment.Likes); n > 0 {
+		header += " " + cs.Gray(fmt.Sprintf("[%s]", text.Pluralize(n, "like")))
+	}
+	fmt.Fprintln(out, header)
 
-		// Comment content
-		content := comment.Content.Raw
-		if content != "" {
-			if io.IsStdoutTTY() {
-				rendered, err := markdown.Render(content,
-					markdown.WithTheme(io.TerminalTheme()),
-					markdown.WithWrap(io.TerminalWidth()))
-				if err == nil {
-					content = rendered
-				}
+	// Comment content
+	content := comment.Content.Raw
+	if content != "" {
+		if io.IsStdoutTTY() {
+			rendered, err := markdown.Render(content,
+				markdown.WithTheme(io.TerminalTheme()),
+				markdown.WithWrap(io.TerminalWidth()-len(indent)))
+			if err == nil {
+				content = rendered
 			}
-			fmt.Fprintln(out, content)
 		}
-		fmt.Fprintln(out)
+		for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+			fmt.Fprintf(out, "%s%s\n", indent, line)
+		}
 	}
+	fmt.Fprintln(out)
 
-	if commentCount == 0 {
-		fmt.Fprintf(out, "%s\n", cs.Gray("No comments"))
+	for _, reply := range thread.replies {
+		printThread(io, reply, depth+1)
 	}
 }
@@ -0,0 +1,163 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/checks"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "duration",
+			value: "48h",
+			want:  time.Date(2024, 6, 13, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "RFC 3339 timestamp",
+			value: "2024-06-01T08:30:00Z",
+			want:  time.Date(2024, 6, 1, 8, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "plain date",
+			value: "2024-06-01",
+			want:  time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "invalid value",
+			value:   "yesterday",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSince(now, tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSince(%q) expected error, got %v", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSince(%q) returned error: %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSince(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func comment(id int, parent int, deleted bool) shared.Comment {
+	c := shared.Comment{ID: id, Deleted: deleted}
+	if parent != 0 {
+		c.Parent = &struct {
+			ID int `json:"id"`
+		}{ID: parent}
+	}
+	return c
+}
+
+func TestBuildThreads(t *testing.T) {
+	comments := []shared.Comment{
+		comment(1, 0, false),
+		comment(2, 1, false),
+		comment(3, 2, false),
+		comment(4, 0, true),
+		comment(5, 4, false), // parent deleted; promoted to root
+		comment(6, 0, false),
+	}
+
+	threads := buildThreads(comments)
+
+	if len(threads) != 3 {
+		t.Fatalf("expected 3 root threads, got %d", len(threads))
+	}
+	if threads[0].comment.ID != 1 || threads[1].comment.ID != 5 || threads[2].comment.ID != 6 {
+		t.Errorf("unexpected root order: %d, %d, %d",
+			threads[0].comment.ID, threads[1].comment.ID, threads[2].comment.ID)
+	}
+	if len(threads[0].replies) != 1 || threads[0].replies[0].comment.ID != 2 {
+		t.Fatalf("expected comment 2 as reply to comment 1, got %+v", threads[0].replies)
+	}
+	if len(threads[0].replies[0].replies) != 1 || threads[0].replies[0].replies[0].comment.ID != 3 {
+		t.Errorf("expected comment 3 nested under comment 2")
+	}
+}
+
+func TestFilterResolution(t *testing.T) {
+	inline := &struct {
+		Path string `json:"path"`
+		From *int   `json:"from"`
+		To   *int   `json:"to"`
+	}{Path: "main.go"}
+	resolution := &struct {
+		Type string `json:"type"`
+	}{Type: "comment_resolution"}
+
+	threads := []*commentThread{
+		{comment: shared.Comment{ID: 1}},
+		{comment: shared.Comment{ID: 2, Inline: inline}},
+		{comment: shared.Comment{ID: 3, Inline: inline, Resolution: resolution}},
+	}
+
+	resolved := filterResolution(threads, true)
+	if len(resolved) != 1 || resolved[0].comment.ID != 3 {
+		t.Errorf("expected only resolved inline thread 3, got %+v", resolved)
+	}
+
+	unresolved := filterResolution(threads, false)
+	if len(unresolved) != 1 || unresolved[0].comment.ID != 2 {
+		t.Errorf("expected only unresolved inline thread 2, got %+v", unresolved)
+	}
+}
+
+func TestFilterSince(t *testing.T) {
+	comments := []shared.Comment{
+		{ID: 1, CreatedOn: "2024-06-01T00:00:00Z"},
+		{ID: 2, CreatedOn: "2024-06-10T00:00:00Z"},
+		{ID: 3, CreatedOn: "not a timestamp"}, // unparseable dates are kept
+	}
+
+	filtered := filterSince(comments, time.Date(2024, 6, 5, 0, 0, 0, 0, time.UTC))
+	if len(filtered) != 2 || filtered[0].ID != 2 || filtered[1].ID != 3 {
+		t.Errorf("unexpected filtered comments: %+v", filtered)
+	}
+
+	all := filterSince(comments, time.Time{})
+	if len(all) != 3 {
+		t.Errorf("zero since should keep all comments, got %d", len(all))
+	}
+}
+
+func TestFailsExitStatus(t *testing.T) {
+	open := &shared.PullRequest{State: "OPEN"}
+
+	if failsExitStatus(open, nil) {
+		t.Error("open PR with no checks should pass")
+	}
+	if !failsExitStatus(&shared.PullRequest{State: "MERGED"}, nil) {
+		t.Error("merged PR should fail")
+	}
+	if !failsExitStatus(open, []checks.BuildStatus{{State: "FAILED"}}) {
+		t.Error("failing check should fail")
+	}
+	if failsExitStatus(open, []checks.BuildStatus{{State: "SUCCESSFUL"}, {State: "INPROGRESS"}}) {
+		t.Error("pending checks alone should pass")
+	}
+
+	changes := &shared.PullRequest{State: "OPEN", Participants: []shared.Participant{{State: "changes_requested"}}}
+	if !failsExitStatus(changes, nil) {
+		t.Error("requested changes should fail")
+	}
+}
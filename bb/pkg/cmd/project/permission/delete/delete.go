@@ -0,0 +1,156 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (bbrepo.Interface, error)
+	Prompter   prompter.Prompter
+
+	ProjectKey string
+	Workspace  string
+	User       string
+	Group      string
+	Confirm    bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <project-key>",
+		Short: "Revoke a default permission on a project",
+		Long: heredoc.Doc(`
+			Revoke the default permission a user or group holds on a Bitbucket project.
+
+			The user or group keeps any permissions granted directly on individual
+			repositories. Exactly one of --user or --group must be given.
+		`),
+		Example: heredoc.Doc(`
+			$ bb project permission delete PROJ --group developers
+			$ bb project permission delete PROJ --user {user-uuid} --yes
+		`),
+		Aliases: []string{"remove", "rm"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectKey = args[0]
+
+			if err := cmdutil.MutuallyExclusive(
+				"exactly one of --user or --group must be given",
+				opts.User != "",
+				opts.Group != "",
+			); err != nil {
+				return err
+			}
+			if opts.User == "" && opts.Group == "" {
+				return cmdutil.FlagErrorf("exactly one of --user or --group must be given")
+			}
+
+			// If workspace not specified, try to get it from the current repo
+			if opts.Workspace == "" {
+				repo, err := opts.BaseRepo()
+				if err != nil {
+					return cmdutil.FlagErrorf("--workspace is required when not in a repository")
+				}
+				opts.Workspace = repo.RepoWorkspace()
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace the project belongs to")
+	cmd.Flags().StringVarP(&opts.User, "user", "u", "", "Revoke the permission of a user, by UUID or account ID")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Revoke the permission of a group, by slug")
+	cmd.Flags().BoolVarP(&opts.Confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("user %s", opts.User)
+	if opts.Group != "" {
+		subject = fmt.Sprintf("group %s", opts.Group)
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	// Confirm deletion
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
+		msg := fmt.Sprintf("Are you sure you want to revoke the permission of %s on project %s?",
+			subject, opts.ProjectKey)
+		confirmed, err := opts.Prompter.Confirm(msg, false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = deletePermission(httpClient, opts)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Revoked the permission of %s on project %s\n",
+			cs.SuccessIcon(), subject, cs.Bold(opts.ProjectKey))
+	}
+
+	return nil
+}
+
+func deletePermission(client *http.Client, opts *DeleteOptions) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var path string
+	if opts.Group != "" {
+		path = fmt.Sprintf("workspaces/%s/projects/%s/permissions-config/groups/%s",
+			opts.Workspace, opts.ProjectKey, opts.Group)
+	} else {
+		path = fmt.Sprintf("workspaces/%s/projects/%s/permissions-config/users/%s",
+			opts.Workspace, opts.ProjectKey, opts.User)
+	}
+
+	return apiClient.Delete("bitbucket.org", path)
+}
@@ -0,0 +1,134 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	ProjectKey string
+	Workspace  string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <project-key>",
+		Short: "List default permissions for a project",
+		Long: heredoc.Doc(`
+			List the default user and group permissions for a Bitbucket project.
+
+			These permissions cascade to every repository in the project.
+		`),
+		Example: heredoc.Doc(`
+			$ bb project permission list PROJ --workspace myworkspace
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectKey = args[0]
+
+			// If workspace not specified, try to get it from the current repo
+			if opts.Workspace == "" {
+				repo, err := opts.BaseRepo()
+				if err != nil {
+					return cmdutil.FlagErrorf("--workspace is required when not in a repository")
+				}
+				opts.Workspace = repo.RepoWorkspace()
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace the project belongs to")
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	users, groups, err := fetchPermissions(httpClient, opts.Workspace, opts.ProjectKey)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if len(users) == 0 && len(groups) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No default permissions set for project %s\n", opts.ProjectKey)
+		return nil
+	}
+
+	return printPermissions(opts.IO, users, groups)
+}
+
+func fetchPermissions(client *http.Client, workspace, projectKey string) ([]shared.UserPermission, []shared.GroupPermission, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	userPath := fmt.Sprintf("workspaces/%s/projects/%s/permissions-config/users?pagelen=100",
+		workspace, projectKey)
+
+	var users shared.UserPermissionList
+	if err := apiClient.Get("bitbucket.org", userPath, &users); err != nil {
+		return nil, nil, err
+	}
+
+	groupPath := fmt.Sprintf("workspaces/%s/projects/%s/permissions-config/groups?pagelen=100",
+		workspace, projectKey)
+
+	var groups shared.GroupPermissionList
+	if err := apiClient.Get("bitbucket.org", groupPath, &groups); err != nil {
+		return nil, nil, err
+	}
+
+	return users.Values, groups.Values, nil
+}
+
+func printPermissions(io *iostreams.IOStreams, users []shared.UserPermission, groups []shared.GroupPermission) error {
+	tp := tableprinter.New(io, tableprinter.WithHeader("TYPE", "NAME", "ID", "PERMISSION"))
+
+	for _, g := range groups {
+		tp.AddField("group")
+		tp.AddField(g.Group.Name)
+		tp.AddField(g.Group.Slug)
+		tp.AddField(g.Permission)
+		tp.EndRow()
+	}
+
+	for _, u := range users {
+		tp.AddField("user")
+		tp.AddField(u.User.DisplayName)
+		tp.AddField(u.User.UUID)
+		tp.AddField(u.Permission)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,34 @@
+package permission
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/permission/delete"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/permission/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/permission/set"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdPermission(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permission <command>",
+		Short: "Manage default permissions for a project",
+		Long: heredoc.Doc(`
+			Manage the default permissions granted on a Bitbucket project.
+
+			Project permissions cascade to every repository in the project unless a
+			repository grants a higher permission of its own.
+		`),
+		Example: heredoc.Doc(`
+			$ bb project permission list PROJ --workspace myworkspace
+			$ bb project permission set PROJ write --group developers
+			$ bb project permission delete PROJ --user {user-uuid}
+		`),
+	}
+
+	cmd.AddCommand(list.NewCmdList(f, nil))
+	cmd.AddCommand(set.NewCmdSet(f, nil))
+	cmd.AddCommand(delete.NewCmdDelete(f, nil))
+
+	return cmd
+}
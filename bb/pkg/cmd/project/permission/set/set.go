@@ -0,0 +1,143 @@
+package set
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type SetOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	ProjectKey string
+	Workspace  string
+	Permission string
+	User       string
+	Group      string
+}
+
+func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command {
+	opts := &SetOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set <project-key> <permission>",
+		Short: "Grant a default permission on a project",
+		Long: heredoc.Doc(`
+			Grant a default permission on a Bitbucket project to a user or group.
+
+			The permission cascades to every repository in the project. Valid
+			permissions are read, write, create-repo, and admin, and exactly one
+			of --user or --group must be given. Users are identified by their
+			UUID (including braces) or account ID; groups by their slug.
+		`),
+		Example: heredoc.Doc(`
+			# Give a group write access to every repository in a project
+			$ bb project permission set PROJ write --group developers
+
+			# Make a user an admin of a project
+			$ bb project permission set PROJ admin --user {user-uuid} --workspace myworkspace
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectKey = args[0]
+			opts.Permission = strings.ToLower(args[1])
+
+			if !slices.Contains(shared.ProjectPermissions, opts.Permission) {
+				return cmdutil.FlagErrorf("invalid permission %q: valid values are %s",
+					args[1], strings.Join(shared.ProjectPermissions, ", "))
+			}
+
+			if err := cmdutil.MutuallyExclusive(
+				"exactly one of --user or --group must be given",
+				opts.User != "",
+				opts.Group != "",
+			); err != nil {
+				return err
+			}
+			if opts.User == "" && opts.Group == "" {
+				return cmdutil.FlagErrorf("exactly one of --user or --group must be given")
+			}
+
+			// If workspace not specified, try to get it from the current repo
+			if opts.Workspace == "" {
+				repo, err := opts.BaseRepo()
+				if err != nil {
+					return cmdutil.FlagErrorf("--workspace is required when not in a repository")
+				}
+				opts.Workspace = repo.RepoWorkspace()
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return setRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace the project belongs to")
+	cmd.Flags().StringVarP(&opts.User, "user", "u", "", "Grant the permission to a user, by UUID or account ID")
+	cmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Grant the permission to a group, by slug")
+
+	return cmd
+}
+
+func setRun(opts *SetOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = setPermission(httpClient, opts)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		subject := fmt.Sprintf("user %s", opts.User)
+		if opts.Group != "" {
+			subject = fmt.Sprintf("group %s", opts.Group)
+		}
+		fmt.Fprintf(opts.IO.Out, "%s Granted %s %s on project %s\n",
+			cs.SuccessIcon(), subject, cs.Bold(opts.Permission), cs.Bold(opts.ProjectKey))
+	}
+
+	return nil
+}
+
+type permissionPayload struct {
+	Permission string `json:"permission"`
+}
+
+func setPermission(client *http.Client, opts *SetOptions) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var path string
+	if opts.Group != "" {
+		path = fmt.Sprintf("workspaces/%s/projects/%s/permissions-config/groups/%s",
+			opts.Workspace, opts.ProjectKey, opts.Group)
+	} else {
+		path = fmt.Sprintf("workspaces/%s/projects/%s/permissions-config/users/%s",
+			opts.Workspace, opts.ProjectKey, opts.User)
+	}
+
+	return apiClient.Put("bitbucket.org", path, permissionPayload{Permission: opts.Permission}, nil)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/create"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/permission"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/view"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -29,6 +30,7 @@ func NewCmdProject(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(list.NewCmdList(f, nil))
 	cmd.AddCommand(view.NewCmdView(f, nil))
 	cmd.AddCommand(create.NewCmdCreate(f, nil))
+	cmd.AddCommand(permission.NewCmdPermission(f))
 
 	return cmd
 }
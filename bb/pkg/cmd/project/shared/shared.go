@@ -48,3 +48,38 @@ type ProjectList struct {
 func (p *Project) HTMLURL() string {
 	return p.Links.HTML.Href
 }
+
+// UserPermission represents a default project permission granted to a user
+type UserPermission struct {
+	User       Owner  `json:"user"`
+	Permission string `json:"permission"`
+}
+
+// GroupPermission represents a default project permission granted to a group
+type GroupPermission struct {
+	Group      Group  `json:"group"`
+	Permission string `json:"permission"`
+}
+
+type Group struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// UserPermissionList represents a paginated list of user permissions
+type UserPermissionList struct {
+	Size   int              `json:"size"`
+	Next   string           `json:"next"`
+	Values []UserPermission `json:"values"`
+}
+
+// GroupPermissionList represents a paginated list of group permissions
+type GroupPermissionList struct {
+	Size   int               `json:"size"`
+	Next   string            `json:"next"`
+	Values []GroupPermission `json:"values"`
+}
+
+// ProjectPermissions are the permission levels accepted by the
+// permissions-config endpoints, lowest to highest.
+var ProjectPermissions = []string{"read", "write", "create-repo", "admin"}
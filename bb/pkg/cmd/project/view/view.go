@@ -8,7 +8,6 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
-	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -24,6 +23,7 @@ type ViewOptions struct {
 	ProjectKey string
 	Workspace  string
 	Web        bool
+	NoBrowser  bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -68,6 +68,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace the project belongs to")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "", false, "Open project in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
 
 	return cmd
 }
@@ -89,10 +90,7 @@ func viewRun(opts *ViewOptions) error {
 	openURL := project.HTMLURL()
 
 	if opts.Web {
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
-		}
-		return opts.Browser.Browse(openURL)
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, openURL, opts.NoBrowser)
 	}
 
 	return printProject(opts.IO, project)
@@ -0,0 +1,347 @@
+package promptstatus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/list"
+	prshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// cacheTTL is how long a cache entry satisfies the fast path before a
+// background refresh is spawned. The stale entry is still printed, so the
+// prompt never waits on the network.
+const cacheTTL = 60 * time.Second
+
+type PromptStatusOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	GitClient  *git.Client
+
+	Refresh bool
+}
+
+// cacheEntry is the on-disk snapshot of a branch's pull request state.
+type cacheEntry struct {
+	NoPR             bool      `json:"no_pr,omitempty"`
+	PRID             int       `json:"pr_id,omitempty"`
+	State            string    `json:"state,omitempty"`
+	ChecksPassed     int       `json:"checks_passed,omitempty"`
+	ChecksFailed     int       `json:"checks_failed,omitempty"`
+	ChecksPending    int       `json:"checks_pending,omitempty"`
+	Approvals        int       `json:"approvals,omitempty"`
+	ChangesRequested int       `json:"changes_requested,omitempty"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+func NewCmdPromptStatus(f *cmdutil.Factory, runF func(*PromptStatusOptions) error) *cobra.Command {
+	opts := &PromptStatusOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		GitClient:  f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "prompt-status",
+		Short: "Print a compact pull request status string for shell prompts",
+		Long: heredoc.Doc(`
+			Print the current branch's open pull request, its check state, and its
+			review state as a single compact string suitable for embedding in a
+			shell prompt.
+
+			The command only ever reads an on-disk cache, so it returns well within
+			a prompt rendering budget; when the cache is stale, a background
+			refresh is spawned and the stale value is printed. Outside a Bitbucket
+			repository, or before the first refresh completes, nothing is printed
+			and the exit status is 0.
+		`),
+		Example: heredoc.Doc(`
+			# In a bash PS1 or zsh precmd
+			$ bb prompt-status
+
+			# Refresh the cache synchronously (done automatically in the background)
+			$ bb prompt-status --refresh
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			if opts.Refresh {
+				return refreshRun(opts)
+			}
+			return promptStatusRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Refresh, "refresh", false, "Fetch from the API and update the cache instead of reading it")
+
+	// the fast path must not pay for a token check; --refresh fails naturally
+	// when unauthenticated
+	cmdutil.DisableAuthCheck(cmd)
+
+	return cmd
+}
+
+func promptStatusRun(opts *PromptStatusOptions) error {
+	// A prompt helper must never break the prompt: any unusual state prints
+	// nothing and exits 0
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return nil
+	}
+	branch, err := opts.GitClient.CurrentBranch(context.Background())
+	if err != nil {
+		return nil
+	}
+
+	entry, err := readCache(cachePath(repo, branch))
+	stale := err != nil || time.Since(entry.UpdatedAt) > cacheTTL
+	if stale {
+		spawnRefresh()
+	}
+	if err != nil || entry.NoPR {
+		return nil
+	}
+
+	fmt.Fprintln(opts.IO.Out, statusString(opts.IO.ColorScheme(), entry))
+	return nil
+}
+
+func statusString(cs *iostreams.ColorScheme, entry *cacheEntry) string {
+	var parts []string
+
+	id := fmt.Sprintf("#%d", entry.PRID)
+	if entry.State != "OPEN" {
+		id += "(" + strings.ToLower(entry.State) + ")"
+	}
+	parts = append(parts, id)
+
+	if entry.ChecksPassed > 0 {
+		parts = append(parts, cs.Green(fmt.Sprintf("%s%d", cs.WordedIcon("✓", "passed:"), entry.ChecksPassed)))
+	}
+	if entry.ChecksFailed > 0 {
+		parts = append(parts, cs.Red(fmt.Sprintf("%s%d", cs.WordedIcon("✗", "failed:"), entry.ChecksFailed)))
+	}
+	if entry.ChecksPending > 0 {
+		parts = append(parts, cs.Yellow(fmt.Sprintf("%s%d", cs.WordedIcon("●", "pending:"), entry.ChecksPending)))
+	}
+	if entry.Approvals > 0 {
+		parts = append(parts, cs.Green(fmt.Sprintf("+%d", entry.Approvals)))
+	}
+	if entry.ChangesRequested > 0 {
+		parts = append(parts, cs.Red(fmt.Sprintf("-%d", entry.ChangesRequested)))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// spawnRefresh starts a detached `bb prompt-status --refresh` so the next
+// prompt sees fresh data. Failures are ignored; the prompt shows stale or no
+// data until a refresh succeeds.
+func spawnRefresh() {
+	exe, err := os.Executable()
+	if err != nil {
+		return
+	}
+	cmd := exec.Command(exe, "prompt-status", "--refresh")
+	cmd.Stdin = nil
+	cmd.Stdout = nil
+	cmd.Stderr = nil
+	if err := cmd.Start(); err == nil {
+		// Let the refresh outlive this process
+		_ = cmd.Process.Release()
+	}
+}
+
+func refreshRun(opts *PromptStatusOptions) error {
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+	branch, err := opts.GitClient.CurrentBranch(context.Background())
+	if err != nil {
+		return err
+	}
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	pr, err := fetchOpenPRForBranch(httpClient, repo, branch)
+	if err != nil {
+		return err
+	}
+	if pr == nil {
+		return writeCache(cachePath(repo, branch), &cacheEntry{NoPR: true, UpdatedAt: time.Now()})
+	}
+
+	return writeCache(cachePath(repo, branch), buildEntry(httpClient, repo, pr))
+}
+
+// buildEntry snapshots a pull request's review and check state into a cache
+// entry.
+func buildEntry(client *http.Client, repo bbrepo.Interface, pr *prshared.PullRequest) *cacheEntry {
+	entry := &cacheEntry{UpdatedAt: time.Now()}
+
+	entry.PRID = pr.ID
+	entry.State = pr.State
+	for _, p := range pr.Participants {
+		if p.Approved {
+			entry.Approvals++
+		}
+		if p.State == "changes_requested" {
+			entry.ChangesRequested++
+		}
+	}
+
+	// Check state is best-effort; a PR with no resolvable commit statuses
+	// still renders its review state
+	if statuses, err := fetchStatuses(client, repo, pr.Source.Commit.Hash); err == nil {
+		for _, s := range statuses {
+			switch s.State {
+			case "SUCCESSFUL":
+				entry.ChecksPassed++
+			case "FAILED", "STOPPED":
+				entry.ChecksFailed++
+			default:
+				entry.ChecksPending++
+			}
+		}
+	}
+
+	return entry
+}
+
+// WarmBranches pre-populates the cache with an entry for every branch that
+// has an open pull request, so checking one of them out renders a prompt
+// without waiting for the first background refresh. It returns how many
+// branches were cached. Only the first page of open pull requests is warmed.
+func WarmBranches(client *http.Client, repo bbrepo.Interface) (int, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests?state=OPEN&pagelen=50",
+		repo.RepoWorkspace(), repo.RepoSlug())
+
+	var page prshared.PullRequestList
+	if err := apiClient.Get(repo.RepoHost(), path, &page); err != nil {
+		return 0, err
+	}
+
+	warmed := 0
+	for _, listed := range page.Values {
+		branch := listed.HeadBranch()
+		if branch == "" {
+			continue
+		}
+		// The list endpoint omits participants; fetch the full pull request
+		pr, err := list.FetchPullRequest(client, repo, listed.ID)
+		if err != nil {
+			continue
+		}
+		if err := writeCache(cachePath(repo, branch), buildEntry(client, repo, pr)); err == nil {
+			warmed++
+		}
+	}
+	return warmed, nil
+}
+
+func fetchOpenPRForBranch(client *http.Client, repo bbrepo.Interface, branch string) (*prshared.PullRequest, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	query := url.QueryEscape(fmt.Sprintf(`source.branch.name="%s"`, branch))
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests?state=OPEN&pagelen=1&q=%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), query)
+
+	var page prshared.PullRequestList
+	if err := apiClient.Get(repo.RepoHost(), path, &page); err != nil {
+		return nil, err
+	}
+	if len(page.Values) == 0 {
+		return nil, nil
+	}
+
+	// The list endpoint omits participants; fetch the full pull request
+	return list.FetchPullRequest(client, repo, page.Values[0].ID)
+}
+
+type buildStatus struct {
+	State string `json:"state"`
+}
+
+func fetchStatuses(client *http.Client, repo bbrepo.Interface, commitHash string) ([]buildStatus, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/commit/%s/statuses?pagelen=100",
+		repo.RepoWorkspace(), repo.RepoSlug(), commitHash)
+
+	var result struct {
+		Values []buildStatus `json:"values"`
+	}
+	if err := apiClient.Get(repo.RepoHost(), path, &result); err != nil {
+		return nil, err
+	}
+	return result.Values, nil
+}
+
+func cachePath(repo bbrepo.Interface, branch string) string {
+	name := fmt.Sprintf("%s-%s-%s.json",
+		repo.RepoWorkspace(), repo.RepoSlug(), strings.ReplaceAll(branch, "/", "-"))
+	return filepath.Join(config.StateDir(), "prompt-status", name)
+}
+
+func readCache(path string) (*cacheEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// writeCache writes via a temp file and rename so a concurrent fast path
+// never sees a partial entry.
+func writeCache(path string, entry *cacheEntry) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
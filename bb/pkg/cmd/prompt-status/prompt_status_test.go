@@ -0,0 +1,67 @@
+package promptstatus
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+func TestStatusString(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	cs := ios.ColorScheme()
+
+	tests := []struct {
+		name  string
+		entry *cacheEntry
+		want  string
+	}{
+		{
+			name:  "open PR with mixed checks and reviews",
+			entry: &cacheEntry{PRID: 123, State: "OPEN", ChecksPassed: 2, ChecksFailed: 1, ChecksPending: 1, Approvals: 1, ChangesRequested: 1},
+			want:  "#123 ✓2 ✗1 ●1 +1 -1",
+		},
+		{
+			name:  "open PR with no checks",
+			entry: &cacheEntry{PRID: 7, State: "OPEN"},
+			want:  "#7",
+		},
+		{
+			name:  "merged PR",
+			entry: &cacheEntry{PRID: 9, State: "MERGED", ChecksPassed: 3},
+			want:  "#9(merged) ✓3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusString(cs, tt.entry); got != tt.want {
+				t.Errorf("statusString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCacheRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ws-repo-main.json")
+
+	want := &cacheEntry{PRID: 42, State: "OPEN", Approvals: 2, UpdatedAt: time.Now().UTC().Truncate(time.Second)}
+	if err := writeCache(path, want); err != nil {
+		t.Fatalf("writeCache: %v", err)
+	}
+
+	got, err := readCache(path)
+	if err != nil {
+		t.Fatalf("readCache: %v", err)
+	}
+	if got.PRID != want.PRID || got.State != want.State || got.Approvals != want.Approvals || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+	}
+}
+
+func TestReadCacheMissing(t *testing.T) {
+	if _, err := readCache(filepath.Join(t.TempDir(), "absent.json")); err == nil {
+		t.Error("expected an error for a missing cache file")
+	}
+}
@@ -0,0 +1,104 @@
+package quick
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type QuickOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Title string
+	Body  string
+}
+
+func NewCmdQuick(f *cmdutil.Factory, runF func(*QuickOptions) error) *cobra.Command {
+	opts := &QuickOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "quick <title>",
+		Aliases: []string{"q"},
+		Short:   "Quickly capture an issue",
+		Long: heredoc.Doc(`
+			Create an issue with just a title, in a single keystroke-friendly command.
+
+			This is a minimal-latency alternative to "bb issue create" for capturing
+			ideas and bugs without breaking flow: the title is the only argument, the
+			body is optional, and everything else takes the tracker's defaults. It
+			pairs well with shell aliases and keyboard-driven workflows.
+		`),
+		Example: heredoc.Doc(`
+			$ bb q "login form drops the username on error"
+			$ bb q "dark mode" -m "requested by three customers this week"
+			$ bb q "flaky pipeline on main" --repo myworkspace/myrepo
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+			opts.Title = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return quickRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "message", "m", "", "Body of the issue")
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	return cmd
+}
+
+func quickRun(opts *QuickOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	payload := map[string]interface{}{
+		"title": opts.Title,
+	}
+	if opts.Body != "" {
+		payload["content"] = map[string]string{
+			"raw": opts.Body,
+		}
+	}
+
+	path := fmt.Sprintf("repositories/%s/%s/issues", repo.RepoWorkspace(), repo.RepoSlug())
+
+	var issue shared.Issue
+	if err := apiClient.Post(repo.RepoHost(), path, payload, &issue); err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("issue tracker is not enabled for this repository")
+		}
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Created issue #%d\n", cs.SuccessIcon(), issue.ID)
+	fmt.Fprintf(opts.IO.Out, "%s\n", issue.HTMLURL())
+
+	return nil
+}
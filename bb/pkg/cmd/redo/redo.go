@@ -0,0 +1,95 @@
+package redo
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/history"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RedoOptions struct {
+	IO         *iostreams.IOStreams
+	Executable func() string
+
+	Number int
+}
+
+func NewCmdRedo(f *cmdutil.Factory, runF func(*RedoOptions) error) *cobra.Command {
+	opts := &RedoOptions{
+		IO:         f.IOStreams,
+		Executable: f.Executable,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "redo [<n>]",
+		Short: "Re-run a recently run bb command",
+		Long: heredoc.Doc(`
+			Re-run a command from the history, by its number in "bb history".
+
+			Without an argument, the most recent command is re-run. Commands
+			recorded with redacted secret values cannot be re-run.
+		`),
+		Example: heredoc.Doc(`
+			# Re-run the last command
+			$ bb redo
+
+			# Re-run the third most recent command
+			$ bb redo 3
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Number = 1
+			if len(args) > 0 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n < 1 {
+					return cmdutil.FlagErrorf("invalid history number %q", args[0])
+				}
+				opts.Number = n
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return redoRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func redoRun(opts *RedoOptions) error {
+	entries, err := history.List()
+	if err != nil {
+		return err
+	}
+	if opts.Number > len(entries) {
+		return fmt.Errorf("history has only %d entries; run `bb history` to see them", len(entries))
+	}
+
+	entry := entries[len(entries)-opts.Number]
+	for _, arg := range entry.Args {
+		if strings.Contains(arg, "********") {
+			return fmt.Errorf("cannot re-run `bb %s`: a secret value was redacted from the history", strings.Join(entry.Args, " "))
+		}
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "Running: bb %s\n", strings.Join(entry.Args, " "))
+
+	cmd := exec.Command(opts.Executable(), entry.Args...)
+	cmd.Env = os.Environ()
+	cmd.Stdin = opts.IO.In
+	cmd.Stdout = opts.IO.Out
+	cmd.Stderr = opts.IO.ErrOut
+	if err := cmd.Run(); err != nil {
+		// The child already reported its error
+		return cmdutil.SilentError
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package reminders
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/snooze"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemindersOptions struct {
+	IO *iostreams.IOStreams
+}
+
+func NewCmdReminders(f *cmdutil.Factory, runF func(*RemindersOptions) error) *cobra.Command {
+	opts := &RemindersOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "reminders",
+		Short: "List snoozed issues and when they come back",
+		Long: heredoc.Doc(`
+			List issues snoozed with "bb issue snooze", soonest first.
+
+			A snoozed issue is hidden from "bb issue list" and "bb status" until
+			its snooze expires; after that it shows up again and disappears from
+			this list.
+		`),
+		Example: heredoc.Doc(`
+			$ bb reminders
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return remindersRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func remindersRun(opts *RemindersOptions) error {
+	entries, err := snooze.List()
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No upcoming reminders. Snooze an issue with `bb issue snooze`.")
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("REPO", "ISSUE", "UNTIL"))
+	now := time.Now()
+	for _, e := range entries {
+		tp.AddField(e.Repo)
+		tp.AddField(fmt.Sprintf("#%d", e.ID), tableprinter.WithColor(cs.Cyan))
+		if opts.IO.IsStdoutTTY() {
+			tp.AddField(fmt.Sprintf("%s (%s)", e.Until.Local().Format("Mon Jan 2 15:04"), untilIn(now, e.Until)))
+		} else {
+			tp.AddField(e.Until.Format(time.RFC3339))
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// untilIn renders how far away a reminder is, coarsely.
+func untilIn(now, until time.Time) string {
+	d := until.Sub(now)
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("in %dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("in %dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("in %dd", int(d.Hours()/24))
+	}
+}
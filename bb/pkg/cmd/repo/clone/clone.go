@@ -2,6 +2,7 @@ package clone
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -12,6 +13,8 @@ import (
 	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -23,10 +26,12 @@ type CloneOptions struct {
 	GitClient  *git.Client
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
+	Prompter   prompter.Prompter
 
-	GitArgs      []string
-	Repository   string
-	UpstreamName string
+	GitArgs       []string
+	Repository    string
+	UpstreamName  string
+	FallbackHTTPS bool
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -35,13 +40,14 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 		HttpClient: f.HttpClient,
 		GitClient:  f.GitClient,
 		Config:     f.Config,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
 		DisableFlagsInUseLine: true,
 
-		Use:   "clone <repository> [<directory>] [-- <gitflags>...]",
-		Args:  cmdutil.MinimumArgs(1, "cannot clone: repository argument required"),
+		Use:   "clone [<repository>] [<directory>] [-- <gitflags>...]",
+		Args:  cobra.ArbitraryArgs,
 		Short: "Clone a repository locally",
 		Long: heredoc.Docf(`
 			Clone a Bitbucket repository locally. Pass additional %[1]sgit clone%[1]s flags by listing
@@ -56,6 +62,13 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 
 			If the repository is a fork, its parent repository will be added as an additional
 			git remote called %[1]supstream%[1]s. The remote name can be configured using %[1]s--upstream-remote-name%[1]s.
+
+			With no repository argument on an interactive terminal, the most recently updated
+			repositories of the default workspace are offered in a filterable picker.
+
+			When an SSH clone fails because no key is usable or the SSH port cannot be
+			reached, %[1]sbb%[1]s offers to retry over HTTPS with the stored credential;
+			%[1]s--fallback-https%[1]s retries without asking.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Clone a repository from a specific workspace
@@ -75,8 +88,12 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 			$ bb repo clone atlassian/bitbucket-cli -- --depth=1
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.Repository = args[0]
-			opts.GitArgs = args[1:]
+			if len(args) > 0 {
+				opts.Repository = args[0]
+				opts.GitArgs = args[1:]
+			} else if !opts.IO.CanPrompt() {
+				return cmdutil.FlagErrorf("cannot clone: repository argument required")
+			}
 
 			if runF != nil {
 				return runF(opts)
@@ -87,6 +104,7 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 	}
 
 	cmd.Flags().StringVarP(&opts.UpstreamName, "upstream-remote-name", "u", "upstream", "Upstream remote name when cloning a fork")
+	cmd.Flags().BoolVar(&opts.FallbackHTTPS, "fallback-https", false, "Retry over HTTPS when an SSH clone fails due to keys or connectivity")
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -110,6 +128,14 @@ func cloneRun(opts *CloneOptions) error {
 
 	apiClient := api.NewClientFromHTTP(httpClient)
 
+	if opts.Repository == "" {
+		selected, err := pickRepository(opts, cfg, apiClient)
+		if err != nil {
+			return err
+		}
+		opts.Repository = selected
+	}
+
 	repositoryIsURL := strings.Contains(opts.Repository, ":")
 	repositoryIsFullName := !repositoryIsURL && strings.Contains(opts.Repository, "/")
 
@@ -149,7 +175,7 @@ func cloneRun(opts *CloneOptions) error {
 			return err
 		}
 
-		protocol = cfg.GitProtocol(repo.RepoHost()).Value
+		protocol = shared.PreferredGitProtocol(context.Background(), nil, cfg, apiClient, repo.RepoHost())
 	}
 
 	// For Bitbucket, we can clone directly without fetching repo info first
@@ -159,6 +185,40 @@ func cloneRun(opts *CloneOptions) error {
 	gitClient := opts.GitClient
 	ctx := context.Background()
 	cloneDir, err := gitClient.Clone(ctx, cloneURL, opts.GitArgs)
+	if err != nil && protocol == "ssh" {
+		var gitErr *git.GitError
+		if errors.As(err, &gitErr) {
+			reason := sshFailureReason(gitErr.Stderr)
+			retry := false
+			if opts.FallbackHTTPS {
+				retry = true
+			} else if reason != "" && opts.IO.CanPrompt() {
+				cs := opts.IO.ColorScheme()
+				fmt.Fprintf(opts.IO.ErrOut, "%s SSH clone failed: %s.\n", cs.WarningIcon(), reason)
+				retry, err = opts.Prompter.Confirm("Retry cloning over HTTPS?", true)
+				if err != nil {
+					return err
+				}
+				if !retry {
+					return gitErr
+				}
+			}
+			if retry {
+				if opts.FallbackHTTPS && opts.IO.IsStderrTTY() {
+					cs := opts.IO.ColorScheme()
+					if reason != "" {
+						fmt.Fprintf(opts.IO.ErrOut, "%s SSH clone failed: %s. Retrying over HTTPS.\n", cs.WarningIcon(), reason)
+					} else {
+						fmt.Fprintf(opts.IO.ErrOut, "%s SSH clone failed. Retrying over HTTPS.\n", cs.WarningIcon())
+					}
+				}
+				// Switch protocols so the upstream remote of a fork matches
+				protocol = "https"
+				cloneURL = bbrepo.FormatRemoteURL(repo, protocol)
+				cloneDir, err = gitClient.Clone(ctx, cloneURL, opts.GitArgs)
+			}
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -212,6 +272,64 @@ func cloneRun(opts *CloneOptions) error {
 	return nil
 }
 
+// pickRepository offers the most recently updated repositories of the default
+// workspace in a filterable prompt and returns the chosen full name. The
+// prompt itself supports fuzzy filtering over the listed page.
+func pickRepository(opts *CloneOptions, cfg gh.Config, apiClient *api.Client) (string, error) {
+	host, _ := cfg.Authentication().DefaultHost()
+	workspace := cfg.DefaultWorkspace(host).Value
+	if workspace == "" {
+		return "", fmt.Errorf("no default workspace configured; set one with `bb config set default_workspace <workspace>` or pass a repository argument")
+	}
+
+	// Cap the number of pages fetched; the prompt filter makes anything
+	// beyond this unlikely to be useful
+	const maxRepos = 200
+
+	opts.IO.StartProgressIndicator()
+	var repos []api.Repository
+	path := fmt.Sprintf("repositories/%s?sort=-updated_on&pagelen=100", workspace)
+	nextURL := ""
+	for {
+		var page api.PaginatedResponse[api.Repository]
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(host, path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return "", err
+		}
+		repos = append(repos, page.Values...)
+		if page.Next == "" || len(repos) >= maxRepos {
+			break
+		}
+		nextURL = page.Next
+	}
+	opts.IO.StopProgressIndicator()
+
+	if len(repos) == 0 {
+		return "", fmt.Errorf("no repositories found in workspace %s", workspace)
+	}
+
+	options := make([]string, len(repos))
+	for i, repo := range repos {
+		name := repo.FullName
+		if name == "" {
+			name = fmt.Sprintf("%s/%s", workspace, repo.Slug)
+		}
+		options[i] = name
+	}
+
+	selected, err := opts.Prompter.Select("Which repository do you want to clone?", "", options)
+	if err != nil {
+		return "", err
+	}
+	return options[selected], nil
+}
+
 // simplifyURL strips given URL of extra parts like extra path segments (i.e.,
 // anything beyond `/workspace/repo`), query strings, or fragments.
 func simplifyURL(u *url.URL) *url.URL {
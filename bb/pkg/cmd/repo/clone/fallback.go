@@ -0,0 +1,25 @@
+package clone
+
+import "strings"
+
+// sshFailureReason classifies an SSH clone failure from git's stderr output.
+// It returns a short human-readable reason for failure classes that an HTTPS
+// retry can work around, or an empty string for anything else (e.g. a
+// repository that simply does not exist).
+func sshFailureReason(stderr string) string {
+	lower := strings.ToLower(stderr)
+	switch {
+	case strings.Contains(lower, "permission denied (publickey"):
+		return "SSH authentication failed (no usable key)"
+	case strings.Contains(lower, "host key verification failed"):
+		return "host key verification failed"
+	case strings.Contains(lower, "could not resolve hostname"):
+		return "could not resolve the SSH host"
+	case strings.Contains(lower, "connection refused"),
+		strings.Contains(lower, "connection timed out"),
+		strings.Contains(lower, "network is unreachable"):
+		return "could not reach the SSH port"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,52 @@
+package clone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sshFailureReason(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   string
+	}{
+		{
+			name:   "missing key",
+			stderr: "git@bitbucket.org: Permission denied (publickey).\nfatal: Could not read from remote repository.",
+			want:   "SSH authentication failed (no usable key)",
+		},
+		{
+			name:   "host key verification",
+			stderr: "Host key verification failed.\nfatal: Could not read from remote repository.",
+			want:   "host key verification failed",
+		},
+		{
+			name:   "dns failure",
+			stderr: "ssh: Could not resolve hostname bitbucket.org: Name or service not known",
+			want:   "could not resolve the SSH host",
+		},
+		{
+			name:   "blocked port",
+			stderr: "ssh: connect to host bitbucket.org port 22: Connection timed out",
+			want:   "could not reach the SSH port",
+		},
+		{
+			name:   "repository not found is not retried",
+			stderr: "fatal: repository 'foo/bar' not found",
+			want:   "",
+		},
+		{
+			name:   "empty stderr",
+			stderr: "",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sshFailureReason(tt.stderr))
+		})
+	}
+}
@@ -5,12 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -20,14 +25,19 @@ type CreateOptions struct {
 	HttpClient func() (*http.Client, error)
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
-
-	Name        string
-	Description string
-	Workspace   string
-	Project     string
-	Private     bool
-	Public      bool
-	Clone       bool
+	Prompter   prompter.Prompter
+
+	Name          string
+	Description   string
+	Workspace     string
+	Project       string
+	Private       bool
+	Public        bool
+	Clone         bool
+	FromManifest  string
+	Gitignore     string
+	License       string
+	DefaultBranch string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -35,6 +45,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		Config:     f.Config,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -48,6 +59,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			- WORKSPACE/REPO format
 
 			By default, repositories are created as private.
+
+			With --default-branch, the repository's main branch is set to the given
+			name and any initial commit created by --gitignore or --license lands
+			on that branch.
+
+			With --from-manifest, a YAML manifest declares settings to apply after
+			creation as a single bootstrap step: description, project, branch model,
+			default reviewers, branch restrictions, pipelines enablement, and
+			pipeline variables. Flags take precedence over manifest values.
 		`),
 		Example: heredoc.Doc(`
 			# Create a private repository in your default workspace
@@ -64,6 +84,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 			# Create and clone the repository
 			$ bb repo create my-project --clone
+
+			# Create a repository and bootstrap its settings from a manifest
+			$ bb repo create my-project --from-manifest bb-repo.yml
+
+			# Create a repository with an initial .gitignore and LICENSE
+			$ bb repo create my-project --gitignore go --license MIT
+
+			# Create a repository whose initial branch is named trunk
+			$ bb repo create my-project --default-branch trunk --gitignore go
 		`),
 		Args: cmdutil.ExactArgs(1, "repository name required"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -87,10 +116,15 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the repository")
 	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace to create the repository in")
+	_ = cmd.RegisterFlagCompletionFunc("workspace", shared.WorkspaceCompletionFunc(f))
 	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Project key to add the repository to")
 	cmd.Flags().BoolVar(&opts.Public, "public", false, "Make the repository public")
 	cmd.Flags().BoolVar(&opts.Private, "private", false, "Make the repository private (default)")
 	cmd.Flags().BoolVarP(&opts.Clone, "clone", "c", false, "Clone the repository after creation")
+	cmd.Flags().StringVar(&opts.FromManifest, "from-manifest", "", "Bootstrap repository settings from a YAML manifest `file`")
+	cmd.Flags().StringVar(&opts.Gitignore, "gitignore", "", "Add an initial .gitignore from a `template` (e.g. go, node, python)")
+	cmd.Flags().StringVar(&opts.License, "license", "", "Add an initial LICENSE by SPDX `id` (e.g. MIT, BSD-3-Clause)")
+	cmd.Flags().StringVar(&opts.DefaultBranch, "default-branch", "", "Initial `branch` name for the repository")
 
 	return cmd
 }
@@ -103,6 +137,20 @@ func createRun(opts *CreateOptions) error {
 
 	cs := opts.IO.ColorScheme()
 
+	var manifest *repoManifest
+	if opts.FromManifest != "" {
+		manifest, err = loadManifest(opts.FromManifest)
+		if err != nil {
+			return err
+		}
+		if opts.Description == "" {
+			opts.Description = manifest.Description
+		}
+		if opts.Project == "" {
+			opts.Project = manifest.Project
+		}
+	}
+
 	// Parse workspace and repo name
 	workspace := opts.Workspace
 	repoName := opts.Name
@@ -113,23 +161,39 @@ func createRun(opts *CreateOptions) error {
 		repoName = parts[1]
 	}
 
-	// If no workspace specified, try to get from config
+	// If no workspace specified, fall back to the configured default or the
+	// personal workspace, confirming the latter before creating anything
 	if workspace == "" {
 		cfg, err := opts.Config()
 		if err != nil {
 			return err
 		}
-		// Try to get default workspace from user info
-		authCfg := cfg.Authentication()
-		user, err := authCfg.ActiveUser(bbinstance.Default())
-		if err != nil || user == "" {
-			return fmt.Errorf("workspace required: use --workspace flag or WORKSPACE/REPO format")
+		workspace, err = shared.ResolveTargetWorkspace(cfg, opts.IO, opts.Prompter, "Create the repository")
+		if err != nil {
+			return err
 		}
-		workspace = user
+	}
+
+	// Resolve the starter files up front so a typo fails before the
+	// repository is created.
+	initialFiles := map[string]string{}
+	if opts.Gitignore != "" {
+		content, err := gitignoreTemplate(opts.Gitignore)
+		if err != nil {
+			return err
+		}
+		initialFiles[".gitignore"] = content
+	}
+	if opts.License != "" {
+		content, err := licenseTemplate(opts.License, fmt.Sprintf("%d", time.Now().Year()), workspace)
+		if err != nil {
+			return err
+		}
+		initialFiles["LICENSE"] = content
 	}
 
 	// Create the repository
-	repo, err := createRepository(httpClient, workspace, repoName, opts.Description, opts.Project, !opts.Public)
+	repo, err := createRepository(httpClient, workspace, repoName, opts.Description, opts.Project, opts.DefaultBranch, !opts.Public)
 	if err != nil {
 		return fmt.Errorf("failed to create repository: %w", err)
 	}
@@ -152,6 +216,27 @@ func createRun(opts *CreateOptions) error {
 		fmt.Fprintln(opts.IO.Out, repoURL)
 	}
 
+	if len(initialFiles) > 0 {
+		if err := uploadInitialFiles(httpClient, workspace, repoName, opts.DefaultBranch, initialFiles); err != nil {
+			return fmt.Errorf("failed to create initial commit: %w", err)
+		}
+		if opts.IO.IsStdoutTTY() {
+			names := make([]string, 0, len(initialFiles))
+			for name := range initialFiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			fmt.Fprintf(opts.IO.Out, "%s Created initial commit with %s\n",
+				cs.SuccessIconWithColor(cs.Green), strings.Join(names, ", "))
+		}
+	}
+
+	if manifest != nil {
+		if err := applyManifest(httpClient, opts.IO, workspace, repoName, manifest); err != nil {
+			return err
+		}
+	}
+
 	if opts.Clone && cloneURL != "" {
 		fmt.Fprintf(opts.IO.ErrOut, "Cloning into '%s'...\n", repoName)
 		// We could use git client here, but keeping it simple for now
@@ -177,7 +262,7 @@ type createRepoResponse struct {
 	} `json:"links"`
 }
 
-func createRepository(client *http.Client, workspace, name, description, project string, isPrivate bool) (*createRepoResponse, error) {
+func createRepository(client *http.Client, workspace, name, description, project, mainBranch string, isPrivate bool) (*createRepoResponse, error) {
 	url := fmt.Sprintf("%srepositories/%s/%s",
 		api.RESTPrefix(bbinstance.Default()),
 		workspace,
@@ -199,6 +284,13 @@ func createRepository(client *http.Client, workspace, name, description, project
 		}
 	}
 
+	if mainBranch != "" {
+		payload["mainbranch"] = map[string]string{
+			"type": "branch",
+			"name": mainBranch,
+		}
+	}
+
 	jsonBody, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
@@ -239,3 +331,39 @@ func createRepository(client *http.Client, workspace, name, description, project
 
 	return &repo, nil
 }
+
+// uploadInitialFiles creates an initial commit containing the given files via
+// the src endpoint, which accepts file contents as form fields keyed by path.
+func uploadInitialFiles(client *http.Client, workspace, name, branch string, files map[string]string) error {
+	srcURL := fmt.Sprintf("%srepositories/%s/%s/src",
+		api.RESTPrefix(bbinstance.Default()),
+		workspace,
+		name,
+	)
+
+	form := url.Values{}
+	form.Set("message", "Initial commit")
+	if branch != "" {
+		form.Set("branch", branch)
+	}
+	for path, content := range files {
+		form.Set(path, content)
+	}
+
+	req, err := http.NewRequest("POST", srcURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(resp)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"gopkg.in/yaml.v3"
+)
+
+// repoManifest declares repository settings to bootstrap after creation,
+// read from a YAML file passed via --from-manifest.
+type repoManifest struct {
+	Description        string                      `yaml:"description"`
+	Project            string                      `yaml:"project"`
+	BranchModel        *branchModelManifest        `yaml:"branch_model"`
+	DefaultReviewers   []string                    `yaml:"default_reviewers"`
+	BranchRestrictions []branchRestrictionManifest `yaml:"branch_restrictions"`
+	Pipelines          *pipelinesManifest          `yaml:"pipelines"`
+	Variables          []variableManifest          `yaml:"variables"`
+}
+
+type branchModelManifest struct {
+	Development string `yaml:"development"`
+	Production  string `yaml:"production"`
+}
+
+type branchRestrictionManifest struct {
+	Kind    string `yaml:"kind"`
+	Pattern string `yaml:"pattern"`
+}
+
+type pipelinesManifest struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+type variableManifest struct {
+	Key     string `yaml:"key"`
+	Value   string `yaml:"value"`
+	Secured bool   `yaml:"secured"`
+}
+
+func loadManifest(path string) (*repoManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest repoManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", path, err)
+	}
+
+	for i, r := range manifest.BranchRestrictions {
+		if r.Kind == "" || r.Pattern == "" {
+			return nil, fmt.Errorf("manifest branch_restrictions[%d]: kind and pattern are required", i)
+		}
+	}
+	for i, v := range manifest.Variables {
+		if v.Key == "" {
+			return nil, fmt.Errorf("manifest variables[%d]: key is required", i)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// applyManifest applies the post-creation settings declared in the manifest,
+// one API call per setting. It reports each step so a partial failure shows
+// how far the bootstrap got.
+func applyManifest(client *http.Client, io *iostreams.IOStreams, workspace, repoName string, manifest *repoManifest) error {
+	apiClient := api.NewClientFromHTTP(client)
+	cs := io.ColorScheme()
+	hostname := bbinstance.Default()
+
+	progress := func(format string, args ...interface{}) {
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(io.Out, "%s "+format+"\n", append([]interface{}{cs.SuccessIcon()}, args...)...)
+		}
+	}
+
+	if manifest.BranchModel != nil {
+		payload := map[string]interface{}{}
+		if manifest.BranchModel.Development != "" {
+			payload["development"] = map[string]interface{}{
+				"name":           manifest.BranchModel.Development,
+				"use_mainbranch": false,
+			}
+		}
+		if manifest.BranchModel.Production != "" {
+			payload["production"] = map[string]interface{}{
+				"name":           manifest.BranchModel.Production,
+				"use_mainbranch": false,
+				"enabled":        true,
+			}
+		}
+		path := fmt.Sprintf("repositories/%s/%s/branching-model/settings", workspace, repoName)
+		if err := apiClient.Put(hostname, path, payload, nil); err != nil {
+			return fmt.Errorf("failed to apply branch model: %w", err)
+		}
+		progress("Applied branch model")
+	}
+
+	for _, reviewer := range manifest.DefaultReviewers {
+		path := fmt.Sprintf("repositories/%s/%s/default-reviewers/%s", workspace, repoName, reviewer)
+		if err := apiClient.Put(hostname, path, nil, nil); err != nil {
+			return fmt.Errorf("failed to add default reviewer %s: %w", reviewer, err)
+		}
+		progress("Added default reviewer %s", cs.Bold(reviewer))
+	}
+
+	for _, restriction := range manifest.BranchRestrictions {
+		payload := map[string]interface{}{
+			"kind":    restriction.Kind,
+			"pattern": restriction.Pattern,
+		}
+		path := fmt.Sprintf("repositories/%s/%s/branch-restrictions", workspace, repoName)
+		if err := apiClient.Post(hostname, path, payload, nil); err != nil {
+			return fmt.Errorf("failed to add branch restriction %s on %s: %w", restriction.Kind, restriction.Pattern, err)
+		}
+		progress("Added branch restriction %s on %s", restriction.Kind, cs.Bold(restriction.Pattern))
+	}
+
+	if manifest.Pipelines != nil {
+		payload := map[string]interface{}{
+			"enabled": manifest.Pipelines.Enabled,
+		}
+		path := fmt.Sprintf("repositories/%s/%s/pipelines_config", workspace, repoName)
+		if err := apiClient.Put(hostname, path, payload, nil); err != nil {
+			return fmt.Errorf("failed to configure pipelines: %w", err)
+		}
+		if manifest.Pipelines.Enabled {
+			progress("Enabled pipelines")
+		} else {
+			progress("Disabled pipelines")
+		}
+	}
+
+	for _, variable := range manifest.Variables {
+		payload := map[string]interface{}{
+			"key":     variable.Key,
+			"value":   variable.Value,
+			"secured": variable.Secured,
+		}
+		path := fmt.Sprintf("repositories/%s/%s/pipelines_config/variables", workspace, repoName)
+		if err := apiClient.Post(hostname, path, payload, nil); err != nil {
+			return fmt.Errorf("failed to set variable %s: %w", variable.Key, err)
+		}
+		progress("Set variable %s", cs.Bold(variable.Key))
+	}
+
+	return nil
+}
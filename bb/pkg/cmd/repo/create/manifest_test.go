@@ -0,0 +1,107 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+		check    func(t *testing.T, m *repoManifest)
+	}{
+		{
+			name: "full manifest",
+			contents: heredoc.Doc(`
+				description: My awesome project
+				project: PROJ
+				branch_model:
+				  development: develop
+				  production: main
+				default_reviewers:
+				  - "{reviewer-uuid}"
+				branch_restrictions:
+				  - kind: push
+				    pattern: main
+				pipelines:
+				  enabled: true
+				variables:
+				  - key: NODE_ENV
+				    value: production
+				  - key: API_KEY
+				    value: hunter2
+				    secured: true
+			`),
+			check: func(t *testing.T, m *repoManifest) {
+				assert.Equal(t, "My awesome project", m.Description)
+				assert.Equal(t, "PROJ", m.Project)
+				assert.Equal(t, "develop", m.BranchModel.Development)
+				assert.Equal(t, "main", m.BranchModel.Production)
+				assert.Equal(t, []string{"{reviewer-uuid}"}, m.DefaultReviewers)
+				require.Len(t, m.BranchRestrictions, 1)
+				assert.Equal(t, "push", m.BranchRestrictions[0].Kind)
+				assert.True(t, m.Pipelines.Enabled)
+				require.Len(t, m.Variables, 2)
+				assert.False(t, m.Variables[0].Secured)
+				assert.True(t, m.Variables[1].Secured)
+			},
+		},
+		{
+			name:     "empty manifest",
+			contents: "",
+			check: func(t *testing.T, m *repoManifest) {
+				assert.Nil(t, m.BranchModel)
+				assert.Nil(t, m.Pipelines)
+				assert.Empty(t, m.DefaultReviewers)
+			},
+		},
+		{
+			name: "restriction missing pattern",
+			contents: heredoc.Doc(`
+				branch_restrictions:
+				  - kind: push
+			`),
+			wantErr: "manifest branch_restrictions[0]: kind and pattern are required",
+		},
+		{
+			name: "variable missing key",
+			contents: heredoc.Doc(`
+				variables:
+				  - value: production
+			`),
+			wantErr: "manifest variables[0]: key is required",
+		},
+		{
+			name:     "invalid yaml",
+			contents: "description: [",
+			wantErr:  "failed to parse manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "bb-repo.yml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.contents), 0o644))
+
+			manifest, err := loadManifest(path)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, manifest)
+		})
+	}
+}
+
+func TestLoadManifestMissingFile(t *testing.T) {
+	_, err := loadManifest(filepath.Join(t.TempDir(), "nope.yml"))
+	assert.ErrorContains(t, err, "failed to read manifest")
+}
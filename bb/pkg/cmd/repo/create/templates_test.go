@@ -0,0 +1,27 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitignoreTemplate(t *testing.T) {
+	content, err := gitignoreTemplate("Go")
+	assert.NoError(t, err)
+	assert.Contains(t, content, "vendor/")
+
+	_, err = gitignoreTemplate("cobol")
+	assert.EqualError(t, err, `unknown gitignore template "cobol" (available: go, java, node, python, rust)`)
+}
+
+func TestLicenseTemplate(t *testing.T) {
+	content, err := licenseTemplate("mit", "2026", "myworkspace")
+	assert.NoError(t, err)
+	assert.Contains(t, content, "Copyright (c) 2026 myworkspace")
+	assert.NotContains(t, content, "[year]")
+	assert.NotContains(t, content, "[fullname]")
+
+	_, err = licenseTemplate("WTFPL", "2026", "myworkspace")
+	assert.EqualError(t, err, `unknown license "WTFPL" (available: bsd-2-clause, bsd-3-clause, isc, mit, unlicense)`)
+}
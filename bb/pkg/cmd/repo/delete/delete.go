@@ -7,6 +7,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -16,6 +17,7 @@ import (
 type DeleteOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
 	Prompter   prompter.Prompter
 
@@ -27,6 +29,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	opts := &DeleteOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
 		Prompter:   f.Prompter,
 	}
@@ -86,17 +89,18 @@ func deleteRun(opts *DeleteOptions) error {
 
 	fullName := fmt.Sprintf("%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
 
-	// Confirm deletion
-	if !opts.Confirm {
-		if !opts.IO.CanPrompt() {
-			return cmdutil.FlagErrorf("--yes required when not running interactively")
-		}
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
 
+	// Confirm deletion
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
 		fmt.Fprintf(opts.IO.ErrOut, "You are about to delete the repository %s.\nThis action CANNOT be undone.\n", fullName)
-		err = opts.Prompter.ConfirmDeletion(fullName)
-		if err != nil {
-			return err
-		}
+		return opts.Prompter.ConfirmDeletion(fullName)
+	})
+	if err != nil {
+		return err
 	}
 
 	opts.IO.StartProgressIndicator()
@@ -7,6 +7,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ type EditOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (bbrepo.Interface, error)
+	Prompter   prompter.Prompter
 
 	RepoArg     string
 	Name        string
@@ -26,6 +28,10 @@ type EditOptions struct {
 	ForkPolicy  string
 	MainBranch  string
 	Project     string
+	Wiki        *bool
+	Issues      *bool
+
+	Interactive bool
 }
 
 func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Command {
@@ -33,9 +39,12 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
 		BaseRepo:   f.BaseRepo,
+		Prompter:   f.Prompter,
 	}
 
 	var setPrivate, setPublic bool
+	var enableWiki, disableWiki bool
+	var enableIssues, disableIssues bool
 
 	cmd := &cobra.Command{
 		Use:   "edit [<repository>]",
@@ -44,13 +53,18 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 			Edit settings for a repository.
 
 			Without an argument, edits the current repository.
+
+			When run without any edit flags on a terminal, an interactive menu
+			offers the editable settings with their current values pre-filled.
 		`),
 		Example: heredoc.Doc(`
+			$ bb repo edit
 			$ bb repo edit --description "New description"
 			$ bb repo edit --private
 			$ bb repo edit --public
 			$ bb repo edit myworkspace/myrepo --main-branch develop
 			$ bb repo edit --project PROJ
+			$ bb repo edit --enable-issues --disable-wiki
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -68,6 +82,26 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 				opts.Private = &notPublic
 			}
 
+			// Handle feature toggles
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--enable-wiki` or `--disable-wiki`",
+				enableWiki, disableWiki,
+			); err != nil {
+				return err
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--enable-issues` or `--disable-issues`",
+				enableIssues, disableIssues,
+			); err != nil {
+				return err
+			}
+			if enableWiki || disableWiki {
+				opts.Wiki = &enableWiki
+			}
+			if enableIssues || disableIssues {
+				opts.Issues = &enableIssues
+			}
+
 			// Validate that at least one edit flag is provided
 			hasEdits := opts.Name != "" ||
 				opts.Description != "" ||
@@ -76,10 +110,15 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 				opts.Private != nil ||
 				opts.ForkPolicy != "" ||
 				opts.MainBranch != "" ||
-				opts.Project != ""
+				opts.Project != "" ||
+				opts.Wiki != nil ||
+				opts.Issues != nil
 
 			if !hasEdits {
-				return cmdutil.FlagErrorf("at least one edit flag is required")
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("at least one edit flag is required when not running interactively")
+				}
+				opts.Interactive = true
 			}
 
 			// Validate fork policy
@@ -113,6 +152,10 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.ForkPolicy, "fork-policy", "", "Set fork policy (allow_forks, no_public_forks, no_forks)")
 	cmd.Flags().StringVar(&opts.MainBranch, "main-branch", "", "Set the default branch")
 	cmd.Flags().StringVarP(&opts.Project, "project", "p", "", "Move repository to a project by key")
+	cmd.Flags().BoolVar(&enableWiki, "enable-wiki", false, "Enable the repository wiki")
+	cmd.Flags().BoolVar(&disableWiki, "disable-wiki", false, "Disable the repository wiki")
+	cmd.Flags().BoolVar(&enableIssues, "enable-issues", false, "Enable the issue tracker")
+	cmd.Flags().BoolVar(&disableIssues, "disable-issues", false, "Disable the issue tracker")
 
 	return cmd
 }
@@ -136,6 +179,27 @@ func editRun(opts *EditOptions) error {
 		}
 	}
 
+	// Fetch the current settings up front: the interactive menu pre-fills
+	// them, and the post-edit summary diffs against them
+	opts.IO.StartProgressIndicator()
+	before, beforeErr := fetchRepoSettings(httpClient, repo)
+	opts.IO.StopProgressIndicator()
+
+	if opts.Interactive {
+		if beforeErr != nil {
+			return beforeErr
+		}
+
+		edited, err := interactiveEdit(opts, before)
+		if err != nil {
+			return err
+		}
+		if !edited {
+			fmt.Fprintln(opts.IO.ErrOut, "No settings selected, nothing to do")
+			return nil
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
 	err = updateRepository(httpClient, repo, opts)
 	opts.IO.StopProgressIndicator()
@@ -148,11 +212,59 @@ func editRun(opts *EditOptions) error {
 		cs := opts.IO.ColorScheme()
 		fmt.Fprintf(opts.IO.Out, "%s Edited repository %s/%s\n",
 			cs.SuccessIcon(), repo.RepoWorkspace(), repo.RepoSlug())
+		// Re-fetch the settings so the summary reflects what the server
+		// actually stored, not just what was requested
+		if before != nil {
+			if after, err := fetchRepoSettings(httpClient, repo); err == nil {
+				cmdutil.PrintFieldChanges(opts.IO, settingsFieldChanges(before, after))
+			}
+		}
 	}
 
 	return nil
 }
 
+// settingsFieldChanges compares the repository settings before and after the
+// edit for the fields this command can modify.
+func settingsFieldChanges(before, after *repoSettings) []cmdutil.FieldChange {
+	visibility := func(s *repoSettings) string {
+		if s.IsPrivate {
+			return "private"
+		}
+		return "public"
+	}
+	toggle := func(on bool) string {
+		if on {
+			return "enabled"
+		}
+		return "disabled"
+	}
+	branch := func(s *repoSettings) string {
+		if s.MainBranch != nil {
+			return s.MainBranch.Name
+		}
+		return ""
+	}
+	project := func(s *repoSettings) string {
+		if s.Project != nil {
+			return s.Project.Key
+		}
+		return ""
+	}
+	return []cmdutil.FieldChange{
+		{Name: "name", Before: before.Name, After: after.Name},
+		{Name: "description", Before: before.Description, After: after.Description},
+		{Name: "website", Before: before.Website, After: after.Website},
+		{Name: "language", Before: before.Language, After: after.Language},
+		{Name: "visibility", Before: visibility(before), After: visibility(after)},
+		{Name: "fork policy", Before: before.ForkPolicy, After: after.ForkPolicy},
+		{Name: "main branch", Before: branch(before), After: branch(after)},
+		{Name: "project", Before: project(before), After: project(after)},
+		{Name: "wiki", Before: toggle(before.HasWiki), After: toggle(after.HasWiki)},
+		{Name: "issue tracker", Before: toggle(before.HasIssues), After: toggle(after.HasIssues)},
+	}
+}
+
 type repoUpdatePayload struct {
 	Name        string      `json:"name,omitempty"`
 	Description string      `json:"description,omitempty"`
@@ -162,6 +274,8 @@ type repoUpdatePayload struct {
 	ForkPolicy  string      `json:"fork_policy,omitempty"`
 	MainBranch  *mainBranch `json:"mainbranch,omitempty"`
 	Project     *projectRef `json:"project,omitempty"`
+	HasWiki     *bool       `json:"has_wiki,omitempty"`
+	HasIssues   *bool       `json:"has_issues,omitempty"`
 }
 
 type mainBranch struct {
@@ -207,6 +321,130 @@ func updateRepository(client *http.Client, repo bbrepo.Interface, opts *EditOpti
 	if opts.Project != "" {
 		payload.Project = &projectRef{Key: opts.Project}
 	}
+	if opts.Wiki != nil {
+		payload.HasWiki = opts.Wiki
+	}
+	if opts.Issues != nil {
+		payload.HasIssues = opts.Issues
+	}
 
 	return apiClient.Put(repo.RepoHost(), path, payload, nil)
 }
+
+// repoSettings holds the current values shown in the interactive menu and
+// diffed by the post-edit summary.
+type repoSettings struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Website     string `json:"website"`
+	Language    string `json:"language"`
+	IsPrivate   bool   `json:"is_private"`
+	ForkPolicy  string `json:"fork_policy"`
+	HasWiki     bool   `json:"has_wiki"`
+	HasIssues   bool   `json:"has_issues"`
+	MainBranch  *struct {
+		Name string `json:"name"`
+	} `json:"mainbranch,omitempty"`
+	Project *struct {
+		Key string `json:"key"`
+	} `json:"project,omitempty"`
+}
+
+func fetchRepoSettings(client *http.Client, repo bbrepo.Interface) (*repoSettings, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
+
+	var settings repoSettings
+	if err := apiClient.Get(repo.RepoHost(), path, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// interactiveEdit offers a menu of editable settings with the current values
+// pre-filled and stores the answers on opts. It reports whether any setting
+// was selected.
+func interactiveEdit(opts *EditOptions, current *repoSettings) (bool, error) {
+	const (
+		optDescription = "Description"
+		optVisibility  = "Visibility"
+		optMainBranch  = "Main branch"
+		optForkPolicy  = "Fork policy"
+		optWiki        = "Wiki"
+		optIssues      = "Issue tracker"
+		optProject     = "Project"
+	)
+	menu := []string{optDescription, optVisibility, optMainBranch, optForkPolicy, optWiki, optIssues, optProject}
+
+	selected, err := opts.Prompter.MultiSelect("What do you want to edit?", nil, menu)
+	if err != nil {
+		return false, err
+	}
+	if len(selected) == 0 {
+		return false, nil
+	}
+
+	for _, i := range selected {
+		switch menu[i] {
+		case optDescription:
+			description, err := opts.Prompter.Input("Description", current.Description)
+			if err != nil {
+				return false, err
+			}
+			opts.Description = description
+		case optVisibility:
+			visibility := "public"
+			if current.IsPrivate {
+				visibility = "private"
+			}
+			choice, err := opts.Prompter.Select("Visibility", visibility, []string{"private", "public"})
+			if err != nil {
+				return false, err
+			}
+			private := choice == 0
+			opts.Private = &private
+		case optMainBranch:
+			branch := ""
+			if current.MainBranch != nil {
+				branch = current.MainBranch.Name
+			}
+			branch, err := opts.Prompter.Input("Main branch", branch)
+			if err != nil {
+				return false, err
+			}
+			opts.MainBranch = branch
+		case optForkPolicy:
+			policies := []string{"allow_forks", "no_public_forks", "no_forks"}
+			choice, err := opts.Prompter.Select("Fork policy", current.ForkPolicy, policies)
+			if err != nil {
+				return false, err
+			}
+			opts.ForkPolicy = policies[choice]
+		case optWiki:
+			enabled, err := opts.Prompter.Confirm("Enable the wiki?", current.HasWiki)
+			if err != nil {
+				return false, err
+			}
+			opts.Wiki = &enabled
+		case optIssues:
+			enabled, err := opts.Prompter.Confirm("Enable the issue tracker?", current.HasIssues)
+			if err != nil {
+				return false, err
+			}
+			opts.Issues = &enabled
+		case optProject:
+			key := ""
+			if current.Project != nil {
+				key = current.Project.Key
+			}
+			key, err := opts.Prompter.Input("Project key", key)
+			if err != nil {
+				return false, err
+			}
+			opts.Project = key
+		}
+	}
+
+	return true, nil
+}
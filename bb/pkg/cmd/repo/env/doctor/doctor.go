@@ -0,0 +1,335 @@
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/run"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/shared/gitcredentials"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+)
+
+// maxClockSkew is how far the local clock may drift from the API server's
+// before the doctor flags it; OAuth and TLS both tolerate roughly this much.
+const maxClockSkew = 2 * time.Minute
+
+type DoctorOptions struct {
+	IO              *iostreams.IOStreams
+	HttpClient      func() (*http.Client, error)
+	PlainHttpClient func() (*http.Client, error)
+	Config          func() (gh.Config, error)
+	GitClient       *git.Client
+
+	Hostname string
+}
+
+func NewCmdDoctor(f *cmdutil.Factory, runF func(*DoctorOptions) error) *cobra.Command {
+	opts := &DoctorOptions{
+		IO:              f.IOStreams,
+		HttpClient:      f.HttpClient,
+		PlainHttpClient: f.PlainHttpClient,
+		Config:          f.Config,
+		GitClient:       f.GitClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose common connectivity and setup problems",
+		Long: heredoc.Doc(`
+			Run a series of checks against the local environment and the Bitbucket
+			host: git availability and version, credential helper wiring, SSH
+			connectivity, API reachability, token validity, clock skew, and the
+			configured pager and browser. Each failing check prints a suggested fix.
+
+			The command exits with 1 when any check fails; warnings do not affect
+			the exit status.
+		`),
+		Example: heredoc.Doc(`
+			# Check the environment against the default host
+			$ bb repo env doctor
+
+			# Check against a specific host
+			$ bb repo env doctor --hostname bitbucket.example.com
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return doctorRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Check against a specific hostname")
+
+	return cmd
+}
+
+// doctor accumulates check results so the run can keep going past failures
+// and report everything at once.
+type doctor struct {
+	io       *iostreams.IOStreams
+	failures int
+	warnings int
+}
+
+func (d *doctor) pass(format string, args ...interface{}) {
+	fmt.Fprintf(d.io.Out, "%s %s\n", d.io.ColorScheme().SuccessIcon(), fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) warn(format string, args ...interface{}) {
+	d.warnings++
+	fmt.Fprintf(d.io.Out, "%s %s\n", d.io.ColorScheme().WarningIcon(), fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) fail(format string, args ...interface{}) {
+	d.failures++
+	fmt.Fprintf(d.io.Out, "%s %s\n", d.io.ColorScheme().FailureIcon(), fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) fix(format string, args ...interface{}) {
+	fmt.Fprintf(d.io.Out, "  - %s\n", fmt.Sprintf(format, args...))
+}
+
+func doctorRun(opts *DoctorOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname := opts.Hostname
+	if hostname == "" {
+		hostname, _ = cfg.Authentication().DefaultHost()
+	}
+
+	ctx := context.Background()
+	cs := opts.IO.ColorScheme()
+	d := &doctor{io: opts.IO}
+
+	fmt.Fprintf(opts.IO.Out, "Checking environment for %s\n\n", cs.Bold(hostname))
+
+	checkGit(ctx, d, opts)
+	checkCredentialHelper(d, opts, hostname)
+	checkSSH(d, cfg, hostname)
+	serverTime := checkAPI(d, opts, hostname)
+	checkToken(d, opts, cfg, hostname)
+	checkClock(d, serverTime)
+	checkProgram(d, "pager", cfg.Pager(hostname).Value)
+	checkProgram(d, "browser", cfg.Browser(hostname).Value)
+
+	fmt.Fprintln(opts.IO.Out)
+	if d.failures > 0 {
+		fmt.Fprintf(opts.IO.Out, "%s found\n", text.Pluralize(d.failures, "problem"))
+		return cmdutil.SilentError
+	}
+	if d.warnings > 0 {
+		fmt.Fprintf(opts.IO.Out, "No problems found (%s)\n", text.Pluralize(d.warnings, "warning"))
+	} else {
+		fmt.Fprintln(opts.IO.Out, "No problems found")
+	}
+	return nil
+}
+
+func checkGit(ctx context.Context, d *doctor, opts *DoctorOptions) {
+	gitCmd, err := opts.GitClient.Command(ctx, "version")
+	if err != nil {
+		d.fail("git is not available: %v", err)
+		d.fix("Install git and make sure it is on your PATH")
+		return
+	}
+	out, err := gitCmd.Output()
+	if err != nil {
+		d.fail("git is not available: %v", err)
+		d.fix("Install git and make sure it is on your PATH")
+		return
+	}
+	d.pass("git is installed (%s)", parseGitVersion(string(out)))
+}
+
+// parseGitVersion extracts "2.39.2" from "git version 2.39.2 (Apple Git-143)".
+func parseGitVersion(out string) string {
+	version := strings.TrimPrefix(strings.TrimSpace(out), "git version ")
+	if i := strings.IndexByte(version, ' '); i > 0 {
+		version = version[:i]
+	}
+	return version
+}
+
+func checkCredentialHelper(d *doctor, opts *DoctorOptions, hostname string) {
+	hc := gitcredentials.HelperConfig{GitClient: opts.GitClient}
+	helper, err := hc.ConfiguredHelper(hostname)
+	if err != nil {
+		d.warn("could not determine the git credential helper: %v", err)
+		return
+	}
+	switch {
+	case helper.IsOurs():
+		d.pass("git credential helper is configured to use bb")
+	case helper.IsConfigured():
+		d.warn("git credential helper is %q, not bb", helper.Cmd)
+		d.fix("Run `bb auth setupgit` to let bb supply git credentials for %s", hostname)
+	default:
+		d.warn("no git credential helper is configured")
+		d.fix("Run `bb auth setupgit` to let bb supply git credentials for %s", hostname)
+	}
+}
+
+func checkSSH(d *doctor, cfg gh.Config, hostname string) {
+	sshPath, err := exec.LookPath("ssh")
+	if err != nil {
+		d.warn("ssh is not installed; skipping SSH connectivity check")
+		return
+	}
+
+	sshCmd := exec.Command(sshPath, "-T",
+		"-o", "BatchMode=yes",
+		"-o", "StrictHostKeyChecking=accept-new",
+		"-o", "ConnectTimeout=5",
+		"git@"+hostname)
+	err = run.PrepareCmd(sshCmd).Run()
+	if err == nil {
+		d.pass("SSH connection to git@%s succeeded", hostname)
+		return
+	}
+
+	// Failing SSH only matters when git operations use it
+	if cfg.GitProtocol(hostname).Value == "ssh" {
+		d.fail("SSH connection to git@%s failed: %v", hostname, err)
+		d.fix("Add an SSH key with `bb ssh-key add`, or switch protocols with `bb config set git_protocol https`")
+	} else {
+		d.warn("SSH connection to git@%s failed (git protocol is https, so this is unused)", hostname)
+	}
+}
+
+// checkAPI verifies the REST API answers at all, without credentials, and
+// returns the server time from the response for the clock skew check.
+func checkAPI(d *doctor, opts *DoctorOptions, hostname string) time.Time {
+	httpClient, err := opts.PlainHttpClient()
+	if err != nil {
+		d.fail("could not build an HTTP client: %v", err)
+		return time.Time{}
+	}
+
+	url := api.RESTPrefix(hostname) + "repositories?pagelen=1"
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		d.fail("API reachability check failed: %v", err)
+		return time.Time{}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		d.fail("could not reach the API at %s: %v", api.RESTPrefix(hostname), err)
+		d.fix("Check your network connection, proxy settings, and the http_proxy and ca_bundle config keys")
+		return time.Time{}
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	d.pass("API at %s is reachable (HTTP %d)", api.RESTPrefix(hostname), resp.StatusCode)
+
+	serverTime, _ := http.ParseTime(resp.Header.Get("Date"))
+	return serverTime
+}
+
+func checkToken(d *doctor, opts *DoctorOptions, cfg gh.Config, hostname string) {
+	if !cfg.Authentication().HasActiveToken(hostname) {
+		d.fail("no authentication token for %s", hostname)
+		d.fix("Run `bb auth login -h %s` to authenticate", hostname)
+		return
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		d.fail("could not build an HTTP client: %v", err)
+		return
+	}
+
+	req, err := http.NewRequest("GET", api.RESTPrefix(hostname)+"user", nil)
+	if err != nil {
+		d.fail("token check failed: %v", err)
+		return
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		d.fail("token check failed: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		d.fail("the token for %s was rejected (HTTP %d)", hostname, resp.StatusCode)
+		d.fix("Run `bb auth login -h %s` to re-authenticate", hostname)
+		return
+	}
+
+	var user struct {
+		Username string `json:"username"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&user)
+	if user.Username != "" {
+		d.pass("token is valid; authenticated as %s", user.Username)
+	} else {
+		d.pass("token is valid")
+	}
+
+	if scopes := resp.Header.Get("X-Oauth-Scopes"); scopes != "" {
+		d.pass("token scopes: %s", scopes)
+	} else {
+		d.warn("the API did not report the token's scopes")
+	}
+}
+
+func checkClock(d *doctor, serverTime time.Time) {
+	if serverTime.IsZero() {
+		d.warn("could not determine the server time; skipping clock skew check")
+		return
+	}
+	skew := time.Since(serverTime)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxClockSkew {
+		d.fail("system clock is off by about %s", skew.Round(time.Second))
+		d.fix("Sync your system clock; large skew breaks TLS and OAuth")
+	} else {
+		d.pass("system clock is within %s of the server", maxClockSkew)
+	}
+}
+
+// checkProgram verifies that a configured helper program (pager, browser)
+// resolves to an executable. A blank value falls back to environment defaults
+// and is fine.
+func checkProgram(d *doctor, name, value string) {
+	if value == "" {
+		d.pass("no %s configured; environment defaults apply", name)
+		return
+	}
+	args, err := shlex.Split(value)
+	if err != nil || len(args) == 0 {
+		d.fail("the configured %s %q could not be parsed", name, value)
+		d.fix("Fix it with `bb config set %s <command>`", name)
+		return
+	}
+	if _, err := exec.LookPath(args[0]); err != nil {
+		d.fail("the configured %s %q was not found on PATH", name, args[0])
+		d.fix("Install %s or change it with `bb config set %s <command>`", args[0], name)
+		return
+	}
+	d.pass("%s is set to %q and is executable", name, value)
+}
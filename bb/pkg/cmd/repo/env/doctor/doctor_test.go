@@ -0,0 +1,20 @@
+package doctor
+
+import "testing"
+
+func TestParseGitVersion(t *testing.T) {
+	tests := []struct {
+		out  string
+		want string
+	}{
+		{"git version 2.39.2\n", "2.39.2"},
+		{"git version 2.39.2 (Apple Git-143)\n", "2.39.2"},
+		{"git version 2.45.0.windows.1\n", "2.45.0.windows.1"},
+	}
+
+	for _, tt := range tests {
+		if got := parseGitVersion(tt.out); got != tt.want {
+			t.Errorf("parseGitVersion(%q) = %q, want %q", tt.out, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package env
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	doctorCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/env/doctor"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdEnv(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "env <command>",
+		Short: "Inspect the local environment bb runs in",
+		Example: heredoc.Doc(`
+			$ bb repo env doctor
+		`),
+	}
+
+	cmd.AddCommand(doctorCmd.NewCmdDoctor(f, nil))
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package fork
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,9 +10,13 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	ghContext "github.com/dlbroadfoot/bitbucket-cli/context"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/shared"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -19,22 +24,32 @@ import (
 
 type ForkOptions struct {
 	HttpClient func() (*http.Client, error)
+	GitClient  *git.Client
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (bbrepo.Interface, error)
-
-	Repository string
-	Workspace  string
-	Name       string
-	Clone      bool
+	Remotes    func() (ghContext.Remotes, error)
+	Prompter   prompter.Prompter
+
+	Repository        string
+	Workspace         string
+	Name              string
+	Clone             bool
+	Remote            bool
+	RemoteName        string
+	DefaultRemoteName bool
+	SyncSchedule      string
 }
 
 func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Command {
 	opts := &ForkOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		GitClient:  f.GitClient,
 		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
+		Remotes:    f.Remotes,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -46,7 +61,9 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 			Without an argument, creates a fork of the current repository.
 			With a WORKSPACE/REPO argument, forks that repository.
 
-			By default, the fork is created in your personal workspace with the same name.
+			Without --workspace, the fork is created in the configured
+			default_workspace, or in your personal workspace after a
+			confirmation prompt.
 		`),
 		Example: heredoc.Doc(`
 			# Fork the current repository
@@ -63,6 +80,13 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 
 			# Fork and clone
 			$ bb repo fork owner/repo --clone
+
+			# Fork the current repository and add it as the 'origin' remote,
+			# renaming the existing 'origin' to 'upstream'
+			$ bb repo fork --remote
+
+			# Fork the current repository and record a weekly upstream sync reminder
+			$ bb repo fork --remote --sync-schedule weekly
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -70,6 +94,15 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 				opts.Repository = args[0]
 			}
 
+			opts.DefaultRemoteName = !cmd.Flags().Changed("remote-name")
+
+			if opts.Remote && opts.Repository != "" {
+				return cmdutil.FlagErrorf("--remote is only supported when forking the current repository")
+			}
+			if opts.SyncSchedule != "" && opts.Repository != "" {
+				return cmdutil.FlagErrorf("--sync-schedule is only supported when forking the current repository")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -78,8 +111,12 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace to create the fork in")
+	_ = cmd.RegisterFlagCompletionFunc("workspace", shared.WorkspaceCompletionFunc(f))
 	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Name for the forked repository")
 	cmd.Flags().BoolVarP(&opts.Clone, "clone", "c", false, "Clone the fork after creation")
+	cmd.Flags().BoolVar(&opts.Remote, "remote", false, "Add the fork as a git remote in the current clone")
+	cmd.Flags().StringVar(&opts.RemoteName, "remote-name", "origin", "Name for the fork's git remote")
+	cmdutil.StringEnumFlag(cmd, &opts.SyncSchedule, "sync-schedule", "", "", []string{"daily", "weekly", "monthly"}, "Record a cadence for syncing the fork from upstream")
 
 	return cmd
 }
@@ -112,19 +149,18 @@ func forkRun(opts *ForkOptions) error {
 		sourceRepo = repo.RepoSlug()
 	}
 
-	// Determine target workspace
+	// Determine target workspace, confirming the personal-workspace
+	// fallback before creating anything
 	targetWorkspace := opts.Workspace
 	if targetWorkspace == "" {
 		cfg, err := opts.Config()
 		if err != nil {
 			return err
 		}
-		authCfg := cfg.Authentication()
-		user, err := authCfg.ActiveUser(bbinstance.Default())
-		if err != nil || user == "" {
-			return fmt.Errorf("could not determine target workspace: use --workspace flag")
+		targetWorkspace, err = shared.ResolveTargetWorkspace(cfg, opts.IO, opts.Prompter, "Create the fork")
+		if err != nil {
+			return err
 		}
-		targetWorkspace = user
 	}
 
 	// Determine target name
@@ -162,6 +198,18 @@ func forkRun(opts *ForkOptions) error {
 		fmt.Fprintln(opts.IO.Out, repoURL)
 	}
 
+	if opts.Remote {
+		if err := addForkRemote(opts, targetWorkspace, fork.Slug); err != nil {
+			return err
+		}
+	}
+
+	if opts.SyncSchedule != "" {
+		if err := recordSyncSchedule(opts, sourceWorkspace, sourceRepo); err != nil {
+			return err
+		}
+	}
+
 	if opts.Clone && cloneURL != "" {
 		fmt.Fprintf(opts.IO.Out, "\nTo clone this fork:\n  git clone %s\n", cloneURL)
 	}
@@ -169,6 +217,100 @@ func forkRun(opts *ForkOptions) error {
 	return nil
 }
 
+// addForkRemote wires the newly created fork into the current clone as a git
+// remote and makes it the default push target.
+func addForkRemote(opts *ForkOptions, forkWorkspace, forkSlug string) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	ctx := context.Background()
+	gitClient := opts.GitClient
+
+	remoteName := opts.RemoteName
+	if _, err := remotes.FindByName(remoteName); err == nil {
+		if opts.DefaultRemoteName {
+			// rename the existing remote out of the way so the fork can take its place
+			renamedName := "upstream"
+			if _, err := remotes.FindByName(renamedName); err == nil {
+				return fmt.Errorf("both %q and %q remotes already exist; use --remote-name to pick a different name for the fork remote", remoteName, renamedName)
+			}
+			renameCmd, err := gitClient.Command(ctx, "remote", "rename", remoteName, renamedName)
+			if err != nil {
+				return err
+			}
+			if err := renameCmd.Run(); err != nil {
+				return err
+			}
+			if opts.IO.IsStdoutTTY() {
+				fmt.Fprintf(opts.IO.Out, "%s Renamed remote %s to %s\n", cs.SuccessIcon(), cs.Bold(remoteName), cs.Bold(renamedName))
+			}
+		} else {
+			return fmt.Errorf("a git remote named %q already exists", remoteName)
+		}
+	}
+
+	forkRepo := bbrepo.NewWithHost(forkWorkspace, forkSlug, bbinstance.Default())
+	protocol := shared.PreferredGitProtocol(ctx, gitClient, cfg, nil, forkRepo.RepoHost())
+	remoteURL := bbrepo.FormatRemoteURL(forkRepo, protocol)
+
+	if _, err := gitClient.AddRemote(ctx, remoteName, remoteURL, []string{}); err != nil {
+		return fmt.Errorf("failed to add remote: %w", err)
+	}
+
+	// make new branches push to the fork by default
+	configCmd, err := gitClient.Command(ctx, "config", "remote.pushDefault", remoteName)
+	if err != nil {
+		return err
+	}
+	if err := configCmd.Run(); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Added remote %s\n", cs.SuccessIcon(), cs.Bold(remoteName))
+	}
+
+	return nil
+}
+
+// recordSyncSchedule stores the upstream repository and the chosen sync
+// cadence in the clone's local git config so that `bb repo sync
+// --from-upstream` knows where the fork came from, and reminds the user how
+// to keep the default branch fresh.
+func recordSyncSchedule(opts *ForkOptions, sourceWorkspace, sourceRepo string) error {
+	ctx := context.Background()
+	gitClient := opts.GitClient
+	cs := opts.IO.ColorScheme()
+
+	for _, kv := range [][2]string{
+		{"bb.forkUpstream", sourceWorkspace + "/" + sourceRepo},
+		{"bb.syncSchedule", opts.SyncSchedule},
+	} {
+		configCmd, err := gitClient.Command(ctx, "config", kv[0], kv[1])
+		if err != nil {
+			return err
+		}
+		if err := configCmd.Run(); err != nil {
+			return fmt.Errorf("failed to record sync schedule: %w", err)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Recorded %s upstream sync schedule\n", cs.SuccessIcon(), opts.SyncSchedule)
+		fmt.Fprintf(opts.IO.Out, "  Run %s to keep your default branch updated\n", cs.Bold("bb repo sync --from-upstream"))
+	}
+
+	return nil
+}
+
 type forkRepoResponse struct {
 	Slug        string `json:"slug"`
 	Name        string `json:"name"`
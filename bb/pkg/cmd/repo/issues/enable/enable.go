@@ -0,0 +1,173 @@
+package enable
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type EnableOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	TemplateFile string
+}
+
+func NewCmdEnable(f *cmdutil.Factory, runF func(*EnableOptions) error) *cobra.Command {
+	opts := &EnableOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Enable the issue tracker",
+		Long: heredoc.Doc(`
+			Enable the issue tracker on a repository.
+
+			With --template, also seed the tracker's metadata from a YAML file.
+			The file can declare kinds, components, milestones, and versions,
+			each as a list of names:
+
+			    components:
+			      - backend
+			      - frontend
+			    milestones:
+			      - "1.0"
+			    versions:
+			      - "0.9"
+		`),
+		Example: heredoc.Doc(`
+			$ bb repo issues enable
+			$ bb repo issues enable --template tracker.yml
+			$ bb repo issues enable -R myworkspace/myrepo
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return enableRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.TemplateFile, "template", "", "Seed tracker metadata from a YAML `file`")
+
+	return cmd
+}
+
+// trackerTemplate declares issue tracker metadata to create after enabling
+// the tracker, read from a YAML file passed via --template.
+type trackerTemplate struct {
+	Kinds      []string `yaml:"kinds"`
+	Components []string `yaml:"components"`
+	Milestones []string `yaml:"milestones"`
+	Versions   []string `yaml:"versions"`
+}
+
+func loadTemplate(path string) (*trackerTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+
+	var template trackerTemplate
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+	}
+
+	return &template, nil
+}
+
+func enableRun(opts *EnableOptions) error {
+	var template *trackerTemplate
+	if opts.TemplateFile != "" {
+		var err error
+		template, err = loadTemplate(opts.TemplateFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+
+	path := fmt.Sprintf("repositories/%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
+	payload := map[string]interface{}{"has_issues": true}
+
+	opts.IO.StartProgressIndicator()
+	err = apiClient.Put(repo.RepoHost(), path, payload, nil)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to enable the issue tracker: %w", err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Enabled the issue tracker on %s/%s\n",
+			cs.SuccessIcon(), repo.RepoWorkspace(), repo.RepoSlug())
+	}
+
+	if template == nil {
+		return nil
+	}
+
+	return applyTemplate(apiClient, opts.IO, repo, template)
+}
+
+// applyTemplate creates the metadata objects declared in the template, one
+// API call per object. It reports each step so a partial failure shows how
+// far the seeding got.
+func applyTemplate(apiClient *api.Client, io *iostreams.IOStreams, repo bbrepo.Interface, template *trackerTemplate) error {
+	cs := io.ColorScheme()
+
+	progress := func(format string, args ...interface{}) {
+		if io.IsStdoutTTY() {
+			fmt.Fprintf(io.Out, "%s "+format+"\n", append([]interface{}{cs.SuccessIcon()}, args...)...)
+		}
+	}
+
+	collections := []struct {
+		label string
+		path  string
+		names []string
+	}{
+		{"kind", "issue-kinds", template.Kinds},
+		{"component", "components", template.Components},
+		{"milestone", "milestones", template.Milestones},
+		{"version", "versions", template.Versions},
+	}
+
+	for _, collection := range collections {
+		for _, name := range collection.names {
+			payload := map[string]interface{}{"name": name}
+			path := fmt.Sprintf("repositories/%s/%s/%s", repo.RepoWorkspace(), repo.RepoSlug(), collection.path)
+			if err := apiClient.Post(repo.RepoHost(), path, payload, nil); err != nil {
+				return fmt.Errorf("failed to create %s %s: %w", collection.label, name, err)
+			}
+			progress("Created %s %s", collection.label, cs.Bold(name))
+		}
+	}
+
+	return nil
+}
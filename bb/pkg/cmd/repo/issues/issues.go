@@ -0,0 +1,26 @@
+package issues
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	issuesEnableCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/issues/enable"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdIssues(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issues <command>",
+		Short: "Manage the repository issue tracker",
+		Long:  `Manage the issue tracker settings of a repository.`,
+		Example: heredoc.Doc(`
+			$ bb repo issues enable
+			$ bb repo issues enable --template tracker.yml
+		`),
+	}
+
+	cmdutil.EnableRepoOverride(cmd, f)
+
+	cmd.AddCommand(issuesEnableCmd.NewCmdEnable(f, nil))
+
+	return cmd
+}
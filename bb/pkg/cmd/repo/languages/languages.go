@@ -0,0 +1,334 @@
+package languages
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type LanguagesOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	RepoArg string
+	Branch  string
+	Limit   int
+}
+
+func NewCmdLanguages(f *cmdutil.Factory, runF func(*LanguagesOptions) error) *cobra.Command {
+	opts := &LanguagesOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "languages [<repository>]",
+		Short: "Show an approximate language breakdown of a repository",
+		Long: heredoc.Doc(`
+			Analyze the file listing at a branch and report an approximate
+			language breakdown by file size, classified by file extension.
+
+			Bitbucket stores only a single language field per repository, so the
+			breakdown is computed client-side from a sample of the source
+			listing. Use --limit to widen or narrow the sample.
+		`),
+		Example: heredoc.Doc(`
+			# Breakdown of the current repository's default branch
+			$ bb repo languages
+
+			# Breakdown of another repository
+			$ bb repo languages workspace/repo
+
+			# Breakdown of a specific branch, sampling up to 5000 files
+			$ bb repo languages --branch develop --limit 5000
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.RepoArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return languagesRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Analyze a specific branch instead of the default branch")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 1000, "Maximum number of files to sample")
+
+	return cmd
+}
+
+func languagesRun(opts *LanguagesOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var repo bbrepo.Interface
+	if opts.RepoArg != "" {
+		repo, err = bbrepo.FromFullName(opts.RepoArg)
+		if err != nil {
+			return fmt.Errorf("argument error: %w", err)
+		}
+	} else {
+		repo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	ref := opts.Branch
+	if ref == "" {
+		var repoInfo api.Repository
+		repoPath := fmt.Sprintf("repositories/%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
+		if err := apiClient.Get(repo.RepoHost(), repoPath, &repoInfo); err != nil {
+			return err
+		}
+		if repoInfo.MainBranch != nil {
+			ref = repoInfo.MainBranch.Name
+		}
+		if ref == "" {
+			ref = "HEAD"
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	files, truncated, err := fetchFileSample(apiClient, repo, ref, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(files) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No files found at this ref")
+		return nil
+	}
+
+	stats := classify(files)
+
+	if opts.IO.IsStdoutTTY() {
+		sampled := text.Pluralize(len(files), "file")
+		if truncated {
+			sampled += " (sampled)"
+		}
+		fmt.Fprintf(opts.IO.Out, "Approximate language breakdown of %s/%s at %s, from %s\n\n",
+			repo.RepoWorkspace(), repo.RepoSlug(), ref, sampled)
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("LANGUAGE", "FILES", "SHARE"))
+	for _, stat := range stats {
+		tp.AddField(stat.Language)
+		tp.AddField(fmt.Sprintf("%d", stat.Files))
+		tp.AddField(fmt.Sprintf("%.1f%%", stat.Share))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// srcEntry is one item of a src listing.
+type srcEntry struct {
+	Type string `json:"type"` // "commit_file", "commit_directory"
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// fetchFileSample lists files at ref recursively, stopping after limit files.
+// The second return value reports whether the listing was cut short.
+func fetchFileSample(apiClient *api.Client, repo bbrepo.Interface, ref string, limit int) ([]srcEntry, bool, error) {
+	var files []srcEntry
+
+	requestPath := fmt.Sprintf("repositories/%s/%s/src/%s/?max_depth=16&pagelen=100",
+		repo.RepoWorkspace(), repo.RepoSlug(), ref)
+	nextURL := ""
+	for {
+		var page struct {
+			Values []srcEntry `json:"values"`
+			Next   string     `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			nextURL, err = apiClient.RESTWithNext(repo.RepoHost(), http.MethodGet, requestPath, nil, &page)
+		} else {
+			nextURL, err = apiClient.RESTWithNextURL(http.MethodGet, nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, entry := range page.Values {
+			if entry.Type != "commit_file" {
+				continue
+			}
+			files = append(files, entry)
+			if len(files) >= limit {
+				return files, nextURL != "", nil
+			}
+		}
+
+		if nextURL == "" {
+			return files, false, nil
+		}
+	}
+}
+
+type languageStat struct {
+	Language string
+	Files    int
+	Bytes    int64
+	Share    float64
+}
+
+// classify groups files by language and returns stats ordered by share of
+// total bytes, largest first.
+func classify(files []srcEntry) []languageStat {
+	byLanguage := map[string]*languageStat{}
+	var totalBytes int64
+
+	for _, file := range files {
+		language := languageForPath(file.Path)
+		stat, ok := byLanguage[language]
+		if !ok {
+			stat = &languageStat{Language: language}
+			byLanguage[language] = stat
+		}
+		stat.Files++
+		stat.Bytes += file.Size
+		totalBytes += file.Size
+	}
+
+	stats := make([]languageStat, 0, len(byLanguage))
+	for _, stat := range byLanguage {
+		if totalBytes > 0 {
+			stat.Share = float64(stat.Bytes) / float64(totalBytes) * 100
+		}
+		stats = append(stats, *stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Bytes != stats[j].Bytes {
+			return stats[i].Bytes > stats[j].Bytes
+		}
+		return stats[i].Language < stats[j].Language
+	})
+
+	return stats
+}
+
+// languageForPath maps a file path to a language name based on its extension
+// or well-known filename.
+func languageForPath(path string) string {
+	base := path
+	if i := strings.LastIndex(base, "/"); i >= 0 {
+		base = base[i+1:]
+	}
+
+	if language, ok := specialFilenames[strings.ToLower(base)]; ok {
+		return language
+	}
+
+	if i := strings.LastIndex(base, "."); i > 0 {
+		if language, ok := extensionLanguages[strings.ToLower(base[i+1:])]; ok {
+			return language
+		}
+	}
+
+	return "Other"
+}
+
+var specialFilenames = map[string]string{
+	"dockerfile":  "Dockerfile",
+	"makefile":    "Makefile",
+	"rakefile":    "Ruby",
+	"gemfile":     "Ruby",
+	"jenkinsfile": "Groovy",
+}
+
+var extensionLanguages = map[string]string{
+	"go":     "Go",
+	"py":     "Python",
+	"rb":     "Ruby",
+	"js":     "JavaScript",
+	"jsx":    "JavaScript",
+	"mjs":    "JavaScript",
+	"ts":     "TypeScript",
+	"tsx":    "TypeScript",
+	"java":   "Java",
+	"kt":     "Kotlin",
+	"kts":    "Kotlin",
+	"scala":  "Scala",
+	"groovy": "Groovy",
+	"c":      "C",
+	"h":      "C",
+	"cc":     "C++",
+	"cpp":    "C++",
+	"cxx":    "C++",
+	"hpp":    "C++",
+	"cs":     "C#",
+	"fs":     "F#",
+	"rs":     "Rust",
+	"swift":  "Swift",
+	"m":      "Objective-C",
+	"mm":     "Objective-C",
+	"php":    "PHP",
+	"pl":     "Perl",
+	"pm":     "Perl",
+	"lua":    "Lua",
+	"r":      "R",
+	"dart":   "Dart",
+	"ex":     "Elixir",
+	"exs":    "Elixir",
+	"erl":    "Erlang",
+	"hs":     "Haskell",
+	"clj":    "Clojure",
+	"elm":    "Elm",
+	"sh":     "Shell",
+	"bash":   "Shell",
+	"zsh":    "Shell",
+	"ps1":    "PowerShell",
+	"sql":    "SQL",
+	"html":   "HTML",
+	"htm":    "HTML",
+	"css":    "CSS",
+	"scss":   "SCSS",
+	"sass":   "SCSS",
+	"less":   "Less",
+	"vue":    "Vue",
+	"svelte": "Svelte",
+	"md":     "Markdown",
+	"rst":    "reStructuredText",
+	"tex":    "TeX",
+	"yml":    "YAML",
+	"yaml":   "YAML",
+	"json":   "JSON",
+	"toml":   "TOML",
+	"xml":    "XML",
+	"proto":  "Protocol Buffers",
+	"tf":     "HCL",
+	"hcl":    "HCL",
+	"gradle": "Gradle",
+	"cmake":  "CMake",
+	"zig":    "Zig",
+	"nim":    "Nim",
+	"jl":     "Julia",
+	"vb":     "Visual Basic",
+	"asm":    "Assembly",
+	"s":      "Assembly",
+}
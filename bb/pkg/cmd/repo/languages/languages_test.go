@@ -0,0 +1,62 @@
+package languages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLanguageForPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"main.go", "Go"},
+		{"pkg/cmd/repo/languages/languages.go", "Go"},
+		{"src/App.TSX", "TypeScript"},
+		{"scripts/build.sh", "Shell"},
+		{"Dockerfile", "Dockerfile"},
+		{"docker/Dockerfile", "Dockerfile"},
+		{"Makefile", "Makefile"},
+		{"README.md", "Markdown"},
+		{"config.yaml", "YAML"},
+		{"LICENSE", "Other"},
+		{".gitignore", "Other"},
+		{"binary.xyz123", "Other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, languageForPath(tt.path))
+		})
+	}
+}
+
+func TestClassify(t *testing.T) {
+	files := []srcEntry{
+		{Type: "commit_file", Path: "main.go", Size: 600},
+		{Type: "commit_file", Path: "util.go", Size: 200},
+		{Type: "commit_file", Path: "script.py", Size: 150},
+		{Type: "commit_file", Path: "README.md", Size: 50},
+	}
+
+	stats := classify(files)
+
+	assert.Equal(t, []languageStat{
+		{Language: "Go", Files: 2, Bytes: 800, Share: 80},
+		{Language: "Python", Files: 1, Bytes: 150, Share: 15},
+		{Language: "Markdown", Files: 1, Bytes: 50, Share: 5},
+	}, stats)
+}
+
+func TestClassifyEmptySizes(t *testing.T) {
+	stats := classify([]srcEntry{
+		{Type: "commit_file", Path: "a.go"},
+		{Type: "commit_file", Path: "b.py"},
+	})
+
+	for _, stat := range stats {
+		assert.Zero(t, stat.Share)
+	}
+	assert.Len(t, stats, 2)
+}
@@ -19,14 +19,17 @@ type RepositoryList struct {
 }
 
 type FilterOptions struct {
-	Visibility  string // private, public
-	Fork        bool
-	Source      bool
-	Language    string
-	Topic       []string
-	Archived    bool
-	NonArchived bool
-	Fields      []string
+	Visibility   string // private, public
+	Fork         bool
+	Source       bool
+	Language     string
+	Project      string // project key
+	Sort         string // API sort field, "-" prefix for descending
+	UpdatedSince string // RFC 3339 lower bound on updated_on
+	Topic        []string
+	Archived     bool
+	NonArchived  bool
+	Fields       []string
 }
 
 // listRepos lists repositories for a workspace using Bitbucket REST API.
@@ -55,9 +58,29 @@ func listUserRepos(client *http.Client, hostname string, limit int, filter Filte
 	} else if filter.Visibility == "public" {
 		queryParts = append(queryParts, "repository.is_private=false")
 	}
+	if filter.Fork {
+		queryParts = append(queryParts, "repository.parent != null")
+	} else if filter.Source {
+		queryParts = append(queryParts, "repository.parent = null")
+	}
+	if filter.Project != "" {
+		queryParts = append(queryParts, fmt.Sprintf("repository.project.key=%q", filter.Project))
+	}
+	if filter.UpdatedSince != "" {
+		queryParts = append(queryParts, fmt.Sprintf("repository.updated_on>%s", filter.UpdatedSince))
+	}
 	if len(queryParts) > 0 {
 		params.Set("q", strings.Join(queryParts, " AND "))
 	}
+	if filter.Sort != "" {
+		sort := filter.Sort
+		if field, ok := strings.CutPrefix(sort, "-"); ok {
+			sort = "-repository." + field
+		} else {
+			sort = "repository." + sort
+		}
+		params.Set("sort", sort)
+	}
 
 	fullURL := apiURL + "?" + params.Encode()
 
@@ -93,13 +116,8 @@ func listUserRepos(client *http.Client, hostname string, limit int, filter Filte
 
 		for _, v := range pageResp.Values {
 			repo := v.Repository
-			// Apply client-side filters
-			if filter.Fork && repo.Parent == nil {
-				continue
-			}
-			if filter.Source && repo.Parent != nil {
-				continue
-			}
+			// Language is the only filter still applied client-side here; the
+			// permissions endpoint does not support querying it
 			if filter.Language != "" && !strings.EqualFold(repo.Language, filter.Language) {
 				continue
 			}
@@ -138,9 +156,23 @@ func listWorkspaceRepos(client *http.Client, hostname string, limit int, workspa
 	if filter.Language != "" {
 		queryParts = append(queryParts, fmt.Sprintf("language=%q", filter.Language))
 	}
+	if filter.Fork {
+		queryParts = append(queryParts, "parent != null")
+	} else if filter.Source {
+		queryParts = append(queryParts, "parent = null")
+	}
+	if filter.Project != "" {
+		queryParts = append(queryParts, fmt.Sprintf("project.key=%q", filter.Project))
+	}
+	if filter.UpdatedSince != "" {
+		queryParts = append(queryParts, fmt.Sprintf("updated_on>%s", filter.UpdatedSince))
+	}
 	if len(queryParts) > 0 {
 		params.Set("q", strings.Join(queryParts, " AND "))
 	}
+	if filter.Sort != "" {
+		params.Set("sort", filter.Sort)
+	}
 
 	fullURL := apiURL + "?" + params.Encode()
 
@@ -168,14 +200,6 @@ func listWorkspaceRepos(client *http.Client, hostname string, limit int, workspa
 		}
 
 		for _, repo := range pageResp.Values {
-			// Apply client-side filters
-			if filter.Fork && repo.Parent == nil {
-				continue
-			}
-			if filter.Source && repo.Parent != nil {
-				continue
-			}
-
 			result.Repositories = append(result.Repositories, repo)
 			if len(result.Repositories) >= limit {
 				break
@@ -9,8 +9,9 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
 
-	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bburl"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
@@ -22,24 +23,39 @@ type ListOptions struct {
 	HttpClient func() (*http.Client, error)
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
+	Browser    browser.Browser
 	Exporter   cmdutil.Exporter
 
+	Web       bool
 	Limit     int
 	Workspace string
 
-	Visibility string
-	Fork       bool
-	Source     bool
-	Language   string
+	Visibility   string
+	Fork         bool
+	Source       bool
+	Language     string
+	Project      string
+	Sort         string
+	Order        string
+	UpdatedSince string
+	Columns      []string
 
 	Now func() time.Time
 }
 
+// listColumns registers every column this command can render. The names also
+// serve as the field list for --json.
+var listColumns = cmdutil.NewColumnSet(
+	[]string{"name", "description", "info", "updated"},
+	"language", "created",
+)
+
 func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
 	opts := ListOptions{
 		IO:         f.IOStreams,
 		Config:     f.Config,
 		HttpClient: f.HttpClient,
+		Browser:    f.Browser,
 		Now:        time.Now,
 	}
 
@@ -72,6 +88,9 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			if opts.Source && opts.Fork {
 				return cmdutil.FlagErrorf("specify only one of `--source` or `--fork`")
 			}
+			if opts.Order != "" && opts.Sort == "" {
+				return cmdutil.FlagErrorf("`--order` requires `--sort`")
+			}
 
 			if flagPrivate {
 				opts.Visibility = "private"
@@ -94,7 +113,16 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().BoolVar(&opts.Source, "source", false, "Show only non-forks")
 	cmd.Flags().BoolVar(&opts.Fork, "fork", false, "Show only forks")
 	cmd.Flags().StringVarP(&opts.Language, "language", "l", "", "Filter by primary coding language")
+	cmd.Flags().StringVar(&opts.Project, "project", "", "Filter by project `key`")
 	cmdutil.StringEnumFlag(cmd, &opts.Visibility, "visibility", "", "", []string{"public", "private"}, "Filter by repository visibility")
+	cmdutil.StringEnumFlag(cmd, &opts.Sort, "sort", "", "", []string{"name", "updated", "size"}, "Sort repositories by the given field")
+	cmdutil.StringEnumFlag(cmd, &opts.Order, "order", "", "", []string{"asc", "desc"}, "Direction of the sort; requires `--sort`")
+	cmd.Flags().StringVar(&opts.UpdatedSince, "updated-since", "", "Show only repositories updated within a `duration` (e.g. 720h)")
+	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the workspace's repository list in the browser")
+
+	cmdutil.AddColumnsFlag(cmd, &opts.Columns, listColumns)
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, listColumns.Fields())
+	cmdutil.AddExportFormatFlags(cmd, &opts.Exporter, listColumns.Fields())
 
 	cmd.Flags().BoolVar(&flagPrivate, "private", false, "Show only private repositories")
 	cmd.Flags().BoolVar(&flagPublic, "public", false, "Show only public repositories")
@@ -121,11 +149,52 @@ func listRun(opts *ListOptions) error {
 		host = bbinstance.Default()
 	}
 
+	if opts.Web {
+		workspace := opts.Workspace
+		if workspace == "" {
+			workspace = cfg.DefaultWorkspace(host).Value
+		}
+		if workspace == "" {
+			return cmdutil.FlagErrorf("--web requires a workspace; pass one or set a default with `bb config set default_workspace <workspace>`")
+		}
+		// A project filter narrows the destination to the project's page
+		if opts.Project != "" {
+			return cmdutil.OpenInBrowser(opts.IO, opts.Browser, bburl.Project(host, workspace, opts.Project), false)
+		}
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, bburl.Workspace(host, workspace), false)
+	}
+
+	columns, err := cmdutil.ResolveColumns(cfg, "repo_list_columns", opts.Columns, listColumns)
+	if err != nil {
+		return err
+	}
+
 	filter := FilterOptions{
 		Visibility: opts.Visibility,
 		Fork:       opts.Fork,
 		Source:     opts.Source,
 		Language:   opts.Language,
+		Project:    opts.Project,
+	}
+
+	if opts.Sort != "" {
+		// The API sorts ascending unless the field is prefixed with "-"
+		sortField := opts.Sort
+		if sortField == "updated" {
+			sortField = "updated_on"
+		}
+		if opts.Order == "desc" {
+			sortField = "-" + sortField
+		}
+		filter.Sort = sortField
+	}
+
+	if opts.UpdatedSince != "" {
+		d, err := time.ParseDuration(opts.UpdatedSince)
+		if err != nil {
+			return cmdutil.FlagErrorf("invalid value for --updated-since: %q", opts.UpdatedSince)
+		}
+		filter.UpdatedSince = opts.Now().Add(-d).UTC().Format(time.RFC3339)
 	}
 
 	listResult, err := listRepos(httpClient, host, opts.Limit, opts.Workspace, filter)
@@ -147,32 +216,41 @@ func listRun(opts *ListOptions) error {
 	}
 
 	cs := opts.IO.ColorScheme()
-	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "DESCRIPTION", "INFO", "UPDATED"))
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader(columns...))
 
 	totalMatchCount := len(listResult.Repositories)
 	for _, repo := range listResult.Repositories {
-		info := repoInfo(repo)
 		infoColor := cs.Muted
-
 		if repo.IsPrivate {
 			infoColor = cs.Yellow
 		}
 
-		t := repo.UpdatedOn
 		fullName := repo.FullName
 		if fullName == "" {
 			fullName = fmt.Sprintf("%s/%s", repo.Workspace.Slug, repo.Slug)
 		}
 
-		tp.AddField(fullName, tableprinter.WithColor(cs.Bold))
-		tp.AddField(text.RemoveExcessiveWhitespace(repo.Description))
-		tp.AddField(info, tableprinter.WithColor(infoColor))
-		tp.AddTimeField(opts.Now(), t, cs.Muted)
+		for _, col := range columns {
+			switch col {
+			case "name":
+				tp.AddField(fullName, tableprinter.WithColor(cs.Bold))
+			case "description":
+				tp.AddField(text.RemoveExcessiveWhitespace(repo.Description))
+			case "info":
+				tp.AddField(repo.InfoLabel(), tableprinter.WithColor(infoColor))
+			case "language":
+				tp.AddField(repo.Language)
+			case "created":
+				tp.AddTimeField(opts.Now(), repo.CreatedOn, cs.Muted)
+			case "updated":
+				tp.AddTimeField(opts.Now(), repo.UpdatedOn, cs.Muted)
+			}
+		}
 		tp.EndRow()
 	}
 
 	if opts.IO.IsStdoutTTY() {
-		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || filter.Language != ""
+		hasFilters := filter.Visibility != "" || filter.Fork || filter.Source || filter.Language != "" || filter.Project != "" || filter.UpdatedSince != ""
 		title := listHeader(listResult.Owner, totalMatchCount, listResult.TotalCount, hasFilters)
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
@@ -203,19 +281,3 @@ func listHeader(workspace string, matchCount, totalMatchCount int, hasFilters bo
 	}
 	return fmt.Sprintf("Showing %d of %d repositories%s", matchCount, totalMatchCount, matchStr)
 }
-
-func repoInfo(r api.Repository) string {
-	var tags []string
-
-	if r.IsPrivate {
-		tags = append(tags, "private")
-	} else {
-		tags = append(tags, "public")
-	}
-
-	if r.Parent != nil {
-		tags = append(tags, "fork")
-	}
-
-	return strings.Join(tags, ", ")
-}
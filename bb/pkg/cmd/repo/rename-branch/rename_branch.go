@@ -0,0 +1,319 @@
+package renamebranch
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RenameBranchOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	BaseRepo   func() (bbrepo.Interface, error)
+	Prompter   prompter.Prompter
+
+	OldBranch string
+	NewBranch string
+	Confirm   bool
+}
+
+func NewCmdRenameBranch(f *cmdutil.Factory, runF func(*RenameBranchOptions) error) *cobra.Command {
+	opts := &RenameBranchOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		BaseRepo:   f.BaseRepo,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "rename-branch <old-branch> <new-branch>",
+		Short: "Rename the default branch of a repository",
+		Long: heredoc.Doc(`
+			Orchestrate a default branch rename: create the new branch from the
+			old one, make it the main branch, retarget open pull requests, and
+			update branch restrictions that match the old name.
+
+			The old branch is left in place so nothing breaks mid-migration;
+			delete it once teammates have switched. The command finishes by
+			printing the git commands each clone must run.
+		`),
+		Example: heredoc.Doc(`
+			$ bb repo rename-branch master main
+		`),
+		Args: cmdutil.ExactArgs(2, "cannot rename branch: old and new branch names required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.OldBranch = args[0]
+			opts.NewBranch = args[1]
+
+			if opts.OldBranch == opts.NewBranch {
+				return cmdutil.FlagErrorf("old and new branch names must differ")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return renameBranchRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func renameBranchRun(opts *RenameBranchOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	// Confirm before touching anything; the rename fans out into several
+	// repository settings
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
+		msg := fmt.Sprintf("Rename the default branch of %s from %q to %q?",
+			bbrepo.FullName(repo), opts.OldBranch, opts.NewBranch)
+		confirmed, err := opts.Prompter.Confirm(msg, false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	// Step 1: create the new branch from the old branch's head
+	opts.IO.StartProgressIndicator()
+	created, err := createBranchFrom(apiClient, repo, opts.OldBranch, opts.NewBranch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Fprintf(out, "%s Created branch %s from %s\n", cs.SuccessIcon(), cs.Cyan(opts.NewBranch), opts.OldBranch)
+	} else {
+		fmt.Fprintf(out, "%s Branch %s already exists, reusing it\n", cs.SuccessIcon(), cs.Cyan(opts.NewBranch))
+	}
+
+	// Step 2: make it the main branch
+	opts.IO.StartProgressIndicator()
+	err = setMainBranch(apiClient, repo, opts.NewBranch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to update the main branch: %w", err)
+	}
+	fmt.Fprintf(out, "%s Set %s as the main branch\n", cs.SuccessIcon(), cs.Cyan(opts.NewBranch))
+
+	// Step 3: retarget open pull requests
+	opts.IO.StartProgressIndicator()
+	retargeted, err := retargetOpenPRs(apiClient, repo, opts.OldBranch, opts.NewBranch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to retarget pull requests: %w", err)
+	}
+	if retargeted > 0 {
+		fmt.Fprintf(out, "%s Retargeted %s to %s\n",
+			cs.SuccessIcon(), text.Pluralize(retargeted, "open pull request"), cs.Cyan(opts.NewBranch))
+	}
+
+	// Step 4: move branch restrictions over
+	opts.IO.StartProgressIndicator()
+	moved, err := updateBranchRestrictions(apiClient, repo, opts.OldBranch, opts.NewBranch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to update branch restrictions: %w", err)
+	}
+	if moved > 0 {
+		fmt.Fprintf(out, "%s Updated %s to match %s\n",
+			cs.SuccessIcon(), text.Pluralize(moved, "branch restriction"), cs.Cyan(opts.NewBranch))
+	}
+
+	fmt.Fprintf(out, "\nTeammates should run these commands in their clones:\n")
+	fmt.Fprintf(out, "  git branch -m %s %s\n", opts.OldBranch, opts.NewBranch)
+	fmt.Fprintf(out, "  git fetch origin\n")
+	fmt.Fprintf(out, "  git branch -u origin/%s %s\n", opts.NewBranch, opts.NewBranch)
+	fmt.Fprintf(out, "  git remote set-head origin -a\n")
+	fmt.Fprintf(out, "\nOnce everyone has switched, delete the old branch with %s\n",
+		cs.Cyan(fmt.Sprintf("`bb api -X DELETE repositories/%s/refs/branches/%s`", bbrepo.FullName(repo), opts.OldBranch)))
+
+	return nil
+}
+
+// createBranchFrom points a new branch at the old branch's head. It reports
+// false without error when the new branch already exists.
+func createBranchFrom(apiClient *api.Client, repo bbrepo.Interface, oldBranch, newBranch string) (bool, error) {
+	var existing struct {
+		Name string `json:"name"`
+	}
+	checkPath := fmt.Sprintf("repositories/%s/%s/refs/branches/%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), url.PathEscape(newBranch))
+	if err := apiClient.Get(repo.RepoHost(), checkPath, &existing); err == nil {
+		return false, nil
+	}
+
+	var old struct {
+		Target struct {
+			Hash string `json:"hash"`
+		} `json:"target"`
+	}
+	oldPath := fmt.Sprintf("repositories/%s/%s/refs/branches/%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), url.PathEscape(oldBranch))
+	if err := apiClient.Get(repo.RepoHost(), oldPath, &old); err != nil {
+		if api.IsNotFoundError(err) {
+			return false, fmt.Errorf("branch %q not found in %s", oldBranch, bbrepo.FullName(repo))
+		}
+		return false, err
+	}
+
+	payload := map[string]interface{}{
+		"name": newBranch,
+		"target": map[string]string{
+			"hash": old.Target.Hash,
+		},
+	}
+	createPath := fmt.Sprintf("repositories/%s/%s/refs/branches",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	if err := apiClient.Post(repo.RepoHost(), createPath, payload, nil); err != nil {
+		return false, fmt.Errorf("failed to create branch %q: %w", newBranch, err)
+	}
+	return true, nil
+}
+
+func setMainBranch(apiClient *api.Client, repo bbrepo.Interface, branch string) error {
+	path := fmt.Sprintf("repositories/%s/%s", repo.RepoWorkspace(), repo.RepoSlug())
+	payload := map[string]interface{}{
+		"mainbranch": map[string]string{
+			"type": "branch",
+			"name": branch,
+		},
+	}
+	return apiClient.Put(repo.RepoHost(), path, payload, nil)
+}
+
+// retargetOpenPRs moves every open pull request targeting oldBranch onto
+// newBranch, returning how many were updated.
+func retargetOpenPRs(apiClient *api.Client, repo bbrepo.Interface, oldBranch, newBranch string) (int, error) {
+	params := url.Values{}
+	params.Set("pagelen", "50")
+	params.Set("q", fmt.Sprintf(`destination.branch.name="%s" AND state="OPEN"`, oldBranch))
+
+	var prs []shared.PullRequest
+	path := fmt.Sprintf("repositories/%s/%s/pullrequests?%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), params.Encode())
+	nextURL := ""
+	for {
+		var page shared.PullRequestList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return 0, err
+		}
+		prs = append(prs, page.Values...)
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	for i, pr := range prs {
+		prPath := fmt.Sprintf("repositories/%s/%s/pullrequests/%d",
+			repo.RepoWorkspace(), repo.RepoSlug(), pr.ID)
+		payload := map[string]interface{}{
+			"destination": map[string]interface{}{
+				"branch": map[string]string{
+					"name": newBranch,
+				},
+			},
+		}
+		if err := apiClient.Put(repo.RepoHost(), prPath, payload, nil); err != nil {
+			return i, fmt.Errorf("pull request #%d: %w", pr.ID, err)
+		}
+	}
+	return len(prs), nil
+}
+
+// updateBranchRestrictions rewrites restrictions whose pattern is exactly
+// oldBranch to newBranch, returning how many were changed.
+func updateBranchRestrictions(apiClient *api.Client, repo bbrepo.Interface, oldBranch, newBranch string) (int, error) {
+	type restriction struct {
+		ID      int    `json:"id"`
+		Kind    string `json:"kind"`
+		Pattern string `json:"pattern"`
+	}
+
+	var matching []restriction
+	path := fmt.Sprintf("repositories/%s/%s/branch-restrictions?pagelen=100",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	nextURL := ""
+	for {
+		var page struct {
+			Values []restriction `json:"values"`
+			Next   string        `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return 0, err
+		}
+		for _, r := range page.Values {
+			if r.Pattern == oldBranch {
+				matching = append(matching, r)
+			}
+		}
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	for i, r := range matching {
+		updatePath := fmt.Sprintf("repositories/%s/%s/branch-restrictions/%d",
+			repo.RepoWorkspace(), repo.RepoSlug(), r.ID)
+		payload := map[string]interface{}{
+			"kind":    r.Kind,
+			"pattern": newBranch,
+		}
+		if err := apiClient.Put(repo.RepoHost(), updatePath, payload, nil); err != nil {
+			return i, fmt.Errorf("%s on %s: %w", r.Kind, r.Pattern, err)
+		}
+	}
+	return len(matching), nil
+}
@@ -6,8 +6,12 @@ import (
 	repoCreateCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/create"
 	repoDeleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/delete"
 	repoEditCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/edit"
+	repoEnvCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/env"
 	repoForkCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/fork"
+	repoIssuesCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/issues"
+	repoLanguagesCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/languages"
 	repoListCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/list"
+	repoRenameBranchCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/rename-branch"
 	repoSyncCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/sync"
 	repoViewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo/view"
 
@@ -38,6 +42,7 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.AddGroup(cmd, "General commands",
 		repoListCmd.NewCmdList(f, nil),
 		repoCreateCmd.NewCmdCreate(f, nil),
+		repoEnvCmd.NewCmdEnv(f),
 	)
 
 	cmdutil.AddGroup(cmd, "Targeted commands",
@@ -47,6 +52,9 @@ func NewCmdRepo(f *cmdutil.Factory) *cobra.Command {
 		repoEditCmd.NewCmdEdit(f, nil),
 		repoDeleteCmd.NewCmdDelete(f, nil),
 		repoSyncCmd.NewCmdSync(f, nil),
+		repoLanguagesCmd.NewCmdLanguages(f, nil),
+		repoRenameBranchCmd.NewCmdRenameBranch(f, nil),
+		repoIssuesCmd.NewCmdIssues(f),
 	)
 
 	return cmd
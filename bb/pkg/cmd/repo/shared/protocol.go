@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/git"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+)
+
+// GitProtocolConfigKey is the git config key for a per-repository git protocol
+// override, e.g. `git config bb.git-protocol ssh`. It takes precedence over the
+// git_protocol setting in the bb configuration.
+const GitProtocolConfigKey = "bb.git-protocol"
+
+// PreferredGitProtocol resolves the git protocol to use when cloning or adding
+// remotes for repositories on hostname.
+//
+// Resolution order:
+//  1. a per-repository override in local git config (bb.git-protocol), when
+//     gitClient is non-nil and the current directory is inside a repository
+//  2. the git_protocol setting from the bb configuration, when explicitly set
+//     by the user
+//  3. "ssh" when the authenticated user has SSH keys registered on hostname,
+//     otherwise the configuration default
+func PreferredGitProtocol(ctx context.Context, gitClient *git.Client, cfg gh.Config, apiClient *api.Client, hostname string) string {
+	if gitClient != nil {
+		if override, err := gitClient.Config(ctx, GitProtocolConfigKey); err == nil {
+			if override == "ssh" || override == "https" {
+				return override
+			}
+		}
+	}
+
+	entry := cfg.GitProtocol(hostname)
+	if entry.Source == gh.ConfigUserProvided {
+		return entry.Value
+	}
+
+	if apiClient != nil && userHasSSHKeys(apiClient, hostname) {
+		return "ssh"
+	}
+
+	return entry.Value
+}
+
+// userHasSSHKeys reports whether the authenticated user has at least one SSH
+// key registered. Errors are treated as "no keys" so protocol detection never
+// blocks a clone.
+func userHasSSHKeys(apiClient *api.Client, hostname string) bool {
+	var result struct {
+		Size   int `json:"size"`
+		Values []struct {
+			UUID string `json:"uuid"`
+		} `json:"values"`
+	}
+	if err := apiClient.Get(hostname, "user/ssh-keys?pagelen=1", &result); err != nil {
+		return false
+	}
+	return len(result.Values) > 0
+}
@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferredGitProtocol(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfgStr    string
+		sshKeys   string
+		stubKeys  bool
+		wantValue string
+	}{
+		{
+			name:      "user-configured protocol wins",
+			cfgStr:    "git_protocol: ssh\n",
+			wantValue: "ssh",
+		},
+		{
+			name:      "defaults to ssh when user has ssh keys",
+			cfgStr:    "",
+			stubKeys:  true,
+			sshKeys:   `{"values":[{"uuid":"{key-1}"}]}`,
+			wantValue: "ssh",
+		},
+		{
+			name:      "falls back to default without ssh keys",
+			cfgStr:    "",
+			stubKeys:  true,
+			sshKeys:   `{"values":[]}`,
+			wantValue: "https",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewFromString(tt.cfgStr)
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.stubKeys {
+				reg.Register(
+					httpmock.REST("GET", "2.0/user/ssh-keys"),
+					httpmock.StringResponse(tt.sshKeys),
+				)
+			}
+			apiClient := api.NewClientFromHTTP(&http.Client{Transport: reg})
+
+			got := PreferredGitProtocol(context.Background(), nil, cfg, apiClient, "bitbucket.org")
+			assert.Equal(t, tt.wantValue, got)
+		})
+	}
+}
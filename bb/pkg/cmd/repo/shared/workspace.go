@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbinstance"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// confirmPrompter is the subset of the prompter needed to confirm a
+// workspace fallback.
+type confirmPrompter interface {
+	Confirm(prompt string, defaultValue bool) (bool, error)
+}
+
+// ResolveTargetWorkspace picks the workspace a new repository or fork should
+// land in when none was given explicitly. The configured default_workspace
+// wins; otherwise the personal workspace is used, after an interactive
+// confirmation naming it — the silent username fallback is rarely what users
+// of shared workspaces intend. action describes the operation in the prompt,
+// e.g. "Create the repository".
+func ResolveTargetWorkspace(cfg gh.Config, io *iostreams.IOStreams, p confirmPrompter, action string) (string, error) {
+	hostname := bbinstance.Default()
+
+	if ws := cfg.DefaultWorkspace(hostname).Value; ws != "" {
+		return ws, nil
+	}
+
+	user, err := cfg.Authentication().ActiveUser(hostname)
+	if err != nil || user == "" {
+		return "", fmt.Errorf("workspace required: use --workspace flag or set one with `bb config set default_workspace <workspace>`")
+	}
+
+	if io.CanPrompt() {
+		confirmed, err := p.Confirm(fmt.Sprintf("No workspace specified. %s in your personal workspace %q?", action, user), true)
+		if err != nil {
+			return "", err
+		}
+		if !confirmed {
+			return "", cmdutil.CancelError
+		}
+	}
+
+	return user, nil
+}
+
+// WorkspaceCompletionFunc completes --workspace flags from workspaces known
+// without a network round trip: the configured default workspace, workspaces
+// with an account mapping, and the personal workspace.
+func WorkspaceCompletionFunc(f *cmdutil.Factory) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		cfg, err := f.Config()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		hostname := bbinstance.Default()
+		seen := map[string]bool{}
+		add := func(ws string) {
+			if ws != "" && !seen[ws] && strings.HasPrefix(strings.ToLower(ws), strings.ToLower(toComplete)) {
+				seen[ws] = true
+			}
+		}
+
+		add(cfg.DefaultWorkspace(hostname).Value)
+		for ws := range cfg.WorkspaceAccounts().All() {
+			add(ws)
+		}
+		if user, err := cfg.Authentication().ActiveUser(hostname); err == nil {
+			add(user)
+		}
+
+		results := make([]string, 0, len(seen))
+		for ws := range seen {
+			results = append(results, ws)
+		}
+		sort.Strings(results)
+		return results, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/git"
@@ -19,8 +20,9 @@ type SyncOptions struct {
 	BaseRepo   func() (bbrepo.Interface, error)
 	GitClient  *git.Client
 
-	Branch string
-	Force  bool
+	Branch       string
+	Force        bool
+	FromUpstream bool
 }
 
 func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
@@ -42,11 +44,16 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 			use --force to overwrite local changes.
 
 			If no branch is specified, the current branch is synced.
+
+			For forks, --from-upstream fetches from the upstream remote instead
+			of origin, so the default branch keeps up with the repository the
+			fork was created from.
 		`),
 		Example: heredoc.Doc(`
 			$ bb repo sync
 			$ bb repo sync --branch main
 			$ bb repo sync --force
+			$ bb repo sync --from-upstream
 		`),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -59,6 +66,7 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to sync (defaults to current branch)")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Force sync even if branches have diverged")
+	cmd.Flags().BoolVar(&opts.FromUpstream, "from-upstream", false, "Sync from the upstream remote instead of origin")
 
 	return cmd
 }
@@ -87,6 +95,18 @@ func syncRun(opts *SyncOptions) error {
 
 	// Get the remote name (usually 'origin')
 	remote := "origin"
+	if opts.FromUpstream {
+		remote = "upstream"
+		checkCmd, err := opts.GitClient.Command(ctx, "config", "--get", "remote.upstream.url")
+		if err != nil {
+			opts.IO.StopProgressIndicator()
+			return err
+		}
+		if _, err := checkCmd.Output(); err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("no %q remote is configured; run `bb repo fork --remote` to add one", remote)
+		}
+	}
 
 	// Fetch the branch from remote
 	fetchCmd, err := opts.GitClient.Command(ctx, "fetch", remote, branch)
@@ -167,6 +187,13 @@ func syncRun(opts *SyncOptions) error {
 		return fmt.Errorf("failed to sync branch: %w", resetErr)
 	}
 
+	if opts.FromUpstream {
+		// remember when the fork last caught up; recording it is best-effort
+		if tsCmd, err := opts.GitClient.Command(ctx, "config", "bb.lastUpstreamSync", time.Now().Format(time.RFC3339)); err == nil {
+			_ = tsCmd.Run()
+		}
+	}
+
 	if opts.IO.IsStdoutTTY() {
 		if opts.Force {
 			fmt.Fprintf(opts.IO.Out, "%s Force synced branch %s with %s\n",
@@ -98,3 +98,44 @@ func RepositoryReadme(client *http.Client, repo bbrepo.Interface, branch string)
 func init() {
 	// Use the api package's method
 }
+
+// behindUpstreamPageLen bounds how many missing commits are counted before
+// the result is capped; walking an arbitrarily stale fork's full history
+// would be wasteful.
+const behindUpstreamPageLen = 50
+
+// behindUpstream counts how many commits the fork's main branch is behind
+// its upstream repository. The second return reports whether the count was
+// capped at behindUpstreamPageLen.
+func behindUpstream(apiClient *api.Client, hostname string, repo *api.Repository) (int, bool, error) {
+	if repo.Parent == nil || repo.MainBranch == nil {
+		return 0, false, nil
+	}
+
+	// Resolve the fork's main branch to a commit hash; a fork's history is a
+	// subset of upstream's, so that hash can anchor an exclude query there.
+	var head struct {
+		Hash string `json:"hash"`
+	}
+	headPath := fmt.Sprintf("repositories/%s/commit/%s", repo.FullName, url.PathEscape(repo.MainBranch.Name))
+	if err := apiClient.Get(hostname, headPath, &head); err != nil {
+		return 0, false, err
+	}
+
+	upstreamBranch := repo.MainBranch.Name
+	if repo.Parent.MainBranch != nil {
+		upstreamBranch = repo.Parent.MainBranch.Name
+	}
+
+	var page struct {
+		Values []struct{} `json:"values"`
+		Next   string     `json:"next"`
+	}
+	commitsPath := fmt.Sprintf("repositories/%s/commits/%s?exclude=%s&pagelen=%d",
+		repo.Parent.FullName, url.PathEscape(upstreamBranch), head.Hash, behindUpstreamPageLen)
+	if err := apiClient.Get(hostname, commitsPath, &page); err != nil {
+		return 0, false, err
+	}
+
+	return len(page.Values), page.Next != "", nil
+}
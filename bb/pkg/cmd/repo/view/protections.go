@@ -0,0 +1,233 @@
+package view
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// restriction is one branch-restriction rule; merge checks and access
+// restrictions share this shape on the API.
+type restriction struct {
+	Kind    string `json:"kind"`
+	Pattern string `json:"pattern"`
+	Value   int    `json:"value"`
+	Users   []struct {
+		DisplayName string `json:"display_name"`
+	} `json:"users"`
+	Groups []struct {
+		Name string `json:"name"`
+	} `json:"groups"`
+}
+
+// protections consolidates the main branch's protection settings, which the
+// API scatters across several endpoints.
+type protections struct {
+	branch           string
+	restrictions     []restriction
+	defaultReviewers []string
+	pipelinesEnabled bool
+}
+
+// accessKinds are the restriction kinds that gate who may touch the branch;
+// everything else on the endpoint is a merge check.
+var accessKinds = map[string]bool{
+	"push":            true,
+	"force":           true,
+	"delete":          true,
+	"restrict_merges": true,
+}
+
+// viewProtections renders the consolidated branch protection panel for the
+// repository's main branch.
+func viewProtections(opts *ViewOptions, apiClient *api.Client, toView bbrepo.Interface, repo *api.Repository) error {
+	branch := "main"
+	if repo.MainBranch != nil && repo.MainBranch.Name != "" {
+		branch = repo.MainBranch.Name
+	}
+
+	opts.IO.StartProgressIndicator()
+	p, err := fetchProtections(apiClient, toView, branch)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	return printProtections(opts.IO, bbrepo.FullName(toView), p)
+}
+
+func fetchProtections(apiClient *api.Client, repo bbrepo.Interface, branch string) (*protections, error) {
+	p := &protections{branch: branch}
+
+	// Branch restrictions, filtered to rules whose pattern covers the main
+	// branch
+	restrictionsPath := fmt.Sprintf("repositories/%s/%s/branch-restrictions?pagelen=100",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	nextURL := ""
+	for {
+		var page struct {
+			Values []restriction `json:"values"`
+			Next   string        `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), restrictionsPath, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range page.Values {
+			if patternMatches(r.Pattern, branch) {
+				p.restrictions = append(p.restrictions, r)
+			}
+		}
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	// Default reviewers
+	var reviewers struct {
+		Values []struct {
+			DisplayName string `json:"display_name"`
+		} `json:"values"`
+	}
+	reviewersPath := fmt.Sprintf("repositories/%s/%s/default-reviewers?pagelen=100",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	if err := apiClient.Get(repo.RepoHost(), reviewersPath, &reviewers); err == nil {
+		for _, r := range reviewers.Values {
+			p.defaultReviewers = append(p.defaultReviewers, r.DisplayName)
+		}
+	}
+
+	// Pipelines enablement
+	var pipelinesConfig struct {
+		Enabled bool `json:"enabled"`
+	}
+	configPath := fmt.Sprintf("repositories/%s/%s/pipelines_config",
+		repo.RepoWorkspace(), repo.RepoSlug())
+	if err := apiClient.Get(repo.RepoHost(), configPath, &pipelinesConfig); err == nil {
+		p.pipelinesEnabled = pipelinesConfig.Enabled
+	}
+
+	return p, nil
+}
+
+// patternMatches reports whether a branch-restriction glob pattern covers the
+// branch.
+func patternMatches(pattern, branch string) bool {
+	if pattern == branch {
+		return true
+	}
+	matched, err := path.Match(pattern, branch)
+	return err == nil && matched
+}
+
+func printProtections(io *iostreams.IOStreams, fullName string, p *protections) error {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintf(out, "Branch protections for %s in %s\n", cs.Cyan(p.branch), cs.Bold(fullName))
+
+	var access, checks []restriction
+	for _, r := range p.restrictions {
+		if accessKinds[r.Kind] {
+			access = append(access, r)
+		} else {
+			checks = append(checks, r)
+		}
+	}
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold("Branch restrictions"))
+	if len(access) == 0 {
+		fmt.Fprintf(out, "  %s\n", cs.Muted("None"))
+	}
+	for _, r := range access {
+		fmt.Fprintf(out, "  %s %s\n", cs.SuccessIcon(), accessSummary(r))
+	}
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold("Merge checks"))
+	if len(checks) == 0 {
+		fmt.Fprintf(out, "  %s\n", cs.Muted("None"))
+	}
+	for _, r := range checks {
+		fmt.Fprintf(out, "  %s %s\n", cs.SuccessIcon(), checkSummary(r))
+	}
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold("Default reviewers"))
+	if len(p.defaultReviewers) == 0 {
+		fmt.Fprintf(out, "  %s\n", cs.Muted("None"))
+	} else {
+		fmt.Fprintf(out, "  %s\n", strings.Join(p.defaultReviewers, ", "))
+	}
+
+	fmt.Fprintf(out, "\n%s\n", cs.Bold("Pipelines"))
+	if p.pipelinesEnabled {
+		fmt.Fprintf(out, "  Enabled\n")
+	} else {
+		fmt.Fprintf(out, "  %s\n", cs.Muted("Disabled"))
+	}
+
+	return nil
+}
+
+// accessSummary renders a who-may-do-what restriction in one line.
+func accessSummary(r restriction) string {
+	var verb string
+	switch r.Kind {
+	case "push":
+		verb = "Pushes restricted"
+	case "force":
+		verb = "Force pushes blocked"
+	case "delete":
+		verb = "Deletion blocked"
+	case "restrict_merges":
+		verb = "Merges restricted"
+	default:
+		verb = r.Kind
+	}
+
+	var allowed []string
+	if len(r.Users) > 0 {
+		allowed = append(allowed, text.Pluralize(len(r.Users), "user"))
+	}
+	if len(r.Groups) > 0 {
+		allowed = append(allowed, text.Pluralize(len(r.Groups), "group"))
+	}
+	if len(allowed) > 0 {
+		return fmt.Sprintf("%s to %s", verb, strings.Join(allowed, ", "))
+	}
+	return verb
+}
+
+// checkSummary renders a merge-check restriction in one line.
+func checkSummary(r restriction) string {
+	switch r.Kind {
+	case "require_approvals_to_merge":
+		return fmt.Sprintf("%s required to merge", text.Pluralize(r.Value, "approval"))
+	case "require_default_reviewer_approvals_to_merge":
+		return fmt.Sprintf("%s from default reviewers required to merge", text.Pluralize(r.Value, "approval"))
+	case "require_passing_builds_to_merge":
+		return fmt.Sprintf("%s required to merge", text.Pluralize(r.Value, "passing build"))
+	case "require_tasks_to_be_completed":
+		return "All tasks must be completed before merging"
+	case "reset_pullrequest_approvals_on_change":
+		return "Approvals reset when the source branch changes"
+	case "enforce_merge_checks":
+		return "Merge checks enforced"
+	case "require_all_dependencies_merged":
+		return "All dependent pull requests must be merged first"
+	case "require_commits_behind":
+		return fmt.Sprintf("Branch may be at most %s behind before merging", text.Pluralize(r.Value, "commit"))
+	default:
+		return r.Kind
+	}
+}
@@ -28,9 +28,11 @@ type ViewOptions struct {
 	Exporter   cmdutil.Exporter
 	Config     func() (gh.Config, error)
 
-	RepoArg string
-	Web     bool
-	Branch  string
+	RepoArg           string
+	Web               bool
+	NoBrowser         bool
+	Branch            string
+	BranchProtections bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -53,12 +55,19 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			With %[1]s--web%[1]s, open the repository in a web browser instead.
 
 			With %[1]s--branch%[1]s, view a specific branch of the repository.
+
+			With %[1]s--branch-protections%[1]s, show a consolidated view of the main
+			branch's protection settings instead: branch restrictions, merge checks,
+			default reviewers, and pipelines enablement.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RepoArg = args[0]
 			}
+			if opts.BranchProtections && opts.Web {
+				return cmdutil.FlagErrorf("specify only one of --branch-protections or --web")
+			}
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -67,7 +76,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open a repository in the browser")
+	cmd.Flags().BoolVar(&opts.NoBrowser, "no-browser", false, "Print destination URL instead of opening the browser")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "View a specific branch of the repository")
+	cmd.Flags().BoolVar(&opts.BranchProtections, "branch-protections", false, "Show the main branch's protection settings")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
@@ -116,6 +127,10 @@ func viewRun(opts *ViewOptions) error {
 		return err
 	}
 
+	if opts.BranchProtections {
+		return viewProtections(opts, apiClient, toView, &repo)
+	}
+
 	var readme *RepoReadme
 	if !opts.Web && opts.Exporter == nil {
 		readme, err = RepositoryReadme(httpClient, toView, opts.Branch)
@@ -124,12 +139,25 @@ func viewRun(opts *ViewOptions) error {
 		}
 	}
 
+	// For forks, surface how far the main branch trails upstream. The count
+	// is informational, so a failed lookup does not fail the view.
+	var upstreamLine string
+	if repo.Parent != nil && !opts.Web && opts.Exporter == nil {
+		if behind, capped, err := behindUpstream(apiClient, toView.RepoHost(), &repo); err == nil {
+			switch {
+			case capped:
+				upstreamLine = fmt.Sprintf("Behind upstream %s by %d+ commits", repo.Parent.FullName, behind)
+			case behind > 0:
+				upstreamLine = fmt.Sprintf("Behind upstream %s by %s", repo.Parent.FullName, text.Pluralize(behind, "commit"))
+			default:
+				upstreamLine = fmt.Sprintf("Up to date with upstream %s", repo.Parent.FullName)
+			}
+		}
+	}
+
 	openURL := generateBranchURL(toView, opts.Branch)
 	if opts.Web {
-		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
-		}
-		return opts.Browser.Browse(openURL)
+		return cmdutil.OpenInBrowser(opts.IO, opts.Browser, openURL, opts.NoBrowser)
 	}
 
 	opts.IO.DetectTerminalTheme()
@@ -149,6 +177,9 @@ func viewRun(opts *ViewOptions) error {
 	if !opts.IO.IsStdoutTTY() {
 		fmt.Fprintf(stdout, "name:\t%s\n", fullName)
 		fmt.Fprintf(stdout, "description:\t%s\n", repo.Description)
+		if upstreamLine != "" {
+			fmt.Fprintf(stdout, "upstream:\t%s\n", upstreamLine)
+		}
 		if readme != nil {
 			fmt.Fprintln(stdout, "--")
 			fmt.Fprint(stdout, readme.Content)
@@ -161,6 +192,9 @@ func viewRun(opts *ViewOptions) error {
 	repoTmpl := heredoc.Doc(`
 		{{.FullName}}
 		{{.Description}}
+		{{- if .Upstream}}
+		{{.Upstream}}
+		{{- end}}
 
 		{{.Readme}}
 
@@ -195,14 +229,20 @@ func viewRun(opts *ViewOptions) error {
 		description = cs.Muted("No description provided")
 	}
 
+	if upstreamLine != "" {
+		upstreamLine = cs.Muted(upstreamLine)
+	}
+
 	repoData := struct {
 		FullName    string
 		Description string
+		Upstream    string
 		Readme      string
 		View        string
 	}{
 		FullName:    cs.Bold(fullName),
 		Description: description,
+		Upstream:    upstreamLine,
 		Readme:      readmeContent,
 		View:        cs.Mutedf("View this repository on Bitbucket: %s", openURL),
 	}
@@ -8,15 +8,17 @@ import (
 	"time"
 
 	"github.com/dlbroadfoot/bitbucket-cli/internal/update"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/extensions"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/dlbroadfoot/bitbucket-cli/utils"
 	"github.com/spf13/cobra"
 )
 
-type ExternalCommandExitError struct {
-	*exec.ExitError
-}
+// ExternalCommandExitError preserves the exit status of an extension's or
+// shell alias's child process so bb can exit with the same code. The type
+// lives in cmdutil so commands outside this package can return it too.
+type ExternalCommandExitError = cmdutil.ExternalCommandExitError
 
 func NewCmdExtension(io *iostreams.IOStreams, em extensions.ExtensionManager, ext extensions.Extension, checkExtensionReleaseInfo func(extensions.ExtensionManager, extensions.Extension) (*update.ReleaseInfo, error)) *cobra.Command {
 	updateMessageChan := make(chan *update.ReleaseInfo)
@@ -44,7 +46,7 @@ func NewCmdExtension(io *iostreams.IOStreams, em extensions.ExtensionManager, ex
 			if _, err := em.Dispatch(args, io.In, io.Out, io.ErrOut); err != nil {
 				var execError *exec.ExitError
 				if errors.As(err, &execError) {
-					return &ExternalCommandExitError{execError}
+					return &ExternalCommandExitError{ExitError: execError}
 				}
 				return fmt.Errorf("failed to run extension: %w\n", err)
 			}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -27,7 +29,7 @@ func rootUsageFunc(w io.Writer, command *cobra.Command) error {
 	}
 
 	if len(subcommands) > 0 {
-		fmt.Fprint(w, "\n\nAvailable commands:\n")
+		fmt.Fprintf(w, "\n\n%s\n", i18n.T("Available commands:"))
 		for _, c := range subcommands {
 			fmt.Fprintf(w, "  %s\n", c.Name())
 		}
@@ -36,7 +38,7 @@ func rootUsageFunc(w io.Writer, command *cobra.Command) error {
 
 	flagUsages := command.LocalFlags().FlagUsages()
 	if flagUsages != "" {
-		fmt.Fprintln(w, "\n\nFlags:")
+		fmt.Fprintf(w, "\n\n%s\n", i18n.T("Flags:"))
 		fmt.Fprint(w, text.Indent(dedent(flagUsages), "  "))
 	}
 	return nil
@@ -56,11 +58,20 @@ func HasFailed() bool {
 	return hasFailed
 }
 
+// ghEquivalents maps GitHub CLI command names to their closest bb
+// counterpart, to orient users migrating from gh.
+var ghEquivalents = map[string]string{
+	"gist":     "api snippets",
+	"org":      "workspace",
+	"run":      "pipeline",
+	"workflow": "pipeline",
+}
+
 // Display helpful error message in case subcommand name was mistyped.
 // This matches Cobra's behavior for root command, which Cobra
 // confusingly doesn't apply to nested commands.
 func nestedSuggestFunc(w io.Writer, command *cobra.Command, arg string) {
-	fmt.Fprintf(w, "unknown command %q for %q\n", arg, command.CommandPath())
+	fmt.Fprint(w, i18n.T("unknown command %q for %q\n", arg, command.CommandPath()))
 
 	var candidates []string
 	if arg == "help" {
@@ -70,19 +81,71 @@ func nestedSuggestFunc(w io.Writer, command *cobra.Command, arg string) {
 			command.SuggestionsMinimumDistance = 2
 		}
 		candidates = command.SuggestionsFor(arg)
+		for _, c := range aliasSuggestions(command, arg) {
+			if !slices.Contains(candidates, c) {
+				candidates = append(candidates, c)
+			}
+		}
 	}
 
 	if len(candidates) > 0 {
-		fmt.Fprint(w, "\nDid you mean this?\n")
+		fmt.Fprintf(w, "\n%s\n", i18n.T("Did you mean this?"))
 		for _, c := range candidates {
 			fmt.Fprintf(w, "\t%s\n", c)
 		}
 	}
 
+	if isRootCmd(command) {
+		if equivalent, ok := ghEquivalents[strings.ToLower(arg)]; ok {
+			fmt.Fprintf(w, "\n%s\n", i18n.T("Coming from the GitHub CLI? The closest equivalent of `gh %s` is `bb %s`.", strings.ToLower(arg), equivalent))
+		}
+	}
+
 	fmt.Fprint(w, "\n")
 	_ = rootUsageFunc(w, command)
 }
 
+// aliasSuggestions returns subcommands one of whose declared aliases is close
+// to arg, which cobra's own SuggestionsFor does not consider.
+func aliasSuggestions(command *cobra.Command, arg string) []string {
+	var suggestions []string
+	for _, c := range command.Commands() {
+		if !c.IsAvailableCommand() {
+			continue
+		}
+		for _, alias := range c.Aliases {
+			levenshtein := levenshteinDistance(strings.ToLower(arg), strings.ToLower(alias))
+			if levenshtein <= command.SuggestionsMinimumDistance || strings.HasPrefix(strings.ToLower(alias), strings.ToLower(arg)) {
+				suggestions = append(suggestions, c.Name())
+				break
+			}
+		}
+	}
+	return suggestions
+}
+
+// levenshteinDistance computes the edit distance between two strings; cobra
+// keeps its own implementation unexported.
+func levenshteinDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
 func isRootCmd(command *cobra.Command) bool {
 	return command != nil && !command.HasParent()
 }
@@ -120,7 +183,7 @@ func rootHelpFunc(f *cmdutil.Factory, command *cobra.Command, _ []string) {
 	}
 	if longText != "" && command.LocalFlags().Lookup("jq") != nil {
 		longText = strings.TrimRight(longText, "\n") +
-			"\n\nFor more information about output formatting flags, see `bb help formatting`."
+			"\n\n" + i18n.T("For more information about output formatting flags, see `bb help formatting`.")
 	}
 
 	helpEntries := []helpEntry{}
@@ -184,16 +247,18 @@ func rootHelpFunc(f *cmdutil.Factory, command *cobra.Command, _ []string) {
 	if _, ok := command.Annotations["help:environment"]; ok {
 		helpEntries = append(helpEntries, helpEntry{"ENVIRONMENT VARIABLES", command.Annotations["help:environment"]})
 	}
-	helpEntries = append(helpEntries, helpEntry{"LEARN MORE", heredoc.Docf(`
+	helpEntries = append(helpEntries, helpEntry{"LEARN MORE", i18n.T(heredoc.Docf(`
 		Use %[1]sbb <command> <subcommand> --help%[1]s for more information about a command.
 		Learn about exit codes using %[1]sbb help exit-codes%[1]s
-	`, "`")})
+	`, "`"))})
 
 	out := f.IOStreams.Out
 	for _, e := range helpEntries {
 		if e.Title != "" {
-			// If there is a title, add indentation to each line in the body
-			fmt.Fprintln(out, cs.Bold(e.Title))
+			// If there is a title, add indentation to each line in the body.
+			// Titles act as their own catalog keys, which covers the section
+			// headings and the command group titles in one place.
+			fmt.Fprintln(out, cs.Bold(i18n.T(e.Title)))
 			fmt.Fprintln(out, text.Indent(strings.Trim(e.Body, "\r\n"), "  "))
 		} else {
 			// If there is no title print the body as is
@@ -1,6 +1,7 @@
 package root
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -116,3 +117,64 @@ func assertPipesAreInCodeBlocks(t *testing.T, cmd *cobra.Command) {
 
 	checkNode(doc)
 }
+
+func TestNestedSuggestFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		arg      string
+		contains []string
+	}{
+		{
+			name:     "misspelled command",
+			arg:      "pipelin",
+			contains: []string{`unknown command "pipelin" for "bb"`, "Did you mean this?", "pipeline"},
+		},
+		{
+			name:     "misspelled command alias",
+			arg:      "repos",
+			contains: []string{"Did you mean this?", "repo"},
+		},
+		{
+			name:     "gh equivalent",
+			arg:      "gist",
+			contains: []string{"Coming from the GitHub CLI?", "`bb api snippets`"},
+		},
+		{
+			name:     "gh equivalent to another command",
+			arg:      "workflow",
+			contains: []string{"The closest equivalent of `gh workflow` is `bb pipeline`."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "bb"}
+			cmd.AddCommand(&cobra.Command{Use: "pipeline", Run: func(*cobra.Command, []string) {}})
+			cmd.AddCommand(&cobra.Command{Use: "repo", Aliases: []string{"repos"}, Run: func(*cobra.Command, []string) {}})
+
+			out := &bytes.Buffer{}
+			nestedSuggestFunc(out, cmd, tt.arg)
+
+			for _, substr := range tt.contains {
+				require.Contains(t, out.String(), substr)
+			}
+		})
+	}
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"repo", "repo", 0},
+		{"repo", "repos", 1},
+		{"pipelin", "pipeline", 1},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, levenshteinDistance(tt.a, tt.b), "levenshteinDistance(%q, %q)", tt.a, tt.b)
+	}
+}
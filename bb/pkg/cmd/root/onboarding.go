@@ -0,0 +1,146 @@
+package root
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	loginCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/login"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth/shared/gitcredentials"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+)
+
+// offerOnboarding walks a first-time interactive user through initial setup:
+// logging in, choosing a default workspace, and optionally configuring the
+// git credential helper and shell completion. It returns true when setup
+// completed and the originally requested command should proceed.
+func offerOnboarding(f *cmdutil.Factory, cfg gh.Config) (bool, error) {
+	io := f.IOStreams
+	cs := io.ColorScheme()
+
+	fmt.Fprintf(io.ErrOut, "Welcome to %s! You are not logged into any Bitbucket hosts.\n", cs.Bold("Bitbucket CLI"))
+	setup, err := f.Prompter.Confirm("Set up bb now?", true)
+	if err != nil || !setup {
+		return false, err
+	}
+
+	login := loginCmd.NewCmdLogin(f, nil)
+	login.SetArgs([]string{})
+	login.SilenceUsage = true
+	if err := login.Execute(); err != nil {
+		return false, err
+	}
+
+	hostname, _ := cfg.Authentication().DefaultHost()
+
+	if err := chooseDefaultWorkspace(f, cfg, hostname); err != nil {
+		return false, err
+	}
+
+	if cfg.GitProtocol(hostname).Value == "https" {
+		if err := offerCredentialHelper(f, hostname); err != nil {
+			return false, err
+		}
+	}
+
+	if err := offerShellCompletion(f); err != nil {
+		return false, err
+	}
+
+	if err := cfg.Write(); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(io.ErrOut, "%s Setup complete. Run `bb help` to explore available commands.\n\n", cs.SuccessIcon())
+	return true, nil
+}
+
+// chooseDefaultWorkspace lets the user pick the workspace that commands fall
+// back to when none is given. Failing to list workspaces is not fatal; the
+// user can set default_workspace later with `bb config set`.
+func chooseDefaultWorkspace(f *cmdutil.Factory, cfg gh.Config, hostname string) error {
+	httpClient, err := f.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var page api.PaginatedResponse[api.Workspace]
+	if err := api.NewClientFromHTTP(httpClient).Get(hostname, "workspaces?pagelen=100", &page); err != nil || len(page.Values) == 0 {
+		return nil
+	}
+
+	slugs := make([]string, len(page.Values))
+	for i, ws := range page.Values {
+		slugs[i] = ws.Slug
+	}
+
+	var selected string
+	if len(slugs) == 1 {
+		selected = slugs[0]
+	} else {
+		i, err := f.Prompter.Select("Which workspace should be the default?", slugs[0], slugs)
+		if err != nil {
+			return err
+		}
+		selected = slugs[i]
+	}
+
+	cfg.Set(hostname, "default_workspace", selected)
+	cs := f.IOStreams.ColorScheme()
+	fmt.Fprintf(f.IOStreams.ErrOut, "%s Default workspace set to %s\n", cs.SuccessIcon(), cs.Bold(selected))
+	return nil
+}
+
+// offerCredentialHelper configures bb as the git credential helper for the
+// host, unless another helper is already in place.
+func offerCredentialHelper(f *cmdutil.Factory, hostname string) error {
+	helperConfig := &gitcredentials.HelperConfig{
+		SelfExecutablePath: f.Executable(),
+		GitClient:          f.GitClient,
+	}
+
+	if helper, err := helperConfig.ConfiguredHelper(hostname); err != nil || helper.IsConfigured() {
+		return nil
+	}
+
+	setup, err := f.Prompter.Confirm("Authenticate Git with your Bitbucket credentials?", true)
+	if err != nil || !setup {
+		return err
+	}
+
+	if err := helperConfig.ConfigureOurs(hostname); err != nil {
+		return err
+	}
+
+	cs := f.IOStreams.ColorScheme()
+	fmt.Fprintf(f.IOStreams.ErrOut, "%s Configured git credential helper\n", cs.SuccessIcon())
+	return nil
+}
+
+// offerShellCompletion points the user at the one-liner that enables
+// completion for their login shell; rc files are left for them to edit.
+func offerShellCompletion(f *cmdutil.Factory) error {
+	shell := filepath.Base(os.Getenv("SHELL"))
+
+	var rcFile, snippet string
+	switch shell {
+	case "bash":
+		rcFile, snippet = "~/.bashrc", `eval "$(bb completion -s bash)"`
+	case "zsh":
+		rcFile, snippet = "~/.zshrc", `eval "$(bb completion -s zsh)"`
+	case "fish":
+		rcFile, snippet = "~/.config/fish/config.fish", "bb completion -s fish | source"
+	default:
+		return nil
+	}
+
+	setup, err := f.Prompter.Confirm(fmt.Sprintf("Set up %s shell completion?", shell), true)
+	if err != nil || !setup {
+		return err
+	}
+
+	fmt.Fprintf(f.IOStreams.ErrOut, "Add the following line to %s to enable completion:\n\n    %s\n\n", rcFile, snippet)
+	return nil
+}
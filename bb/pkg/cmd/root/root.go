@@ -6,25 +6,45 @@ import (
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/profile"
 	aliasCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/alias/shared"
 	apiCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/api"
 	authCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/auth"
+	branchCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/branch"
 	browseCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/browse"
+	cacheCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/cache"
+	checksCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/checks"
+	commitCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/commit"
 	completionCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/completion"
 	configCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/config"
+	depsCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/deps"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/factory"
+	filterCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/filter"
+	foreachCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/foreach"
+	historyCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/history"
+	hooksCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/hooks"
 	issueCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue"
+	issueBranchCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/issue-branch"
+	notifyCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/notify"
+	openCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/open"
 	pipelineCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pipeline"
 	prCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr"
 	projectCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/project"
+	promptStatusCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/prompt-status"
+	quickCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/quick"
+	redoCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/redo"
+	remindersCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/reminders"
 	repoCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/repo"
 	searchCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/search"
 	secretCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret"
 	sshKeyCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/ssh-key"
 	statusCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/status"
+	upgradeCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/upgrade"
 	variableCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/variable"
 	versionCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/version"
+	webhookCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/webhook"
 	workspaceCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/google/shlex"
@@ -59,8 +79,32 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 			"versionInfo": versionCmd.Format(version, buildDate),
 		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if profileEnabled, _ := cmd.Flags().GetBool("profile"); profileEnabled {
+				profile.Enable()
+			}
+
+			if dumpEnabled, _ := cmd.Flags().GetBool("debug-dump"); dumpEnabled {
+				api.EnableDebugDump()
+			}
+
+			// the HTTP client factory picks the CA bundle up from the environment
+			if caCert, _ := cmd.Flags().GetString("ca-cert"); caCert != "" {
+				os.Setenv("BB_CA_BUNDLE", caCert)
+			}
+
 			// require that the user is authenticated before running most commands
-			if cmdutil.IsAuthCheckEnabled(cmd) && !cmdutil.CheckAuth(cfg) {
+			stopAuthTimer := profile.Track("auth check")
+			authenticated := !cmdutil.IsAuthCheckEnabled(cmd) || cmdutil.CheckAuth(cfg)
+			stopAuthTimer()
+			if !authenticated {
+				// a fresh interactive install gets a guided setup instead of an error
+				if io.CanPrompt() {
+					if done, err := offerOnboarding(f, cfg); err != nil {
+						return err
+					} else if done {
+						return nil
+					}
+				}
 				fmt.Fprint(io.ErrOut, authHelp())
 				return &AuthError{}
 			}
@@ -72,6 +116,10 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	// cmd.SetErr(f.IOStreams.ErrOut) // just let it default to os.Stderr instead
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+	cmd.PersistentFlags().String("ca-cert", "", "Path to a PEM bundle of extra CA certificates to trust, overriding the ca_bundle config setting")
+	cmd.PersistentFlags().Bool("profile", false, "Print phase timings when the command finishes")
+	cmd.PersistentFlags().Bool("debug-dump", false, "On API errors, write the full request and response (credentials redacted) to a temp file and print its path")
+	cmd.PersistentFlags().Int("concurrency", 0, "Bound on concurrent HTTP requests for commands that fan out, overriding the concurrency config setting")
 
 	// override Cobra's default behaviors unless an opt-out has been set
 	if os.Getenv("BB_COBRA") == "" {
@@ -100,24 +148,44 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(aliasCmd.NewCmdAlias(f))
 	cmd.AddCommand(authCmd.NewCmdAuth(f))
 	cmd.AddCommand(configCmd.NewCmdConfig(f))
+	cmd.AddCommand(filterCmd.NewCmdFilter(f))
 	cmd.AddCommand(completionCmd.NewCmdCompletion(f.IOStreams))
 	cmd.AddCommand(projectCmd.NewCmdProject(f))
+	cmd.AddCommand(upgradeCmd.NewCmdUpgrade(f, version, nil))
+	cmd.AddCommand(foreachCmd.NewCmdForeach(f, nil))
+	cmd.AddCommand(historyCmd.NewCmdHistory(f, nil))
+	cmd.AddCommand(redoCmd.NewCmdRedo(f, nil))
+	cmd.AddCommand(issueBranchCmd.NewCmdIssueBranch(f, nil))
+	cmd.AddCommand(notifyCmd.NewCmdNotify(f, nil))
+	cmd.AddCommand(openCmd.NewCmdOpen(f, nil))
+	cmd.AddCommand(remindersCmd.NewCmdReminders(f, nil))
+	// prompt-status deliberately uses the plain BaseRepo resolver; the smart
+	// one can hit the network, which the fast path must never do
+	cmd.AddCommand(promptStatusCmd.NewCmdPromptStatus(f, nil))
 
 	// below here at the commands that require the "intelligent" BaseRepo resolver
 	repoResolvingCmdFactory := *f
 	repoResolvingCmdFactory.BaseRepo = factory.SmartBaseRepoFunc(f)
 
 	cmd.AddCommand(apiCmd.NewCmdApi(&repoResolvingCmdFactory, nil))
+	cmd.AddCommand(branchCmd.NewCmdBranch(&repoResolvingCmdFactory))
 	cmd.AddCommand(browseCmd.NewCmdBrowse(&repoResolvingCmdFactory, nil))
+	cmd.AddCommand(cacheCmd.NewCmdCache(&repoResolvingCmdFactory))
+	cmd.AddCommand(checksCmd.NewCmdChecks(&repoResolvingCmdFactory))
+	cmd.AddCommand(commitCmd.NewCmdCommit(&repoResolvingCmdFactory))
+	cmd.AddCommand(depsCmd.NewCmdDeps(&repoResolvingCmdFactory))
+	cmd.AddCommand(hooksCmd.NewCmdHooks(&repoResolvingCmdFactory))
 	cmd.AddCommand(issueCmd.NewCmdIssue(&repoResolvingCmdFactory))
 	cmd.AddCommand(pipelineCmd.NewCmdPipeline(&repoResolvingCmdFactory))
 	cmd.AddCommand(prCmd.NewCmdPR(&repoResolvingCmdFactory))
+	cmd.AddCommand(quickCmd.NewCmdQuick(&repoResolvingCmdFactory, nil))
 	cmd.AddCommand(repoCmd.NewCmdRepo(&repoResolvingCmdFactory))
 	cmd.AddCommand(searchCmd.NewCmdSearch(&repoResolvingCmdFactory))
 	cmd.AddCommand(secretCmd.NewCmdSecret(&repoResolvingCmdFactory))
 	cmd.AddCommand(sshKeyCmd.NewCmdSSHKey(&repoResolvingCmdFactory))
 	cmd.AddCommand(statusCmd.NewCmdStatus(&repoResolvingCmdFactory, nil))
 	cmd.AddCommand(variableCmd.NewCmdVariable(&repoResolvingCmdFactory))
+	cmd.AddCommand(webhookCmd.NewCmdWebhook(&repoResolvingCmdFactory))
 	cmd.AddCommand(workspaceCmd.NewCmdWorkspace(&repoResolvingCmdFactory))
 
 	// Help topics
@@ -149,7 +217,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 				})
 			}
 			if strings.HasPrefix(aliasValue, "!") {
-				shellAliasCmd := NewCmdShellAlias(io, parentArgs[0], aliasValue)
+				shellAliasCmd := NewCmdShellAlias(f, parentArgs[0], aliasValue)
 				parentCmd.AddCommand(shellAliasCmd)
 			} else {
 				aliasCmd := NewCmdAlias(io, parentArgs[0], aliasValue)
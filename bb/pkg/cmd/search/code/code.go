@@ -124,22 +124,41 @@ func searchCode(client *http.Client, workspace, repo, query string, limit int) (
 	if repo != "" {
 		// Search within a specific repository
 		path = fmt.Sprintf("repositories/%s/%s/src?q=%s&pagelen=%d",
-			workspace, repo, encodedQuery, limit)
+			workspace, repo, encodedQuery, min(limit, 100))
 	} else {
 		// Search across the workspace using the code search API
 		// Note: This is a simplified implementation - Bitbucket's code search
 		// API has specific requirements and may not be available for all workspaces
 		path = fmt.Sprintf("workspaces/%s/search/code?search_query=%s&pagelen=%d",
-			workspace, encodedQuery, limit)
+			workspace, encodedQuery, min(limit, 100))
 	}
 
-	var result CodeSearchResults
-	err := apiClient.Get("bitbucket.org", path, &result)
-	if err != nil {
-		return nil, err
-	}
+	var results []CodeSearchResult
+	nextURL := ""
+	for {
+		var page CodeSearchResults
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	return result.Values, nil
+		for _, r := range page.Values {
+			results = append(results, r)
+			if len(results) >= limit {
+				return results, nil
+			}
+		}
+
+		if page.Next == "" {
+			return results, nil
+		}
+		nextURL = page.Next
+	}
 }
 
 func printResults(io *iostreams.IOStreams, results []CodeSearchResult) error {
@@ -0,0 +1,200 @@
+package prs
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/repocache"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	prshared "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/pr/shared"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PrsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+
+	Query     string
+	Workspace string
+	State     string
+	Limit     int
+
+	Concurrency int
+}
+
+func NewCmdPrs(f *cmdutil.Factory, runF func(*PrsOptions) error) *cobra.Command {
+	opts := &PrsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "prs <query>",
+		Short: "Search for pull requests across a workspace",
+		Long: heredoc.Doc(`
+			Search for pull requests matching a query across every repository in
+			a workspace.
+
+			The query is matched against pull request titles and descriptions.
+			Bitbucket Cloud has no workspace-wide pull request search endpoint,
+			so the search fans out one list query per repository; the repository
+			list is cached for an hour and the requests run with bounded
+			concurrency.
+		`),
+		Example: heredoc.Doc(`
+			$ bb search prs "payment bug" --workspace myworkspace
+			$ bb search prs timeout --workspace myworkspace --state all --limit 10
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Query = args[0]
+
+			if opts.Workspace == "" {
+				return cmdutil.FlagErrorf("--workspace is required")
+			}
+
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return prsRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace to search in (required)")
+	cmdutil.StringEnumFlag(cmd, &opts.State, "state", "s", "open", []string{"open", "merged", "declined", "superseded", "all"}, "Filter by state")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of results")
+
+	return cmd
+}
+
+// match is one search hit together with the repository it came from.
+type match struct {
+	repo string
+	pr   prshared.PullRequest
+}
+
+func prsRun(opts *PrsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	repos, err := repocache.Repos(apiClient, "bitbucket.org", opts.Workspace)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	matches := searchPullRequests(apiClient, opts, repos, cmdutil.Concurrency(opts.Concurrency, cfg))
+	opts.IO.StopProgressIndicator()
+
+	if len(matches) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No pull requests found matching %q\n", opts.Query)
+		return nil
+	}
+
+	return printMatches(opts.IO, matches)
+}
+
+// searchPullRequests fans one pull request list query per repository out
+// across workers goroutines and merges the matches, newest first.
+// Repositories whose query fails (for example because pull requests are
+// disabled) contribute no matches.
+func searchPullRequests(client *api.Client, opts *PrsOptions, repos []repocache.Repo, workers int) []match {
+	quoted := strings.ReplaceAll(opts.Query, `"`, `\"`)
+	q := fmt.Sprintf(`(title ~ "%s" OR description ~ "%s")`, quoted, quoted)
+	if state := prshared.PRStateFromString(opts.State); state != "" {
+		q += fmt.Sprintf(` AND state="%s"`, state)
+	}
+
+	params := url.Values{}
+	params.Set("q", q)
+	params.Set("pagelen", fmt.Sprintf("%d", min(opts.Limit, 50)))
+
+	var mu sync.Mutex
+	var matches []match
+	jobs := make(chan repocache.Repo)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for repo := range jobs {
+				path := fmt.Sprintf("repositories/%s/%s/pullrequests?%s",
+					opts.Workspace, repo.Slug, params.Encode())
+
+				var page prshared.PullRequestList
+				if err := client.Get("bitbucket.org", path, &page); err != nil {
+					continue
+				}
+
+				mu.Lock()
+				for _, pr := range page.Values {
+					matches = append(matches, match{repo: repo.FullName, pr: pr})
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, repo := range repos {
+		jobs <- repo
+	}
+	close(jobs)
+	wg.Wait()
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].pr.UpdatedOn > matches[j].pr.UpdatedOn
+	})
+	if len(matches) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+
+	return matches
+}
+
+func printMatches(io *iostreams.IOStreams, matches []match) error {
+	cs := io.ColorScheme()
+
+	tp := tableprinter.New(io, tableprinter.WithHeader("REPOSITORY", "ID", "TITLE", "STATE", "UPDATED"))
+
+	for _, m := range matches {
+		tp.AddField(m.repo)
+		tp.AddField(fmt.Sprintf("#%d", m.pr.ID), tableprinter.WithColor(cs.Cyan))
+		tp.AddField(text.Truncate(50, m.pr.Title))
+		tp.AddField(m.pr.StateDisplay())
+
+		if t, err := time.Parse(time.RFC3339, m.pr.UpdatedOn); err == nil {
+			tp.AddField(text.FuzzyAgoAbbr(time.Now(), t))
+		} else {
+			tp.AddField("-")
+		}
+
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
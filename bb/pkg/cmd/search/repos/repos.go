@@ -118,15 +118,34 @@ func searchRepositories(client *http.Client, workspace, query string, limit int)
 	// Build the search query
 	// The Bitbucket API uses a specific query syntax
 	encodedQuery := url.QueryEscape(fmt.Sprintf("name~\"%s\"", query))
-	path := fmt.Sprintf("repositories/%s?q=%s&pagelen=%d", workspace, encodedQuery, limit)
+	path := fmt.Sprintf("repositories/%s?q=%s&pagelen=%d", workspace, encodedQuery, min(limit, 100))
+
+	var repos []Repository
+	nextURL := ""
+	for {
+		var page RepositoryList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
 
-	var result RepositoryList
-	err := apiClient.Get("bitbucket.org", path, &result)
-	if err != nil {
-		return nil, err
-	}
+		for _, r := range page.Values {
+			repos = append(repos, r)
+			if len(repos) >= limit {
+				return repos, nil
+			}
+		}
 
-	return result.Values, nil
+		if page.Next == "" {
+			return repos, nil
+		}
+		nextURL = page.Next
+	}
 }
 
 func printRepositories(io *iostreams.IOStreams, repos []Repository) error {
@@ -3,6 +3,7 @@ package search
 import (
 	"github.com/MakeNowJust/heredoc"
 	codeCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/search/code"
+	prsCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/search/prs"
 	reposCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/search/repos"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -11,21 +12,23 @@ import (
 func NewCmdSearch(f *cmdutil.Factory) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "search <command>",
-		Short: "Search for repositories and code",
+		Short: "Search for repositories, code, and pull requests",
 		Long: heredoc.Doc(`
-			Search for repositories and code across Bitbucket.
+			Search for repositories, code, and pull requests across Bitbucket.
 
 			You can search within a specific workspace or across all accessible workspaces.
 		`),
 		Example: heredoc.Doc(`
 			$ bb search repos cli --workspace myworkspace
 			$ bb search code "func main" --workspace myworkspace
+			$ bb search prs "payment bug" --workspace myworkspace
 		`),
 		GroupID: "core",
 	}
 
 	cmd.AddCommand(reposCmd.NewCmdRepos(f, nil))
 	cmd.AddCommand(codeCmd.NewCmdCode(f, nil))
+	cmd.AddCommand(prsCmd.NewCmdPrs(f, nil))
 
 	return cmd
 }
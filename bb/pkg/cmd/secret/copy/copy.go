@@ -0,0 +1,266 @@
+package copy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CopyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	Prompter   prompter.Prompter
+
+	FromRepo    string
+	ToRepo      string
+	Environment string
+}
+
+func NewCmdCopy(f *cmdutil.Factory, runF func(*CopyOptions) error) *cobra.Command {
+	opts := &CopyOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "copy --from <workspace/repo> --to <workspace/repo>",
+		Short: "Copy secrets from one repository to another",
+		Long: heredoc.Doc(`
+			Copy pipeline secrets (secured variables) from one repository to another.
+
+			Secret values cannot be read back from the Bitbucket API, so this command
+			lists the secret names defined on the source repository and prompts for
+			each value interactively. It requires an interactive terminal.
+
+			When --environment is given, secrets are copied between the deployment
+			environments of the same name in both repositories.
+
+			If --from or --to is omitted, the current repository is used.
+		`),
+		Example: heredoc.Doc(`
+			# Copy secrets from another repository into the current one
+			$ bb secret copy --from myworkspace/template-repo
+
+			# Copy secrets for a deployment environment
+			$ bb secret copy --from myworkspace/repoA --to myworkspace/repoB --environment staging
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.FromRepo == "" && opts.ToRepo == "" {
+				return cmdutil.FlagErrorf("at least one of --from or --to is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return copyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.FromRepo, "from", "", "Source repository in WORKSPACE/REPO_SLUG format")
+	cmd.Flags().StringVar(&opts.ToRepo, "to", "", "Target repository in WORKSPACE/REPO_SLUG format")
+	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Copy secrets for a specific deployment environment")
+
+	return cmd
+}
+
+func copyRun(opts *CopyOptions) error {
+	if !opts.IO.CanPrompt() {
+		return cmdutil.FlagErrorf("secret values cannot be read from the API; 'bb secret copy' requires an interactive terminal to re-enter them")
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var fromRepo, toRepo bbrepo.Interface
+	if opts.FromRepo != "" {
+		fromRepo, err = bbrepo.FromFullName(opts.FromRepo)
+		if err != nil {
+			return fmt.Errorf("invalid --from repository: %w", err)
+		}
+	} else {
+		fromRepo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+	if opts.ToRepo != "" {
+		toRepo, err = bbrepo.FromFullName(opts.ToRepo)
+		if err != nil {
+			return fmt.Errorf("invalid --to repository: %w", err)
+		}
+	} else {
+		toRepo, err = opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+	}
+
+	if bbrepo.IsSame(fromRepo, toRepo) {
+		return fmt.Errorf("source and target repositories are the same: %s", bbrepo.FullName(fromRepo))
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	// The environment path segment must be a UUID, and the same name maps to
+	// a different UUID in each repository, so resolve it against both sides
+	fromEnv, toEnv := opts.Environment, opts.Environment
+	if opts.Environment != "" {
+		opts.IO.StartProgressIndicator()
+		fromEnv, err = api.ResolveEnvironment(apiClient, fromRepo.RepoHost(), fromRepo.RepoWorkspace(), fromRepo.RepoSlug(), opts.Environment)
+		if err == nil {
+			toEnv, err = api.ResolveEnvironment(apiClient, toRepo.RepoHost(), toRepo.RepoWorkspace(), toRepo.RepoSlug(), opts.Environment)
+		}
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	names, err := fetchSecretNames(apiClient, fromRepo, fromEnv)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to list secrets for %s: %w", bbrepo.FullName(fromRepo), err)
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if len(names) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No secrets found on %s\n", bbrepo.FullName(fromRepo))
+		return nil
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Secret values cannot be read from the API; enter each value to copy it, or leave blank to skip.\n",
+		cs.WarningIcon())
+
+	copied := 0
+	for _, name := range names {
+		value, err := opts.Prompter.Password(fmt.Sprintf("Value for %s", name))
+		if err != nil {
+			return err
+		}
+		if value == "" {
+			fmt.Fprintf(opts.IO.ErrOut, "Skipping %s\n", name)
+			continue
+		}
+
+		opts.IO.StartProgressIndicator()
+		err = setSecret(apiClient, toRepo, name, value, toEnv)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to set secret %q on %s: %w", name, bbrepo.FullName(toRepo), err)
+		}
+		copied++
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Copied %d secret(s) from %s to %s\n",
+			cs.SuccessIcon(), copied, cs.Bold(bbrepo.FullName(fromRepo)), cs.Bold(bbrepo.FullName(toRepo)))
+	}
+
+	return nil
+}
+
+func variablesPath(repo bbrepo.Interface, environment string) string {
+	if environment != "" {
+		return fmt.Sprintf("repositories/%s/%s/deployments_config/environments/%s/variables",
+			repo.RepoWorkspace(), repo.RepoSlug(), environment)
+	}
+	return fmt.Sprintf("repositories/%s/%s/pipelines_config/variables",
+		repo.RepoWorkspace(), repo.RepoSlug())
+}
+
+// fetchSecretNames returns the names of secured variables defined on a repository.
+func fetchSecretNames(apiClient *api.Client, repo bbrepo.Interface, environment string) ([]string, error) {
+	var result struct {
+		Values []struct {
+			Key     string `json:"key"`
+			Secured bool   `json:"secured"`
+		} `json:"values"`
+	}
+	err := apiClient.Get(repo.RepoHost(), variablesPath(repo, environment)+"?pagelen=100", &result)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, v := range result.Values {
+		if v.Secured {
+			names = append(names, v.Key)
+		}
+	}
+	return names, nil
+}
+
+type secretPayload struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+func setSecret(apiClient *api.Client, repo bbrepo.Interface, name, value, environment string) error {
+	payload := secretPayload{
+		Key:     name,
+		Value:   value,
+		Secured: true,
+	}
+
+	err := apiClient.Post(repo.RepoHost(), variablesPath(repo, environment), payload, nil)
+	if err != nil {
+		if api.IsConflictError(err) || strings.Contains(err.Error(), "already exists") {
+			return updateExistingSecret(apiClient, repo, name, value, environment)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func updateExistingSecret(apiClient *api.Client, repo bbrepo.Interface, name, value, environment string) error {
+	var list struct {
+		Values []struct {
+			UUID string `json:"uuid"`
+			Key  string `json:"key"`
+		} `json:"values"`
+	}
+	if err := apiClient.Get(repo.RepoHost(), variablesPath(repo, environment)+"?pagelen=100", &list); err != nil {
+		return err
+	}
+
+	var uuid string
+	for _, v := range list.Values {
+		if v.Key == name {
+			uuid = v.UUID
+			break
+		}
+	}
+
+	if uuid == "" {
+		return fmt.Errorf("secret %q not found", name)
+	}
+
+	uuid = strings.Trim(uuid, "{}")
+	updatePath := fmt.Sprintf("%s/{%s}", variablesPath(repo, environment), uuid)
+
+	payload := secretPayload{
+		Key:     name,
+		Value:   value,
+		Secured: true,
+	}
+
+	return apiClient.Put(repo.RepoHost(), updatePath, payload, nil)
+}
@@ -0,0 +1,135 @@
+package restore
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/progress"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/secretcache"
+	setCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret/set"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RestoreOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+	Config     func() (gh.Config, error)
+
+	Progress string
+}
+
+func NewCmdRestore(f *cmdutil.Factory, runF func(*RestoreOptions) error) *cobra.Command {
+	opts := &RestoreOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore secrets from the local encrypted cache",
+		Long: heredoc.Doc(`
+			Restore the secrets of a repository from the local GPG-encrypted cache.
+
+			Only secrets that were set with 'bb secret set --cache' are in the
+			cache. This is useful after recreating a repository, since Bitbucket
+			never returns secured values.
+		`),
+		Example: heredoc.Doc(`
+			$ bb secret restore
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Progress != "" && opts.Progress != "json" {
+				return cmdutil.FlagErrorf("invalid --progress mode %q, valid options: json", opts.Progress)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return restoreRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Progress, "progress", "", "Emit machine-readable progress events to stderr: {json}")
+
+	return cmd
+}
+
+func restoreRun(opts *RestoreOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	cache, err := secretcache.NewFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	entries, err := cache.Entries(repo)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("no cached secrets found for %s", bbrepo.FullName(repo))
+	}
+
+	var emitter *progress.Emitter
+	if opts.Progress == "json" {
+		emitter = progress.New(opts.IO.ErrOut, "secret restore")
+	}
+	emitter.Start(len(entries))
+
+	cs := opts.IO.ColorScheme()
+	for i, entry := range entries {
+		value, err := cache.Decrypt(entry)
+		if err != nil {
+			return err
+		}
+
+		opts.IO.StartProgressIndicator()
+		err = setCmd.CreateOrUpdateSecret(httpClient, repo, entry.Name, value, entry.Environment)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			emitter.Error(entry.Name, err.Error())
+			return fmt.Errorf("failed to restore secret %s: %w", entry.Name, err)
+		}
+		emitter.Progress(entry.Name, i+1, len(entries))
+
+		if opts.IO.IsStdoutTTY() {
+			if entry.Environment != "" {
+				fmt.Fprintf(opts.IO.Out, "%s Restored secret %s for environment %s\n",
+					cs.SuccessIcon(), cs.Bold(entry.Name), cs.Cyan(entry.Environment))
+			} else {
+				fmt.Fprintf(opts.IO.Out, "%s Restored secret %s\n",
+					cs.SuccessIcon(), cs.Bold(entry.Name))
+			}
+		}
+	}
+
+	emitter.Done(fmt.Sprintf("restored %d secrets", len(entries)))
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "Restored %d secrets to %s\n", len(entries), bbrepo.FullName(repo))
+	}
+
+	return nil
+}
@@ -2,8 +2,10 @@ package secret
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	copyCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret/copy"
 	deleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret/delete"
 	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret/list"
+	restoreCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret/restore"
 	setCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/secret/set"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -31,6 +33,8 @@ func NewCmdSecret(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(listCmd.NewCmdList(f, nil))
 	cmd.AddCommand(setCmd.NewCmdSet(f, nil))
 	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(copyCmd.NewCmdCopy(f, nil))
+	cmd.AddCommand(restoreCmd.NewCmdRestore(f, nil))
 
 	return cmd
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/secretcache"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -20,11 +22,13 @@ type SetOptions struct {
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (bbrepo.Interface, error)
 	Prompter   prompter.Prompter
+	Config     func() (gh.Config, error)
 
 	SecretName  string
 	SecretValue string
 	Environment string
 	Body        string
+	Cache       bool
 }
 
 func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command {
@@ -33,6 +37,7 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 		HttpClient: f.HttpClient,
 		BaseRepo:   f.BaseRepo,
 		Prompter:   f.Prompter,
+		Config:     f.Config,
 	}
 
 	cmd := &cobra.Command{
@@ -47,6 +52,11 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 			- Interactive prompt (if neither of the above)
 
 			Secrets are always stored as secured variables (encrypted).
+
+			With --cache, the value is additionally saved to a local GPG-encrypted
+			cache so that 'bb secret restore' can repopulate the secrets of a
+			recreated repository. Bitbucket never returns secured values, so the
+			cache is the only copy you can read back.
 		`),
 		Example: heredoc.Doc(`
 			# Set a secret interactively
@@ -60,6 +70,9 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 
 			# Set a secret for a specific environment
 			$ bb secret set API_KEY --environment production --body "prod-value"
+
+			# Set a secret and keep an encrypted local copy
+			$ bb secret set API_KEY --cache
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -74,6 +87,7 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Secret value")
 	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Set secret for a specific deployment environment")
+	cmd.Flags().BoolVar(&opts.Cache, "cache", false, "Also save the value to the local encrypted secret cache")
 
 	return cmd
 }
@@ -115,14 +129,34 @@ func setRun(opts *SetOptions) error {
 		return cmdutil.FlagErrorf("secret value cannot be empty")
 	}
 
+	// Resolve the cache first so a misconfigured cache fails before the
+	// secret is pushed
+	var cache *secretcache.Cache
+	if opts.Cache {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		cache, err = secretcache.NewFromConfig(cfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
-	err = createOrUpdateSecret(httpClient, repo, opts.SecretName, secretValue, opts.Environment)
+	err = CreateOrUpdateSecret(httpClient, repo, opts.SecretName, secretValue, opts.Environment)
 	opts.IO.StopProgressIndicator()
 
 	if err != nil {
 		return err
 	}
 
+	if cache != nil {
+		if err := cache.Put(repo, opts.Environment, opts.SecretName, secretValue); err != nil {
+			return fmt.Errorf("secret was set, but caching it failed: %w", err)
+		}
+	}
+
 	if opts.IO.IsStdoutTTY() {
 		cs := opts.IO.ColorScheme()
 		if opts.Environment != "" {
@@ -143,11 +177,20 @@ type secretPayload struct {
 	Secured bool   `json:"secured"`
 }
 
-func createOrUpdateSecret(client *http.Client, repo bbrepo.Interface, name, value, environment string) error {
+// CreateOrUpdateSecret sets a secured variable, updating in place when one
+// with the same name already exists.
+func CreateOrUpdateSecret(client *http.Client, repo bbrepo.Interface, name, value, environment string) error {
 	apiClient := api.NewClientFromHTTP(client)
 
 	var path string
 	if environment != "" {
+		// Resolve the display name to a UUID so names with spaces or
+		// different casing still address the right environment
+		var err error
+		environment, err = api.ResolveEnvironment(apiClient, repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug(), environment)
+		if err != nil {
+			return err
+		}
 		path = fmt.Sprintf("repositories/%s/%s/deployments_config/environments/%s/variables",
 			repo.RepoWorkspace(), repo.RepoSlug(), environment)
 	} else {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -16,6 +17,7 @@ import (
 type DeleteOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	Prompter   prompter.Prompter
 
 	KeyID   string
@@ -26,6 +28,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	opts := &DeleteOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		Prompter:   f.Prompter,
 	}
 
@@ -64,12 +67,13 @@ func deleteRun(opts *DeleteOptions) error {
 		return err
 	}
 
-	// Confirm deletion
-	if !opts.Confirm {
-		if !opts.IO.CanPrompt() {
-			return cmdutil.FlagErrorf("--yes required when not running interactively")
-		}
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
 
+	// Confirm deletion
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
 		confirmed, err := opts.Prompter.Confirm(
 			fmt.Sprintf("Are you sure you want to delete SSH key %s?", opts.KeyID), false)
 		if err != nil {
@@ -78,6 +82,10 @@ func deleteRun(opts *DeleteOptions) error {
 		if !confirmed {
 			return cmdutil.CancelError
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	opts.IO.StartProgressIndicator()
@@ -5,6 +5,7 @@ import (
 	addCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/ssh-key/add"
 	deleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/ssh-key/delete"
 	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/ssh-key/list"
+	verifyHostCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/ssh-key/verify-host"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -30,6 +31,7 @@ func NewCmdSSHKey(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(listCmd.NewCmdList(f, nil))
 	cmd.AddCommand(addCmd.NewCmdAdd(f, nil))
 	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(verifyHostCmd.NewCmdVerifyHost(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,214 @@
+package verifyhost
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/safeexec"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type VerifyHostOptions struct {
+	IO *iostreams.IOStreams
+
+	Hostname       string
+	Port           int
+	Write          bool
+	KnownHostsFile string
+}
+
+func NewCmdVerifyHost(f *cmdutil.Factory, runF func(*VerifyHostOptions) error) *cobra.Command {
+	opts := &VerifyHostOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "verify-host",
+		Short: "Show the SSH host key fingerprints of a Bitbucket host",
+		Long: heredoc.Doc(`
+			Fetch the SSH host keys of a Bitbucket host and display their fingerprints,
+			so they can be checked against the ones the server operator publishes.
+
+			With --write, the host keys are also appended to your known_hosts file,
+			which is useful when onboarding a self-hosted Bitbucket instance.
+		`),
+		Example: heredoc.Doc(`
+			# Show the host key fingerprints of bitbucket.org
+			$ bb ssh-key verify-host
+
+			# Verify a self-hosted instance and trust its keys
+			$ bb ssh-key verify-host --hostname bitbucket.example.com --write
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.KnownHostsFile == "" {
+				home, err := os.UserHomeDir()
+				if err != nil {
+					return err
+				}
+				opts.KnownHostsFile = filepath.Join(home, ".ssh", "known_hosts")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return verifyHostRun(opts)
+		},
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	cmd.Flags().StringVar(&opts.Hostname, "hostname", "bitbucket.org", "The Bitbucket hostname to scan")
+	cmd.Flags().IntVar(&opts.Port, "port", 22, "The SSH port of the host")
+	cmd.Flags().BoolVar(&opts.Write, "write", false, "Append the host keys to your known_hosts file")
+	cmd.Flags().StringVar(&opts.KnownHostsFile, "known-hosts-file", "", "Path of the known_hosts file to append to (default ~/.ssh/known_hosts)")
+
+	return cmd
+}
+
+func verifyHostRun(opts *VerifyHostOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	opts.IO.StartProgressIndicator()
+	hostKeys, err := scanHostKeys(opts.Hostname, opts.Port)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(hostKeys) == 0 {
+		return fmt.Errorf("no SSH host keys received from %s", opts.Hostname)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "SSH host key fingerprints for %s:\n\n", cs.Bold(opts.Hostname))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("TYPE", "FINGERPRINT"))
+	for _, key := range hostKeys {
+		tp.AddField(key.keyType)
+		tp.AddField(key.fingerprint)
+		tp.EndRow()
+	}
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	if !opts.Write {
+		return nil
+	}
+
+	added, err := appendKnownHosts(opts.KnownHostsFile, hostKeys)
+	if err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		if added == 0 {
+			fmt.Fprintf(opts.IO.Out, "\n%s All host keys already present in %s\n", cs.SuccessIcon(), opts.KnownHostsFile)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "\n%s Added %d host key(s) to %s\n", cs.SuccessIcon(), added, opts.KnownHostsFile)
+		}
+	}
+
+	return nil
+}
+
+// hostKey is one entry of ssh-keyscan output.
+type hostKey struct {
+	line        string
+	keyType     string
+	fingerprint string
+}
+
+func scanHostKeys(hostname string, port int) ([]hostKey, error) {
+	keyscanExe, err := safeexec.LookPath("ssh-keyscan")
+	if err != nil {
+		return nil, fmt.Errorf("could not find ssh-keyscan on your PATH: %w", err)
+	}
+
+	cmd := exec.Command(keyscanExe, "-p", fmt.Sprintf("%d", port), hostname)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("ssh-keyscan failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var keys []hostKey
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 3 {
+			continue
+		}
+		fingerprint, err := fingerprintSHA256(fields[2])
+		if err != nil {
+			continue
+		}
+		keys = append(keys, hostKey{line: line, keyType: fields[1], fingerprint: fingerprint})
+	}
+
+	return keys, nil
+}
+
+// fingerprintSHA256 renders a base64-encoded public key in the
+// "SHA256:..." format used by OpenSSH.
+func fingerprintSHA256(encodedKey string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// appendKnownHosts adds any host keys not already present to the known_hosts
+// file and reports how many were added.
+func appendKnownHosts(path string, keys []hostKey) (int, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	known := make(map[string]bool)
+	for _, line := range strings.Split(string(existing), "\n") {
+		known[strings.TrimSpace(line)] = true
+	}
+
+	var missing []string
+	for _, key := range keys {
+		if !known[key.line] {
+			missing = append(missing, key.line)
+		}
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(strings.Join(missing, "\n") + "\n"); err != nil {
+		return 0, err
+	}
+	return len(missing), nil
+}
@@ -1,12 +1,18 @@
 package status
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"os/signal"
 	"sync"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/snooze"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -15,14 +21,19 @@ import (
 type StatusOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 
-	Workspace string
+	Workspace   string
+	Concurrency int
+	Watch       bool
+	Interval    int
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
 	opts := &StatusOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 	}
 
 	cmd := &cobra.Command{
@@ -37,15 +48,27 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 			- Issues assigned to you
 
 			The output is scoped to a specific workspace.
+
+			With --watch, the output is cleared and redrawn every --interval
+			seconds until interrupted, turning the command into a lightweight
+			terminal dashboard.
 		`),
 		Example: heredoc.Doc(`
 			$ bb status --workspace myworkspace
+			$ bb status --workspace myworkspace --watch --interval 60
 		`),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.Workspace == "" {
 				return cmdutil.FlagErrorf("--workspace is required")
 			}
+			if cmd.Flags().Changed("interval") && !opts.Watch {
+				return cmdutil.FlagErrorf("--interval can only be used with --watch")
+			}
+			if opts.Interval < 1 {
+				return cmdutil.FlagErrorf("--interval must be at least 1 second")
+			}
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
 
 			if runF != nil {
 				return runF(opts)
@@ -55,6 +78,8 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	}
 
 	cmd.Flags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace to show status for (required)")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Refresh the output periodically instead of exiting")
+	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", 30, "Refresh interval in seconds when using --watch")
 
 	return cmd
 }
@@ -100,6 +125,7 @@ type PRList struct {
 // IssueList represents a paginated list of issues
 type IssueList struct {
 	Size   int     `json:"size"`
+	Next   string  `json:"next"`
 	Values []Issue `json:"values"`
 }
 
@@ -111,6 +137,49 @@ type User struct {
 }
 
 func statusRun(opts *StatusOptions) error {
+	if !opts.Watch {
+		return statusOnce(opts)
+	}
+	if !opts.IO.IsStdoutTTY() {
+		return cmdutil.FlagErrorf("--watch requires an interactive terminal")
+	}
+
+	// The shared client memoizes GET responses for the lifetime of the
+	// invocation; flush them each cycle or every redraw repeats the first
+	// fetch's data
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	// The alternate screen buffer keeps the shell's scrollback intact while
+	// the dashboard redraws over itself
+	opts.IO.StartAlternateScreenBuffer()
+	defer opts.IO.StopAlternateScreenBuffer()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	cs := opts.IO.ColorScheme()
+	interval := time.Duration(opts.Interval) * time.Second
+	for {
+		api.FlushDedupCache(httpClient)
+		opts.IO.RefreshScreen()
+		fmt.Fprintln(opts.IO.Out, cs.Muted(fmt.Sprintf("Refreshing every %ds, last updated %s. Press Ctrl+C to quit.",
+			opts.Interval, time.Now().Format("15:04:05"))))
+		fmt.Fprintln(opts.IO.Out)
+		if err := statusOnce(opts); err != nil {
+			return err
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func statusOnce(opts *StatusOptions) error {
 	httpClient, err := opts.HttpClient()
 	if err != nil {
 		return err
@@ -118,6 +187,12 @@ func statusRun(opts *StatusOptions) error {
 
 	cs := opts.IO.ColorScheme()
 
+	var cfg gh.Config
+	if opts.Config != nil {
+		cfg, _ = opts.Config()
+	}
+	workers := cmdutil.Concurrency(opts.Concurrency, cfg)
+
 	opts.IO.StartProgressIndicator()
 
 	// Fetch current user first
@@ -151,7 +226,7 @@ func statusRun(opts *StatusOptions) error {
 	// Get issues assigned to user
 	go func() {
 		defer wg.Done()
-		assignedIssues, issuesErr = fetchAssignedIssues(httpClient, opts.Workspace, user.UUID)
+		assignedIssues, issuesErr = fetchAssignedIssues(httpClient, opts.Workspace, user.UUID, workers)
 	}()
 
 	wg.Wait()
@@ -168,6 +243,21 @@ func statusRun(opts *StatusOptions) error {
 		assignedIssues = nil
 	}
 
+	// Hide issues the user has snoozed locally (see bb issue snooze)
+	if hidden, err := snooze.List(); err == nil && len(hidden) > 0 {
+		snoozed := map[string]bool{}
+		for _, e := range hidden {
+			snoozed[fmt.Sprintf("%s#%d", e.Repo, e.ID)] = true
+		}
+		kept := make([]Issue, 0, len(assignedIssues))
+		for _, issue := range assignedIssues {
+			if !snoozed[fmt.Sprintf("%s#%d", issue.Repository.FullName, issue.ID)] {
+				kept = append(kept, issue)
+			}
+		}
+		assignedIssues = kept
+	}
+
 	// Print results
 	hasSomething := false
 
@@ -250,42 +340,104 @@ func fetchUserPRs(client *http.Client, workspace, userUUID, role string) ([]Pull
 	return result.Values, nil
 }
 
-func fetchAssignedIssues(client *http.Client, workspace, userUUID string) ([]Issue, error) {
+// issueRepoLimit caps how many repositories with issues enabled are queried.
+const (
+	issueRepoLimit = 100
+)
+
+func fetchAssignedIssues(client *http.Client, workspace, userUUID string, workers int) ([]Issue, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
 	// This queries for issues assigned to the user across the workspace
 	// Note: Bitbucket's issue API requires querying per-repository
-	// This is a simplified implementation that queries the workspace level
 	query := fmt.Sprintf("assignee.uuid=\"%s\" AND state!=\"closed\" AND state!=\"resolved\"", userUUID)
-	path := fmt.Sprintf("repositories/%s?q=has_issues=true&pagelen=10", workspace)
 
-	// Get repos with issues enabled
+	// Get repos with issues enabled, following pagination up to the limit
+	type repoInfo struct {
+		FullName string `json:"full_name"`
+		Slug     string `json:"slug"`
+	}
 	type RepoList struct {
-		Values []struct {
-			FullName string `json:"full_name"`
-			Slug     string `json:"slug"`
-		} `json:"values"`
+		Values []repoInfo `json:"values"`
+		Next   string     `json:"next"`
 	}
 
-	var repos RepoList
-	if err := apiClient.Get("bitbucket.org", path, &repos); err != nil {
-		return nil, err
+	var repos []repoInfo
+	path := fmt.Sprintf("repositories/%s?q=has_issues=true&pagelen=100", workspace)
+	nextURL := ""
+	for {
+		var page RepoList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		repos = append(repos, page.Values...)
+		if len(repos) >= issueRepoLimit || page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+	if len(repos) > issueRepoLimit {
+		repos = repos[:issueRepoLimit]
 	}
 
-	var allIssues []Issue
-	for _, repo := range repos.Values {
-		issuePath := fmt.Sprintf("repositories/%s/%s/issues?q=%s&pagelen=10",
-			workspace, repo.Slug, query)
+	// Query each repo's issues through a bounded worker pool, keeping the
+	// per-repo results in repository order
+	results := make([][]Issue, len(repos))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
 
-		var issues IssueList
-		if err := apiClient.Get("bitbucket.org", issuePath, &issues); err != nil {
-			continue // Skip repos where we can't fetch issues
-		}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				repo := repos[i]
+				issuePath := fmt.Sprintf("repositories/%s/%s/issues?q=%s&pagelen=100",
+					workspace, repo.Slug, query)
+
+				var repoIssues []Issue
+				issueURL := ""
+				for {
+					var issues IssueList
+					var err error
+					if issueURL == "" {
+						err = apiClient.Get("bitbucket.org", issuePath, &issues)
+					} else {
+						err = apiClient.RESTWithURL("GET", issueURL, nil, &issues)
+					}
+					if err != nil {
+						repoIssues = nil
+						break // Skip repos where we can't fetch issues
+					}
+					for _, issue := range issues.Values {
+						issue.Repository.FullName = repo.FullName
+						repoIssues = append(repoIssues, issue)
+					}
+					if issues.Next == "" {
+						break
+					}
+					issueURL = issues.Next
+				}
+				results[i] = repoIssues
+			}
+		}()
+	}
 
-		for _, issue := range issues.Values {
-			issue.Repository.FullName = repo.FullName
-			allIssues = append(allIssues, issue)
-		}
+	for i := range repos {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var allIssues []Issue
+	for _, repoIssues := range results {
+		allIssues = append(allIssues, repoIssues...)
 	}
 
 	return allIssues, nil
@@ -0,0 +1,388 @@
+package upgrade
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/hashicorp/go-version"
+	"github.com/spf13/cobra"
+)
+
+// releaseRepo is the GitHub repository that hosts Bitbucket CLI releases.
+const releaseRepo = "dlbroadfoot/bitbucket-cli"
+
+type UpgradeOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Executable func() string
+
+	CurrentVersion string
+	CheckOnly      bool
+}
+
+type release struct {
+	TagName string         `json:"tag_name"`
+	URL     string         `json:"html_url"`
+	Assets  []releaseAsset `json:"assets"`
+}
+
+type releaseAsset struct {
+	Name        string `json:"name"`
+	DownloadURL string `json:"browser_download_url"`
+}
+
+func NewCmdUpgrade(f *cmdutil.Factory, currentVersion string, runF func(*UpgradeOptions) error) *cobra.Command {
+	opts := &UpgradeOptions{
+		IO:             f.IOStreams,
+		HttpClient:     f.HttpClient,
+		Executable:     f.Executable,
+		CurrentVersion: currentVersion,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade bb to the latest release",
+		Long: heredoc.Doc(`
+			Upgrade bb to the latest release.
+
+			The latest release is downloaded for the current platform, verified
+			against the published checksums, and atomically swapped in place of
+			the running executable.
+
+			Installations managed by a package manager (for example Homebrew or
+			a Linux distribution package) are detected and left untouched; use
+			the package manager to upgrade those instead.
+		`),
+		Example: heredoc.Doc(`
+			# Upgrade to the latest release
+			$ bb upgrade
+
+			# Only check whether a newer release exists
+			$ bb upgrade --check-only
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return upgradeRun(opts)
+		},
+	}
+
+	cmdutil.DisableAuthCheck(cmd)
+
+	cmd.Flags().BoolVar(&opts.CheckOnly, "check-only", false, "Check for a newer release without installing it")
+
+	return cmd
+}
+
+func upgradeRun(opts *UpgradeOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	exe, err := resolveExecutable(opts.Executable())
+	if err != nil {
+		return fmt.Errorf("could not locate the bb executable: %w", err)
+	}
+
+	if manager := detectManagedInstall(exe); manager != "" && !opts.CheckOnly {
+		return fmt.Errorf("this installation of bb appears to be managed by %s; upgrade it with %s instead", manager, manager)
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	rel, err := fetchLatestRelease(httpClient)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to check for a newer release: %w", err)
+	}
+
+	if !versionGreaterThan(rel.TagName, opts.CurrentVersion) {
+		fmt.Fprintf(opts.IO.Out, "%s bb is up to date (%s)\n", cs.SuccessIcon(), strings.TrimPrefix(opts.CurrentVersion, "v"))
+		return nil
+	}
+
+	if opts.CheckOnly {
+		fmt.Fprintf(opts.IO.Out, "A newer release of bb is available: %s → %s\n%s\n",
+			strings.TrimPrefix(opts.CurrentVersion, "v"), strings.TrimPrefix(rel.TagName, "v"), rel.URL)
+		return nil
+	}
+
+	asset := pickAsset(rel.Assets, runtime.GOOS, runtime.GOARCH)
+	if asset == nil {
+		return fmt.Errorf("no release asset found for %s/%s; see %s", runtime.GOOS, runtime.GOARCH, rel.URL)
+	}
+
+	checksums := pickChecksumsAsset(rel.Assets)
+	if checksums == nil {
+		return fmt.Errorf("release %s does not publish checksums; refusing to upgrade", rel.TagName)
+	}
+
+	opts.IO.StartProgressIndicator()
+	assetData, err := download(httpClient, asset.DownloadURL)
+	if err == nil {
+		var checksumData []byte
+		checksumData, err = download(httpClient, checksums.DownloadURL)
+		if err == nil {
+			err = verifyChecksum(checksumData, asset.Name, assetData)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	binary, err := extractBinary(asset.Name, assetData)
+	if err != nil {
+		return err
+	}
+
+	if err := replaceExecutable(exe, binary); err != nil {
+		return fmt.Errorf("failed to install the new release: %w", err)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Upgraded bb to %s\n", cs.SuccessIcon(), strings.TrimPrefix(rel.TagName, "v"))
+	return nil
+}
+
+func fetchLatestRelease(client *http.Client) (*release, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/releases/latest", releaseRepo)
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP %d", resp.StatusCode)
+	}
+
+	var rel release
+	if err := json.NewDecoder(resp.Body).Decode(&rel); err != nil {
+		return nil, err
+	}
+	return &rel, nil
+}
+
+// downloadTimeout bounds a single asset download. It is deliberately generous:
+// it only has to catch a stalled connection, not pace a slow one.
+const downloadTimeout = 10 * time.Minute
+
+func download(client *http.Client, url string) ([]byte, error) {
+	// The factory client's overall timeout covers reading the whole body,
+	// which a multi-megabyte asset on a slow link can easily exceed. Keep the
+	// transport (proxy and TLS settings) but bound the download per request
+	// instead.
+	dl := *client
+	dl.Timeout = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), downloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dl.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: HTTP %d", url, resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// pickAsset selects the release asset built for the given platform.
+func pickAsset(assets []releaseAsset, goos, goarch string) *releaseAsset {
+	for i, a := range assets {
+		name := strings.ToLower(a.Name)
+		if strings.Contains(name, goos) && strings.Contains(name, goarch) {
+			return &assets[i]
+		}
+	}
+	return nil
+}
+
+func pickChecksumsAsset(assets []releaseAsset) *releaseAsset {
+	for i, a := range assets {
+		if strings.Contains(strings.ToLower(a.Name), "checksums") {
+			return &assets[i]
+		}
+	}
+	return nil
+}
+
+// verifyChecksum checks data against the sha256 entry for name in a
+// checksums.txt-style manifest of "<hex digest>  <file name>" lines.
+func verifyChecksum(manifest []byte, name string, data []byte) error {
+	var want string
+	for _, line := range strings.Split(string(manifest), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == name {
+			want = strings.ToLower(fields[0])
+			break
+		}
+	}
+	if want == "" {
+		return fmt.Errorf("no checksum found for %q", name)
+	}
+
+	sum := sha256.Sum256(data)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return fmt.Errorf("checksum mismatch for %q: expected %s, got %s", name, want, got)
+	}
+	return nil
+}
+
+// extractBinary returns the bb executable contained in a release asset,
+// which may be a tarball, a zip archive, or the raw binary itself.
+func extractBinary(name string, data []byte) ([]byte, error) {
+	switch {
+	case strings.HasSuffix(name, ".tar.gz") || strings.HasSuffix(name, ".tgz"):
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+
+		tr := tar.NewReader(gz)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if hdr.Typeflag == tar.TypeReg && isBinaryName(hdr.Name) {
+				return io.ReadAll(tr)
+			}
+		}
+		return nil, fmt.Errorf("no bb binary found in %q", name)
+	case strings.HasSuffix(name, ".zip"):
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range zr.File {
+			if isBinaryName(f.Name) {
+				rc, err := f.Open()
+				if err != nil {
+					return nil, err
+				}
+				defer rc.Close()
+				return io.ReadAll(rc)
+			}
+		}
+		return nil, fmt.Errorf("no bb binary found in %q", name)
+	default:
+		return data, nil
+	}
+}
+
+func isBinaryName(path string) bool {
+	base := filepath.Base(path)
+	return base == "bb" || base == "bb.exe"
+}
+
+// replaceExecutable atomically swaps the binary at exe for the new contents
+// by writing a sibling temp file and renaming it into place.
+func replaceExecutable(exe string, binary []byte) error {
+	dir := filepath.Dir(exe)
+
+	tmp, err := os.CreateTemp(dir, ".bb-upgrade-*")
+	if err != nil {
+		return err
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpName, 0755); err != nil {
+		return err
+	}
+
+	// Windows cannot replace a running executable in place, but it can be
+	// renamed aside first.
+	if runtime.GOOS == "windows" {
+		old := exe + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(exe, old); err != nil {
+			return err
+		}
+	}
+
+	return os.Rename(tmpName, exe)
+}
+
+// resolveExecutable follows symlinks so managed-install detection and the
+// final rename act on the real binary.
+func resolveExecutable(exe string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(exe)
+	if err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
+
+// detectManagedInstall reports the package manager that appears to own the
+// binary at path, or "" when it looks self-installed.
+func detectManagedInstall(path string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(path, `\`, "/"))
+
+	switch {
+	case strings.Contains(normalized, "/cellar/") || strings.Contains(normalized, "/homebrew/") || strings.Contains(normalized, "/linuxbrew/"):
+		return "Homebrew"
+	case strings.Contains(normalized, "/nix/store/"):
+		return "Nix"
+	case strings.Contains(normalized, "/snap/"):
+		return "Snap"
+	case strings.Contains(normalized, "/scoop/"):
+		return "Scoop"
+	case strings.HasPrefix(normalized, "/usr/bin/") || strings.HasPrefix(normalized, "/usr/lib/") || strings.HasPrefix(normalized, "/opt/"):
+		return "your system package manager"
+	}
+	return ""
+}
+
+func versionGreaterThan(v, w string) bool {
+	vv, ve := version.NewVersion(strings.TrimPrefix(v, "v"))
+	vw, we := version.NewVersion(strings.TrimPrefix(w, "v"))
+	return ve == nil && we == nil && vv.GreaterThan(vw)
+}
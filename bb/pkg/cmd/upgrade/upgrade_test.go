@@ -0,0 +1,97 @@
+package upgrade
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestPickAsset(t *testing.T) {
+	assets := []releaseAsset{
+		{Name: "bb_1.2.3_checksums.txt"},
+		{Name: "bb_1.2.3_linux_amd64.tar.gz"},
+		{Name: "bb_1.2.3_darwin_arm64.tar.gz"},
+		{Name: "bb_1.2.3_windows_amd64.zip"},
+	}
+
+	tests := []struct {
+		goos   string
+		goarch string
+		want   string
+	}{
+		{"linux", "amd64", "bb_1.2.3_linux_amd64.tar.gz"},
+		{"darwin", "arm64", "bb_1.2.3_darwin_arm64.tar.gz"},
+		{"windows", "amd64", "bb_1.2.3_windows_amd64.zip"},
+		{"freebsd", "amd64", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.goos+"/"+tt.goarch, func(t *testing.T) {
+			got := pickAsset(assets, tt.goos, tt.goarch)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("expected no asset, got %q", got.Name)
+				}
+				return
+			}
+			if got == nil || got.Name != tt.want {
+				t.Errorf("expected %q, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	data := []byte("release binary contents")
+	sum := sha256.Sum256(data)
+	manifest := fmt.Sprintf("%s  bb_linux_amd64.tar.gz\n%s  bb_darwin_arm64.tar.gz\n",
+		hex.EncodeToString(sum[:]), "0000000000000000000000000000000000000000000000000000000000000000")
+
+	if err := verifyChecksum([]byte(manifest), "bb_linux_amd64.tar.gz", data); err != nil {
+		t.Errorf("expected checksum to verify, got %v", err)
+	}
+	if err := verifyChecksum([]byte(manifest), "bb_darwin_arm64.tar.gz", data); err == nil {
+		t.Error("expected a checksum mismatch error")
+	}
+	if err := verifyChecksum([]byte(manifest), "bb_windows_amd64.zip", data); err == nil {
+		t.Error("expected a missing checksum error")
+	}
+}
+
+func TestDetectManagedInstall(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/opt/homebrew/Cellar/bb/1.2.3/bin/bb", "Homebrew"},
+		{"/home/linuxbrew/.linuxbrew/bin/bb", "Homebrew"},
+		{"/nix/store/abc123-bb-1.2.3/bin/bb", "Nix"},
+		{"/snap/bb/current/bb", "Snap"},
+		{"C:\\Users\\me\\scoop\\apps\\bb\\current\\bb.exe", "Scoop"},
+		{"/usr/bin/bb", "your system package manager"},
+		{"/usr/local/bin/bb", ""},
+		{"/home/me/.local/bin/bb", ""},
+	}
+	for _, tt := range tests {
+		if got := detectManagedInstall(tt.path); got != tt.want {
+			t.Errorf("detectManagedInstall(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestVersionGreaterThan(t *testing.T) {
+	tests := []struct {
+		v, w string
+		want bool
+	}{
+		{"v1.2.0", "v1.1.0", true},
+		{"1.2.0", "v1.2.0", false},
+		{"v1.2.0", "1.3.0", false},
+		{"v1.2.0", "DEV", false},
+	}
+	for _, tt := range tests {
+		if got := versionGreaterThan(tt.v, tt.w); got != tt.want {
+			t.Errorf("versionGreaterThan(%q, %q) = %v, want %v", tt.v, tt.w, got, tt.want)
+		}
+	}
+}
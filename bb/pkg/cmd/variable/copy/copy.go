@@ -0,0 +1,262 @@
+package copy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CopyOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	FromRepo    string
+	ToRepo      string
+	Environment string
+}
+
+func NewCmdCopy(f *cmdutil.Factory, runF func(*CopyOptions) error) *cobra.Command {
+	opts := &CopyOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "copy --from <workspace/repo> --to <workspace/repo>",
+		Short: "Copy pipeline variables from one repository to another",
+		Long: heredoc.Doc(`
+			Copy pipeline variables from one repository to another.
+
+			This is useful for bootstrapping a new repository from an existing
+			configuration. When --environment is given, variables are copied between
+			the deployment environments of the same name in both repositories.
+
+			Secured variables cannot be read back from the API and are skipped;
+			use 'bb secret copy' to re-enter them interactively.
+
+			If --from or --to is omitted, the current repository is used.
+		`),
+		Example: heredoc.Doc(`
+			# Copy variables from another repository into the current one
+			$ bb variable copy --from myworkspace/template-repo
+
+			# Copy variables from the current repository to a new one
+			$ bb variable copy --to myworkspace/new-repo
+
+			# Copy variables for a deployment environment
+			$ bb variable copy --from myworkspace/repoA --to myworkspace/repoB --environment staging
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.FromRepo == "" && opts.ToRepo == "" {
+				return cmdutil.FlagErrorf("at least one of --from or --to is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return copyRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.FromRepo, "from", "", "Source repository in WORKSPACE/REPO_SLUG format")
+	cmd.Flags().StringVar(&opts.ToRepo, "to", "", "Target repository in WORKSPACE/REPO_SLUG format")
+	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Copy variables for a specific deployment environment")
+
+	return cmd
+}
+
+// pipelineVariable represents a Bitbucket pipeline variable
+type pipelineVariable struct {
+	UUID    string `json:"uuid"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+func copyRun(opts *CopyOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	fromRepo, toRepo, err := resolveRepos(opts)
+	if err != nil {
+		return err
+	}
+
+	if bbrepo.IsSame(fromRepo, toRepo) {
+		return fmt.Errorf("source and target repositories are the same: %s", bbrepo.FullName(fromRepo))
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	// The environment path segment must be a UUID, and the same name maps to
+	// a different UUID in each repository, so resolve it against both sides
+	fromEnv, toEnv := opts.Environment, opts.Environment
+	if opts.Environment != "" {
+		opts.IO.StartProgressIndicator()
+		fromEnv, err = api.ResolveEnvironment(apiClient, fromRepo.RepoHost(), fromRepo.RepoWorkspace(), fromRepo.RepoSlug(), opts.Environment)
+		if err == nil {
+			toEnv, err = api.ResolveEnvironment(apiClient, toRepo.RepoHost(), toRepo.RepoWorkspace(), toRepo.RepoSlug(), opts.Environment)
+		}
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	}
+
+	opts.IO.StartProgressIndicator()
+	variables, err := fetchVariables(apiClient, fromRepo, fromEnv)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to list variables for %s: %w", bbrepo.FullName(fromRepo), err)
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	copied := 0
+	skipped := 0
+	for _, v := range variables {
+		if v.Secured {
+			skipped++
+			fmt.Fprintf(opts.IO.ErrOut, "%s Skipping secured variable %s (value cannot be read; use 'bb secret copy')\n",
+				cs.WarningIcon(), cs.Bold(v.Key))
+			continue
+		}
+
+		opts.IO.StartProgressIndicator()
+		err := setVariable(apiClient, toRepo, v.Key, v.Value, toEnv)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to set variable %q on %s: %w", v.Key, bbrepo.FullName(toRepo), err)
+		}
+		copied++
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		msg := fmt.Sprintf("%s Copied %d variable(s) from %s to %s",
+			cs.SuccessIcon(), copied, cs.Bold(bbrepo.FullName(fromRepo)), cs.Bold(bbrepo.FullName(toRepo)))
+		if opts.Environment != "" {
+			msg += fmt.Sprintf(" (environment %s)", cs.Cyan(opts.Environment))
+		}
+		if skipped > 0 {
+			msg += fmt.Sprintf(", skipped %d secured", skipped)
+		}
+		fmt.Fprintln(opts.IO.Out, msg)
+	}
+
+	return nil
+}
+
+func resolveRepos(opts *CopyOptions) (fromRepo, toRepo bbrepo.Interface, err error) {
+	if opts.FromRepo != "" {
+		fromRepo, err = bbrepo.FromFullName(opts.FromRepo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --from repository: %w", err)
+		}
+	} else {
+		fromRepo, err = opts.BaseRepo()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if opts.ToRepo != "" {
+		toRepo, err = bbrepo.FromFullName(opts.ToRepo)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --to repository: %w", err)
+		}
+	} else {
+		toRepo, err = opts.BaseRepo()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return fromRepo, toRepo, nil
+}
+
+func variablesPath(repo bbrepo.Interface, environment string) string {
+	if environment != "" {
+		return fmt.Sprintf("repositories/%s/%s/deployments_config/environments/%s/variables",
+			repo.RepoWorkspace(), repo.RepoSlug(), environment)
+	}
+	return fmt.Sprintf("repositories/%s/%s/pipelines_config/variables",
+		repo.RepoWorkspace(), repo.RepoSlug())
+}
+
+func fetchVariables(apiClient *api.Client, repo bbrepo.Interface, environment string) ([]pipelineVariable, error) {
+	var result struct {
+		Values []pipelineVariable `json:"values"`
+	}
+	err := apiClient.Get(repo.RepoHost(), variablesPath(repo, environment)+"?pagelen=100", &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Values, nil
+}
+
+type variablePayload struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+func setVariable(apiClient *api.Client, repo bbrepo.Interface, name, value, environment string) error {
+	payload := variablePayload{
+		Key:     name,
+		Value:   value,
+		Secured: false,
+	}
+
+	// Try to create first; if the variable already exists, update it in place
+	err := apiClient.Post(repo.RepoHost(), variablesPath(repo, environment), payload, nil)
+	if err != nil {
+		if api.IsConflictError(err) || strings.Contains(err.Error(), "already exists") {
+			return updateExistingVariable(apiClient, repo, name, value, environment)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func updateExistingVariable(apiClient *api.Client, repo bbrepo.Interface, name, value, environment string) error {
+	existing, err := fetchVariables(apiClient, repo, environment)
+	if err != nil {
+		return err
+	}
+
+	var uuid string
+	for _, v := range existing {
+		if v.Key == name {
+			uuid = v.UUID
+			break
+		}
+	}
+
+	if uuid == "" {
+		return fmt.Errorf("variable %q not found", name)
+	}
+
+	uuid = strings.Trim(uuid, "{}")
+	updatePath := fmt.Sprintf("%s/{%s}", variablesPath(repo, environment), uuid)
+
+	payload := variablePayload{
+		Key:     name,
+		Value:   value,
+		Secured: false,
+	}
+
+	return apiClient.Put(repo.RepoHost(), updatePath, payload, nil)
+}
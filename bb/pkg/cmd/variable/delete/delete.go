@@ -8,6 +8,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/dlbroadfoot/bitbucket-cli/api"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
 	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
@@ -17,6 +18,7 @@ import (
 type DeleteOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
 	BaseRepo   func() (bbrepo.Interface, error)
 	Prompter   prompter.Prompter
 
@@ -29,6 +31,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 	opts := &DeleteOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		BaseRepo:   f.BaseRepo,
 		Prompter:   f.Prompter,
 	}
@@ -76,12 +79,13 @@ func deleteRun(opts *DeleteOptions) error {
 		return err
 	}
 
-	// Confirm deletion
-	if !opts.Confirm {
-		if !opts.IO.CanPrompt() {
-			return cmdutil.FlagErrorf("--yes required when not running interactively")
-		}
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
 
+	// Confirm deletion
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
 		var msg string
 		if opts.Environment != "" {
 			msg = fmt.Sprintf("Are you sure you want to delete variable %q from environment %q?",
@@ -97,6 +101,10 @@ func deleteRun(opts *DeleteOptions) error {
 		if !confirmed {
 			return cmdutil.CancelError
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	opts.IO.StartProgressIndicator()
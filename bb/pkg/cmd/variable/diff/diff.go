@@ -0,0 +1,217 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DiffOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	EnvFile     string
+	Environment string
+}
+
+func NewCmdDiff(f *cmdutil.Factory, runF func(*DiffOptions) error) *cobra.Command {
+	opts := &DiffOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff --env-file <file>",
+		Short: "Compare pipeline variables with a dotenv file",
+		Long: heredoc.Doc(`
+			Compare the pipeline variables of a repository with a local dotenv file.
+
+			Variables only present in the file, only present on Bitbucket, and
+			variables whose values differ are each listed. Secured variables
+			cannot be compared because their values are never returned by the API.
+
+			By default, repository-level variables are compared. Use --environment
+			to compare variables of a specific deployment environment.
+		`),
+		Example: heredoc.Doc(`
+			$ bb variable diff --env-file .env
+			$ bb variable diff --env-file .env.production --environment production
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.EnvFile == "" {
+				return cmdutil.FlagErrorf("`--env-file` is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return diffRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.EnvFile, "env-file", "", "Dotenv `file` to compare against")
+	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Compare variables of a specific deployment environment")
+
+	return cmd
+}
+
+// pipelineVariable represents a Bitbucket pipeline variable
+type pipelineVariable struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+func diffRun(opts *DiffOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(opts.EnvFile)
+	if err != nil {
+		return fmt.Errorf("could not read env file: %w", err)
+	}
+	defer file.Close()
+
+	local, err := parseEnvFile(file)
+	if err != nil {
+		return fmt.Errorf("could not parse %s: %w", opts.EnvFile, err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	remote, err := fetchVariables(httpClient, repo, opts.Environment)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	printDiff(opts.IO, opts.EnvFile, local, remote)
+	return nil
+}
+
+func fetchVariables(client *http.Client, repo bbrepo.Interface, environment string) ([]pipelineVariable, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var path string
+	if environment != "" {
+		// Resolve the display name to a UUID so names with spaces or
+		// different casing still address the right environment
+		var err error
+		environment, err = api.ResolveEnvironment(apiClient, repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug(), environment)
+		if err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("repositories/%s/%s/deployments_config/environments/%s/variables?pagelen=100",
+			repo.RepoWorkspace(), repo.RepoSlug(), environment)
+	} else {
+		path = fmt.Sprintf("repositories/%s/%s/pipelines_config/variables?pagelen=100",
+			repo.RepoWorkspace(), repo.RepoSlug())
+	}
+
+	var result struct {
+		Values []pipelineVariable `json:"values"`
+	}
+	if err := apiClient.Get(repo.RepoHost(), path, &result); err != nil {
+		return nil, err
+	}
+	return result.Values, nil
+}
+
+// parseEnvFile reads dotenv-style KEY=VALUE lines. Blank lines and comments
+// are skipped, an optional "export " prefix is dropped, and matching quotes
+// around the value are stripped.
+func parseEnvFile(r io.Reader) (map[string]string, error) {
+	vars := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid line %d: %q", lineNo, line)
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		vars[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+func printDiff(io *iostreams.IOStreams, envFile string, local map[string]string, remote []pipelineVariable) {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	remoteByKey := make(map[string]pipelineVariable, len(remote))
+	for _, v := range remote {
+		remoteByKey[v.Key] = v
+	}
+
+	keys := make([]string, 0, len(local)+len(remote))
+	for key := range local {
+		keys = append(keys, key)
+	}
+	for key := range remoteByKey {
+		if _, ok := local[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	inSync := true
+	for _, key := range keys {
+		localValue, inLocal := local[key]
+		remoteVar, inRemote := remoteByKey[key]
+
+		switch {
+		case !inRemote:
+			fmt.Fprintln(out, cs.Green(fmt.Sprintf("+ %s (only in %s)", key, envFile)))
+		case !inLocal:
+			fmt.Fprintln(out, cs.Red(fmt.Sprintf("- %s (only on Bitbucket)", key)))
+		case remoteVar.Secured:
+			fmt.Fprintln(out, cs.Gray(fmt.Sprintf("? %s (secured; cannot compare)", key)))
+		case localValue != remoteVar.Value:
+			fmt.Fprintln(out, cs.Yellow(fmt.Sprintf("~ %s (values differ)", key)))
+		default:
+			continue
+		}
+		inSync = false
+	}
+
+	if inSync {
+		fmt.Fprintf(out, "Pipeline variables are in sync with %s\n", envFile)
+	}
+}
@@ -0,0 +1,55 @@
+package set
+
+import (
+	"math"
+	"strings"
+)
+
+// Common prefixes of well-known credential formats.
+var tokenPrefixes = []string{
+	"ghp_", "gho_", "ghu_", "ghs_", "github_pat_", // GitHub
+	"glpat-",         // GitLab
+	"xoxb-", "xoxp-", // Slack
+	"sk_live_", "rk_live_", // Stripe
+	"AKIA",          // AWS access key ID
+	"ATBB", "ATCTT", // Bitbucket app passwords and API tokens
+	"eyJhbGciOi", // JWT header
+}
+
+// detectSecret reports why a value looks like a credential, or "" when it
+// does not. It is deliberately conservative: only PEM blocks, well-known
+// token prefixes, and long high-entropy strings trigger it.
+func detectSecret(value string) string {
+	if strings.Contains(value, "-----BEGIN ") {
+		return "PEM block"
+	}
+
+	for _, prefix := range tokenPrefixes {
+		if strings.HasPrefix(value, prefix) {
+			return "known token prefix"
+		}
+	}
+
+	if len(value) >= 32 && !strings.ContainsAny(value, " \t\n") && shannonEntropy(value) >= 4.5 {
+		return "high-entropy string"
+	}
+
+	return ""
+}
+
+// shannonEntropy returns the entropy of the string in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
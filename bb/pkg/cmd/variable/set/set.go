@@ -23,6 +23,7 @@ type SetOptions struct {
 	VariableValue string
 	Environment   string
 	Body          string
+	Force         bool
 }
 
 func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command {
@@ -44,7 +45,9 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 			- Standard input (e.g., piped from another command)
 
 			Variables are stored as plain text. For sensitive data like API keys
-			and passwords, use 'bb secret set' instead.
+			and passwords, use 'bb secret set' instead. Values that look like
+			credentials (PEM blocks, known token prefixes, high-entropy strings)
+			are refused unless --force is given.
 		`),
 		Example: heredoc.Doc(`
 			# Set a variable with value as argument
@@ -75,6 +78,7 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Variable value (alternative to positional argument)")
 	cmd.Flags().StringVarP(&opts.Environment, "environment", "e", "", "Set variable for a specific deployment environment")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Set the variable even when the value looks like a credential")
 
 	return cmd
 }
@@ -107,6 +111,14 @@ func setRun(opts *SetOptions) error {
 		return cmdutil.FlagErrorf("variable value is required")
 	}
 
+	// Variables are stored and displayed as plain text, so push credentials
+	// toward bb secret set
+	if !opts.Force {
+		if reason := detectSecret(value); reason != "" {
+			return fmt.Errorf("value looks like a credential (%s); use `bb secret set %s` instead, or pass --force to set it anyway", reason, opts.VariableName)
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
 	err = createOrUpdateVariable(httpClient, repo, opts.VariableName, value, opts.Environment)
 	opts.IO.StopProgressIndicator()
@@ -140,6 +152,13 @@ func createOrUpdateVariable(client *http.Client, repo bbrepo.Interface, name, va
 
 	var path string
 	if environment != "" {
+		// Resolve the display name to a UUID so names with spaces or
+		// different casing still address the right environment
+		var err error
+		environment, err = api.ResolveEnvironment(apiClient, repo.RepoHost(), repo.RepoWorkspace(), repo.RepoSlug(), environment)
+		if err != nil {
+			return err
+		}
 		path = fmt.Sprintf("repositories/%s/%s/deployments_config/environments/%s/variables",
 			repo.RepoWorkspace(), repo.RepoSlug(), environment)
 	} else {
@@ -2,7 +2,9 @@ package variable
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	copyCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/variable/copy"
 	deleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/variable/delete"
+	diffCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/variable/diff"
 	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/variable/list"
 	setCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/variable/set"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
@@ -30,6 +32,8 @@ func NewCmdVariable(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(listCmd.NewCmdList(f, nil))
 	cmd.AddCommand(setCmd.NewCmdSet(f, nil))
 	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(copyCmd.NewCmdCopy(f, nil))
+	cmd.AddCommand(diffCmd.NewCmdDiff(f, nil))
 
 	return cmd
 }
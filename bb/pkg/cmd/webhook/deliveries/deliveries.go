@@ -0,0 +1,273 @@
+package deliveries
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeliveriesOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	HookUUID   string
+	DeliveryID string
+	Limit      int
+}
+
+// delivery is one attempt to deliver a webhook event. Request and response
+// payloads are only populated when a single delivery is fetched by ID.
+type delivery struct {
+	UUID       string `json:"uuid"`
+	Event      string `json:"event"`
+	StatusCode int    `json:"status_code"`
+	Duration   int    `json:"duration"` // milliseconds
+	CreatedOn  string `json:"created_on"`
+	Request    *struct {
+		Headers map[string]string `json:"headers"`
+		Body    string            `json:"body"`
+	} `json:"request"`
+	Response *struct {
+		Headers map[string]string `json:"headers"`
+		Body    string            `json:"body"`
+	} `json:"response"`
+}
+
+func NewCmdDeliveries(f *cmdutil.Factory, runF func(*DeliveriesOptions) error) *cobra.Command {
+	opts := &DeliveriesOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "deliveries <hook-uuid> [<delivery-id>]",
+		Short: "List recent delivery attempts for a webhook",
+		Long: heredoc.Doc(`
+			List the recent delivery attempts of a repository webhook: event,
+			response status code, duration, and time.
+
+			With a delivery ID as second argument, show that delivery's request
+			and response payloads where the API provides them, to debug a
+			failing integration.
+		`),
+		Example: heredoc.Doc(`
+			# List recent deliveries for a webhook
+			$ bb webhook deliveries '{6e9f1bcd-...}'
+
+			# Inspect one delivery's request and response
+			$ bb webhook deliveries '{6e9f1bcd-...}' '{0a4c3f21-...}'
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.HookUUID = args[0]
+			if len(args) > 1 {
+				opts.DeliveryID = args[1]
+			}
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deliveriesRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of deliveries to fetch")
+
+	return cmd
+}
+
+func deliveriesRun(opts *DeliveriesOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	if opts.DeliveryID != "" {
+		opts.IO.StartProgressIndicator()
+		d, err := fetchDelivery(httpClient, repo, opts.HookUUID, opts.DeliveryID)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		return printDelivery(opts.IO, d)
+	}
+
+	opts.IO.StartProgressIndicator()
+	deliveries, err := fetchDeliveries(httpClient, repo, opts.HookUUID, opts.Limit)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if len(deliveries) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No deliveries recorded for this webhook")
+		return nil
+	}
+
+	return printDeliveries(opts.IO, deliveries)
+}
+
+func fetchDeliveries(client *http.Client, repo bbrepo.Interface, hookUUID string, limit int) ([]delivery, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/hooks/%s/deliveries?pagelen=%d",
+		repo.RepoWorkspace(), repo.RepoSlug(), hookUUID, min(limit, 100))
+
+	var deliveries []delivery
+	nextURL := ""
+	for {
+		var page struct {
+			Values []delivery `json:"values"`
+			Next   string     `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get(repo.RepoHost(), path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			if api.IsNotFoundError(err) {
+				return nil, fmt.Errorf("no webhook %s in %s", hookUUID, bbrepo.FullName(repo))
+			}
+			return nil, err
+		}
+
+		for _, d := range page.Values {
+			deliveries = append(deliveries, d)
+			if len(deliveries) >= limit {
+				return deliveries, nil
+			}
+		}
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return deliveries, nil
+}
+
+func fetchDelivery(client *http.Client, repo bbrepo.Interface, hookUUID, deliveryID string) (*delivery, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/hooks/%s/deliveries/%s",
+		repo.RepoWorkspace(), repo.RepoSlug(), hookUUID, deliveryID)
+
+	d := &delivery{}
+	if err := apiClient.Get(repo.RepoHost(), path, d); err != nil {
+		if api.IsNotFoundError(err) {
+			return nil, fmt.Errorf("no delivery %s for webhook %s", deliveryID, hookUUID)
+		}
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func printDeliveries(io *iostreams.IOStreams, deliveries []delivery) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("delivery", "event", "status", "duration", "delivered"))
+
+	for _, d := range deliveries {
+		tp.AddField(d.UUID)
+		tp.AddField(d.Event)
+
+		status := fmt.Sprintf("%d", d.StatusCode)
+		switch {
+		case d.StatusCode >= 200 && d.StatusCode < 300:
+			tp.AddField(status, tableprinter.WithColor(cs.Green))
+		case d.StatusCode == 0:
+			tp.AddField("error", tableprinter.WithColor(cs.Red))
+		default:
+			tp.AddField(status, tableprinter.WithColor(cs.Red))
+		}
+
+		tp.AddField(fmt.Sprintf("%dms", d.Duration))
+		if t, err := time.Parse(time.RFC3339, d.CreatedOn); err == nil {
+			tp.AddField(t.Local().Format("2006-01-02 15:04:05"))
+		} else {
+			tp.AddField(d.CreatedOn)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func printDelivery(io *iostreams.IOStreams, d *delivery) error {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(d.Event), d.UUID)
+	if t, err := time.Parse(time.RFC3339, d.CreatedOn); err == nil {
+		fmt.Fprintf(out, "Delivered %s, responded %d in %dms\n",
+			t.Local().Format("2006-01-02 15:04:05"), d.StatusCode, d.Duration)
+	} else {
+		fmt.Fprintf(out, "Responded %d in %dms\n", d.StatusCode, d.Duration)
+	}
+
+	if d.Request != nil {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Request"))
+		printHeaders(out, d.Request.Headers)
+		printBody(out, d.Request.Body)
+	}
+	if d.Response != nil {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Response"))
+		printHeaders(out, d.Response.Headers)
+		printBody(out, d.Response.Body)
+	}
+	if d.Request == nil && d.Response == nil {
+		fmt.Fprintln(out, "\nThe API did not record payloads for this delivery")
+	}
+
+	return nil
+}
+
+func printHeaders(out io.Writer, headers map[string]string) {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(out, "%s: %s\n", k, headers[k])
+	}
+}
+
+// printBody pretty-prints JSON payloads and passes everything else through.
+func printBody(out io.Writer, body string) {
+	if body == "" {
+		return
+	}
+	var pretty bytes.Buffer
+	if json.Indent(&pretty, []byte(body), "", "  ") == nil {
+		fmt.Fprintf(out, "\n%s\n", pretty.String())
+	} else {
+		fmt.Fprintf(out, "\n%s\n", body)
+	}
+}
@@ -0,0 +1,254 @@
+package forward
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ForwardOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (bbrepo.Interface, error)
+
+	Events   []string
+	RelayURL string
+	Port     int
+}
+
+// knownEvents are the webhook event keys the Bitbucket API accepts.
+var knownEvents = []string{
+	"repo:push",
+	"repo:commit_status_created",
+	"repo:commit_status_updated",
+	"pullrequest:created",
+	"pullrequest:updated",
+	"pullrequest:approved",
+	"pullrequest:fulfilled",
+	"pullrequest:rejected",
+	"pullrequest:comment_created",
+	"issue:created",
+	"issue:updated",
+	"issue:comment_created",
+}
+
+func NewCmdForward(f *cmdutil.Factory, runF func(*ForwardOptions) error) *cobra.Command {
+	opts := &ForwardOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "forward",
+		Short: "Stream webhook events to your terminal or a local port",
+		Long: heredoc.Docf(`
+			Create a temporary webhook on the current repository pointing at a relay,
+			and stream the events it receives for local integration development.
+
+			The relay is any HTTPS endpoint that accepts the webhook deliveries and
+			replays them as newline-delimited JSON on a GET of the same URL. Events
+			are printed to the terminal, or forwarded to %[1]shttp://localhost:<port>%[1]s
+			when %[1]s--port%[1]s is given.
+
+			The temporary webhook is deleted when the command exits.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Print push and PR events to the terminal
+			$ bb webhook forward --relay-url https://relay.example.com/hooks
+
+			# Forward build status events to a local server
+			$ bb webhook forward --relay-url https://relay.example.com/hooks \
+			    --events repo:commit_status_created,repo:commit_status_updated --port 8080
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.RelayURL == "" {
+				opts.RelayURL = os.Getenv("BB_WEBHOOK_RELAY")
+			}
+			if opts.RelayURL == "" {
+				return cmdutil.FlagErrorf("--relay-url is required (or set BB_WEBHOOK_RELAY)")
+			}
+
+			for _, event := range opts.Events {
+				if !isKnownEvent(event) {
+					return cmdutil.FlagErrorf("unknown event %q: valid events are %s",
+						event, strings.Join(knownEvents, ", "))
+				}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return forwardRun(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&opts.Events, "events", "e", []string{"repo:push", "pullrequest:created"}, "Webhook events to subscribe to")
+	cmd.Flags().StringVar(&opts.RelayURL, "relay-url", "", "URL of the relay that receives and replays the webhook deliveries")
+	cmd.Flags().IntVarP(&opts.Port, "port", "p", 0, "Forward events to a server on this localhost port instead of printing them")
+
+	return cmd
+}
+
+func isKnownEvent(event string) bool {
+	for _, known := range knownEvents {
+		if event == known {
+			return true
+		}
+	}
+	return false
+}
+
+type webhook struct {
+	UUID        string   `json:"uuid,omitempty"`
+	Description string   `json:"description"`
+	URL         string   `json:"url"`
+	Active      bool     `json:"active"`
+	Events      []string `json:"events"`
+}
+
+func forwardRun(opts *ForwardOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	opts.IO.StartProgressIndicator()
+	hook, err := createWebhook(httpClient, repo, opts)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to create temporary webhook: %w", err)
+	}
+
+	defer func() {
+		if err := deleteWebhook(httpClient, repo, hook.UUID); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to delete temporary webhook %s: %v\n",
+				cs.WarningIcon(), hook.UUID, err)
+		}
+	}()
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Created temporary webhook for %s (%s)\n",
+		cs.SuccessIcon(), bbrepo.FullName(repo), strings.Join(opts.Events, ", "))
+	if opts.Port > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "Forwarding events to http://localhost:%d — press Ctrl+C to stop\n", opts.Port)
+	} else {
+		fmt.Fprintln(opts.IO.ErrOut, "Streaming events — press Ctrl+C to stop")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	return streamEvents(ctx, opts)
+}
+
+func createWebhook(client *http.Client, repo bbrepo.Interface, opts *ForwardOptions) (*webhook, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/hooks", repo.RepoWorkspace(), repo.RepoSlug())
+	payload := webhook{
+		Description: "bb webhook forward (temporary)",
+		URL:         opts.RelayURL,
+		Active:      true,
+		Events:      opts.Events,
+	}
+
+	var created webhook
+	if err := apiClient.Post(repo.RepoHost(), path, payload, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func deleteWebhook(client *http.Client, repo bbrepo.Interface, uuid string) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s/%s/hooks/%s", repo.RepoWorkspace(), repo.RepoSlug(), uuid)
+	return apiClient.Delete(repo.RepoHost(), path)
+}
+
+// streamEvents reads newline-delimited JSON events from the relay until the
+// context is cancelled, printing or forwarding each one.
+func streamEvents(ctx context.Context, opts *ForwardOptions) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", opts.RelayURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/x-ndjson")
+
+	// The relay is not a Bitbucket host, so use a plain client rather than
+	// one carrying our auth headers.
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("failed to connect to relay: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to connect to relay: HTTP %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := handleEvent(opts, line); err != nil {
+			cs := opts.IO.ColorScheme()
+			fmt.Fprintf(opts.IO.ErrOut, "%s %v\n", cs.WarningIcon(), err)
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return scanner.Err()
+}
+
+func handleEvent(opts *ForwardOptions, event []byte) error {
+	if opts.Port > 0 {
+		url := fmt.Sprintf("http://localhost:%d/", opts.Port)
+		resp, err := http.Post(url, "application/json", bytes.NewReader(event))
+		if err != nil {
+			return fmt.Errorf("failed to forward event: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("local server responded with HTTP %d", resp.StatusCode)
+		}
+		return nil
+	}
+
+	var pretty bytes.Buffer
+	if json.Indent(&pretty, event, "", "  ") == nil {
+		fmt.Fprintln(opts.IO.Out, pretty.String())
+	} else {
+		fmt.Fprintln(opts.IO.Out, string(event))
+	}
+	return nil
+}
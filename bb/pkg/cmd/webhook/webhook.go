@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/webhook/deliveries"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/webhook/forward"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdWebhook(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook <command>",
+		Short: "Work with repository webhooks",
+		Long: heredoc.Doc(`
+			Work with the webhooks of a Bitbucket repository.
+		`),
+		Example: heredoc.Doc(`
+			$ bb webhook forward --relay-url https://relay.example.com/hooks --port 8080
+			$ bb webhook deliveries '{6e9f1bcd-...}'
+		`),
+	}
+
+	cmd.AddCommand(deliveries.NewCmdDeliveries(f, nil))
+	cmd.AddCommand(forward.NewCmdForward(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,334 @@
+package auditbranches
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type AuditBranchesOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+
+	WorkspaceSlug string
+	PolicyFile    string
+	Fix           bool
+	Concurrency   int
+}
+
+// branchPolicy declares the branch restrictions every repository in a
+// workspace is expected to carry, read from a YAML file passed via --policy.
+type branchPolicy struct {
+	BranchRestrictions []policyRestriction `yaml:"branch_restrictions"`
+}
+
+type policyRestriction struct {
+	Kind    string `yaml:"kind"`
+	Pattern string `yaml:"pattern"`
+}
+
+// repoDrift records how one repository deviates from the policy.
+type repoDrift struct {
+	Slug    string
+	Missing []policyRestriction
+	Extra   []policyRestriction
+	Err     error
+}
+
+func NewCmdAuditBranches(f *cmdutil.Factory, runF func(*AuditBranchesOptions) error) *cobra.Command {
+	opts := &AuditBranchesOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit-branches <workspace> --policy <file>",
+		Short: "Detect branch restriction drift across a workspace",
+		Long: heredoc.Doc(`
+			Compare the branch restrictions of every repository in a workspace
+			against a declarative policy and report drift.
+
+			The policy file lists the restrictions each repository must carry:
+
+			    branch_restrictions:
+			      - kind: push
+			        pattern: main
+			      - kind: force
+			        pattern: main
+
+			Restrictions a repository carries beyond the policy are reported but
+			never removed. With --fix, missing restrictions are created. The
+			command exits non-zero when unfixed drift remains, for use in CI.
+		`),
+		Example: heredoc.Doc(`
+			# Report drift
+			$ bb workspace audit-branches myworkspace --policy policy.yml
+
+			# Reconcile by adding missing restrictions
+			$ bb workspace audit-branches myworkspace --policy policy.yml --fix
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.WorkspaceSlug = args[0]
+			opts.Concurrency, _ = cmd.Flags().GetInt("concurrency")
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return auditBranchesRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.PolicyFile, "policy", "", "Path to the YAML policy `file` (required)")
+	cmd.Flags().BoolVar(&opts.Fix, "fix", false, "Create the restrictions missing from the policy")
+	_ = cmd.MarkFlagRequired("policy")
+
+	return cmd
+}
+
+func auditBranchesRun(opts *AuditBranchesOptions) error {
+	policy, err := loadPolicy(opts.PolicyFile)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var cfg gh.Config
+	if c, err := opts.Config(); err == nil {
+		cfg = c
+	}
+	workers := cmdutil.Concurrency(opts.Concurrency, cfg)
+
+	opts.IO.StartProgressIndicator()
+	slugs, err := fetchRepoSlugs(httpClient, opts.WorkspaceSlug)
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+	drifts := auditRepos(httpClient, opts.WorkspaceSlug, slugs, policy, opts.Fix, workers)
+	opts.IO.StopProgressIndicator()
+
+	return printDrift(opts.IO, drifts, len(slugs), opts.Fix)
+}
+
+func loadPolicy(path string) (*branchPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy: %w", err)
+	}
+
+	var policy branchPolicy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy %s: %w", path, err)
+	}
+
+	if len(policy.BranchRestrictions) == 0 {
+		return nil, fmt.Errorf("policy %s declares no branch_restrictions", path)
+	}
+	for i, r := range policy.BranchRestrictions {
+		if r.Kind == "" || r.Pattern == "" {
+			return nil, fmt.Errorf("policy branch_restrictions[%d]: kind and pattern are required", i)
+		}
+	}
+
+	return &policy, nil
+}
+
+func fetchRepoSlugs(client *http.Client, workspace string) ([]string, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	path := fmt.Sprintf("repositories/%s?pagelen=100&fields=next,values.slug", workspace)
+	var slugs []string
+	nextURL := ""
+	for {
+		var page api.PaginatedResponse[api.Repository]
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range page.Values {
+			slugs = append(slugs, repo.Slug)
+		}
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return slugs, nil
+}
+
+// auditRepos checks each repository against the policy with a bounded worker
+// pool, optionally creating the missing restrictions.
+func auditRepos(client *http.Client, workspace string, slugs []string, policy *branchPolicy, fix bool, workers int) []repoDrift {
+	drifts := make([]repoDrift, len(slugs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				drifts[i] = auditRepo(client, workspace, slugs[i], policy, fix)
+			}
+		}()
+	}
+
+	for i := range slugs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return drifts
+}
+
+func auditRepo(client *http.Client, workspace, slug string, policy *branchPolicy, fix bool) repoDrift {
+	drift := repoDrift{Slug: slug}
+	apiClient := api.NewClientFromHTTP(client)
+
+	existing, err := fetchRestrictions(apiClient, workspace, slug)
+	if err != nil {
+		drift.Err = err
+		return drift
+	}
+
+	have := make(map[policyRestriction]bool, len(existing))
+	for _, r := range existing {
+		have[r] = true
+	}
+	want := make(map[policyRestriction]bool, len(policy.BranchRestrictions))
+	for _, r := range policy.BranchRestrictions {
+		want[r] = true
+		if !have[r] {
+			drift.Missing = append(drift.Missing, r)
+		}
+	}
+	for _, r := range existing {
+		if !want[r] {
+			drift.Extra = append(drift.Extra, r)
+		}
+	}
+
+	if fix && len(drift.Missing) > 0 {
+		var remaining []policyRestriction
+		for _, r := range drift.Missing {
+			payload := map[string]interface{}{
+				"kind":    r.Kind,
+				"pattern": r.Pattern,
+			}
+			path := fmt.Sprintf("repositories/%s/%s/branch-restrictions", workspace, slug)
+			if err := apiClient.Post("bitbucket.org", path, payload, nil); err != nil {
+				drift.Err = fmt.Errorf("failed to add %s on %s: %w", r.Kind, r.Pattern, err)
+				remaining = append(remaining, r)
+			}
+		}
+		drift.Missing = remaining
+	}
+
+	return drift
+}
+
+func fetchRestrictions(apiClient *api.Client, workspace, slug string) ([]policyRestriction, error) {
+	path := fmt.Sprintf("repositories/%s/%s/branch-restrictions?pagelen=100", workspace, slug)
+	var restrictions []policyRestriction
+	nextURL := ""
+	for {
+		var page struct {
+			Values []struct {
+				Kind    string `json:"kind"`
+				Pattern string `json:"pattern"`
+			} `json:"values"`
+			Next string `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range page.Values {
+			restrictions = append(restrictions, policyRestriction{Kind: r.Kind, Pattern: r.Pattern})
+		}
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return restrictions, nil
+}
+
+func printDrift(io *iostreams.IOStreams, drifts []repoDrift, total int, fixed bool) error {
+	cs := io.ColorScheme()
+	clean := 0
+	drifted := 0
+
+	for _, d := range drifts {
+		if d.Err != nil {
+			fmt.Fprintf(io.ErrOut, "%s %s: %v\n", cs.FailureIcon(), d.Slug, d.Err)
+			drifted++
+			continue
+		}
+		if len(d.Missing) == 0 && len(d.Extra) == 0 {
+			clean++
+			continue
+		}
+
+		drifted++
+		fmt.Fprintf(io.Out, "%s %s\n", cs.WarningIcon(), cs.Bold(d.Slug))
+		for _, r := range d.Missing {
+			fmt.Fprintf(io.Out, "  missing %s on %s\n", r.Kind, r.Pattern)
+		}
+		for _, r := range d.Extra {
+			fmt.Fprintf(io.Out, "  extra %s on %s (not removed)\n", r.Kind, r.Pattern)
+		}
+	}
+
+	verb := "match"
+	if fixed {
+		verb = "now match"
+	}
+	fmt.Fprintf(io.Out, "\n%d of %d repositories %s the policy\n", clean, total, verb)
+
+	// Unfixed drift fails the command so CI pipelines notice.
+	unfixed := false
+	for _, d := range drifts {
+		if d.Err != nil || len(d.Missing) > 0 {
+			unfixed = true
+			break
+		}
+	}
+	if unfixed {
+		return cmdutil.SilentError
+	}
+	return nil
+}
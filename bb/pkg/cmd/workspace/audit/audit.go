@@ -0,0 +1,287 @@
+package audit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type AuditOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	WorkspaceSlug string
+	Actor         string
+	Action        string
+	Since         string
+	Until         string
+	Limit         int
+	CSV           bool
+}
+
+// auditEvent is one entry of a workspace audit log.
+type auditEvent struct {
+	Action    string `json:"action"`
+	Timestamp string `json:"timestamp"`
+	Actor     struct {
+		DisplayName string `json:"display_name"`
+		Nickname    string `json:"nickname"`
+	} `json:"actor"`
+	Resource struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"resource"`
+}
+
+var auditFields = []string{"action", "actor", "timestamp", "resource"}
+
+func (e *auditEvent) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "action":
+			data[f] = e.Action
+		case "timestamp":
+			data[f] = e.Timestamp
+		case "actor":
+			data[f] = map[string]interface{}{
+				"display_name": e.Actor.DisplayName,
+				"nickname":     e.Actor.Nickname,
+			}
+		case "resource":
+			data[f] = map[string]interface{}{
+				"type": e.Resource.Type,
+				"name": e.Resource.Name,
+			}
+		}
+	}
+	return data
+}
+
+func NewCmdAudit(f *cmdutil.Factory, runF func(*AuditOptions) error) *cobra.Command {
+	opts := &AuditOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit <workspace>",
+		Short: "List workspace audit events",
+		Long: heredoc.Doc(`
+			List audit events recorded for a workspace: who did what, to which
+			resource, and when.
+
+			Audit events are only available to workspace admins on a Premium
+			plan; on other plans Bitbucket answers this query with "not found".
+
+			Filter by actor, action, or date range, and export as JSON (--json)
+			or CSV (--csv) for compliance tooling.
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace audit myworkspace
+
+			# Events for one actor in the last week
+			$ bb workspace audit myworkspace --actor jsmith --since 7d
+
+			# Permission changes in January, as CSV
+			$ bb workspace audit myworkspace --action permission --since 2026-01-01 --until 2026-02-01 --csv
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.WorkspaceSlug = args[0]
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--json` or `--csv`",
+				opts.Exporter != nil,
+				opts.CSV,
+			); err != nil {
+				return err
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return auditRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Actor, "actor", "", "Filter by actor nickname")
+	cmd.Flags().StringVar(&opts.Action, "action", "", "Filter by action name")
+	cmd.Flags().StringVar(&opts.Since, "since", "", "Only events at or after a `date` (2006-01-02, RFC 3339, or a duration like 7d)")
+	cmd.Flags().StringVar(&opts.Until, "until", "", "Only events at or before a `date` (2006-01-02, RFC 3339, or a duration like 7d)")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of events to fetch")
+	cmd.Flags().BoolVar(&opts.CSV, "csv", false, "Output events as CSV")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, auditFields)
+
+	return cmd
+}
+
+func auditRun(opts *AuditOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	events, err := fetchAuditEvents(httpClient, opts)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, events)
+	}
+	if opts.CSV {
+		return printCSV(opts.IO, events)
+	}
+
+	if len(events) == 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "No audit events match your search in %s\n", opts.WorkspaceSlug)
+		return nil
+	}
+
+	return printEvents(opts.IO, events)
+}
+
+func fetchAuditEvents(client *http.Client, opts *AuditOptions) ([]auditEvent, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	params := url.Values{}
+	params.Set("pagelen", fmt.Sprintf("%d", min(opts.Limit, 100)))
+
+	var queryParts []string
+	if opts.Actor != "" {
+		queryParts = append(queryParts, fmt.Sprintf(`actor.nickname="%s"`, opts.Actor))
+	}
+	if opts.Action != "" {
+		queryParts = append(queryParts, fmt.Sprintf(`action="%s"`, opts.Action))
+	}
+	if opts.Since != "" {
+		q, err := dateQuery("timestamp", ">=", opts.Since)
+		if err != nil {
+			return nil, err
+		}
+		queryParts = append(queryParts, q)
+	}
+	if opts.Until != "" {
+		q, err := dateQuery("timestamp", "<=", opts.Until)
+		if err != nil {
+			return nil, err
+		}
+		queryParts = append(queryParts, q)
+	}
+	if len(queryParts) > 0 {
+		params.Set("q", strings.Join(queryParts, " AND "))
+	}
+
+	path := fmt.Sprintf("workspaces/%s/audit/events?%s", opts.WorkspaceSlug, params.Encode())
+
+	var events []auditEvent
+	nextURL := ""
+	for {
+		var page struct {
+			Values []auditEvent `json:"values"`
+			Next   string       `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			if api.IsNotFoundError(err) {
+				return nil, fmt.Errorf("audit events are not available for %s: requires workspace admin access on a Premium plan", opts.WorkspaceSlug)
+			}
+			return nil, err
+		}
+
+		for _, e := range page.Values {
+			events = append(events, e)
+			if len(events) >= opts.Limit {
+				return events, nil
+			}
+		}
+
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return events, nil
+}
+
+// dateQuery builds a timestamp comparison, defaulting to op when the
+// expression does not carry its own operator.
+func dateQuery(field, op, expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "<") && !strings.HasPrefix(expr, ">") {
+		expr = op + expr
+	}
+	return cmdutil.BuildDateQuery(field, expr)
+}
+
+func printEvents(io *iostreams.IOStreams, events []auditEvent) error {
+	tp := tableprinter.New(io, tableprinter.WithHeader("action", "actor", "resource", "timestamp"))
+
+	for _, e := range events {
+		tp.AddField(e.Action)
+		tp.AddField(actorLabel(e))
+		tp.AddField(resourceLabel(e))
+		if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+			tp.AddField(t.Local().Format("2006-01-02 15:04:05"))
+		} else {
+			tp.AddField(e.Timestamp)
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func printCSV(io *iostreams.IOStreams, events []auditEvent) error {
+	w := csv.NewWriter(io.Out)
+	if err := w.Write([]string{"timestamp", "actor", "action", "resource_type", "resource_name"}); err != nil {
+		return err
+	}
+	for _, e := range events {
+		if err := w.Write([]string{e.Timestamp, actorLabel(e), e.Action, e.Resource.Type, e.Resource.Name}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func actorLabel(e auditEvent) string {
+	if e.Actor.Nickname != "" {
+		return e.Actor.Nickname
+	}
+	return e.Actor.DisplayName
+}
+
+func resourceLabel(e auditEvent) string {
+	if e.Resource.Type != "" && e.Resource.Name != "" {
+		return fmt.Sprintf("%s:%s", e.Resource.Type, e.Resource.Name)
+	}
+	if e.Resource.Name != "" {
+		return e.Resource.Name
+	}
+	return e.Resource.Type
+}
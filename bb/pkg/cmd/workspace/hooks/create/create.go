@@ -0,0 +1,114 @@
+package create
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Workspace   string
+	URL         string
+	Description string
+	Events      []string
+	Inactive    bool
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create <workspace>",
+		Short: "Create a webhook on a workspace",
+		Long: heredoc.Doc(`
+			Create a webhook on a Bitbucket workspace.
+
+			The webhook fires for the subscribed events in every repository of
+			the workspace. Event keys follow the Bitbucket webhook event names,
+			e.g. repo:push, pullrequest:created, issue:created.
+		`),
+		Example: heredoc.Doc(`
+			# Notify a chat integration about every push in the workspace
+			$ bb workspace hooks create myworkspace --url https://example.com/hook --events repo:push
+
+			# Subscribe an audit endpoint to pull request activity
+			$ bb workspace hooks create myworkspace --url https://audit.example.com/bb \
+			    --events pullrequest:created,pullrequest:fulfilled --description "PR audit trail"
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot create webhook: workspace required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Workspace = args[0]
+
+			if opts.URL == "" {
+				return cmdutil.FlagErrorf("--url is required")
+			}
+			if len(opts.Events) == 0 {
+				return cmdutil.FlagErrorf("--events is required")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.URL, "url", "u", "", "URL that receives the webhook deliveries")
+	cmd.Flags().StringSliceVarP(&opts.Events, "events", "e", nil, "Webhook events to subscribe to")
+	cmd.Flags().StringVarP(&opts.Description, "description", "d", "", "Description of the webhook")
+	cmd.Flags().BoolVar(&opts.Inactive, "inactive", false, "Create the webhook in a disabled state")
+
+	return cmd
+}
+
+type webhookPayload struct {
+	Description string   `json:"description"`
+	URL         string   `json:"url"`
+	Active      bool     `json:"active"`
+	Events      []string `json:"events"`
+}
+
+func createRun(opts *CreateOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+
+	payload := webhookPayload{
+		Description: opts.Description,
+		URL:         opts.URL,
+		Active:      !opts.Inactive,
+		Events:      opts.Events,
+	}
+
+	var created struct {
+		UUID string `json:"uuid"`
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = apiClient.Post("bitbucket.org", fmt.Sprintf("workspaces/%s/hooks", opts.Workspace), payload, &created)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.Out, "%s Created webhook %s on workspace %s\n",
+		cs.SuccessIcon(), cs.Cyan(created.UUID), cs.Bold(opts.Workspace))
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package delete
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/prompter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Prompter   prompter.Prompter
+
+	Workspace string
+	UUID      string
+	Confirm   bool
+}
+
+func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
+	opts := &DeleteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Config:     f.Config,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "delete <workspace> <webhook-uuid>",
+		Short: "Delete a webhook from a workspace",
+		Long: heredoc.Doc(`
+			Delete a webhook from a Bitbucket workspace.
+
+			Find the webhook UUID with "bb workspace hooks list".
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace hooks delete myworkspace '{6e9f1bcd-...}'
+			$ bb workspace hooks delete myworkspace '{6e9f1bcd-...}' --yes
+		`),
+		Aliases: []string{"remove", "rm"},
+		Args:    cmdutil.ExactArgs(2, "cannot delete webhook: workspace and webhook UUID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Workspace = args[0]
+			opts.UUID = args[1]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return deleteRun(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Confirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func deleteRun(opts *DeleteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	// Confirm deletion
+	err = cmdutil.ConfirmDestructive(cfg, opts.IO, opts.Confirm, func() error {
+		msg := fmt.Sprintf("Are you sure you want to delete webhook %s from workspace %q?",
+			opts.UUID, opts.Workspace)
+
+		confirmed, err := opts.Prompter.Confirm(msg, false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	uuid := strings.Trim(opts.UUID, "{}")
+	path := fmt.Sprintf("workspaces/%s/hooks/{%s}", opts.Workspace, uuid)
+
+	opts.IO.StartProgressIndicator()
+	err = apiClient.Delete("bitbucket.org", path)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		if api.IsNotFoundError(err) {
+			return fmt.Errorf("webhook %s not found in workspace %q", opts.UUID, opts.Workspace)
+		}
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Deleted webhook %s from workspace %s\n",
+			cs.SuccessIcon(), cs.Cyan(opts.UUID), cs.Bold(opts.Workspace))
+	}
+
+	return nil
+}
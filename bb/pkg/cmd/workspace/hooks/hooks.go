@@ -0,0 +1,35 @@
+package hooks
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	createCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/hooks/create"
+	deleteCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/hooks/delete"
+	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/hooks/list"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdHooks(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks <command>",
+		Short: "Manage workspace webhooks",
+		Long: heredoc.Doc(`
+			Manage the webhooks of a Bitbucket workspace.
+
+			Workspace webhooks fire for events in every repository of the
+			workspace, so org-wide integrations like audit trails or chat
+			notifications can be provisioned once instead of per repository.
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace hooks list myworkspace
+			$ bb workspace hooks create myworkspace --url https://example.com/hook --events repo:push
+			$ bb workspace hooks delete myworkspace '{6e9f1bcd-...}'
+		`),
+	}
+
+	cmd.AddCommand(createCmd.NewCmdCreate(f, nil))
+	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+
+	return cmd
+}
@@ -0,0 +1,135 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Workspace string
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := &ListOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <workspace>",
+		Short: "List the webhooks of a workspace",
+		Long: heredoc.Doc(`
+			List the webhooks configured on a Bitbucket workspace.
+
+			Use the UUID column with "bb workspace hooks delete" to remove a
+			webhook, or with "bb webhook deliveries" to inspect its deliveries.
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace hooks list myworkspace
+		`),
+		Aliases: []string{"ls"},
+		Args:    cmdutil.ExactArgs(1, "cannot list webhooks: workspace required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Workspace = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return listRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+// Webhook represents a workspace webhook subscription
+type Webhook struct {
+	UUID        string   `json:"uuid"`
+	Description string   `json:"description"`
+	URL         string   `json:"url"`
+	Active      bool     `json:"active"`
+	Events      []string `json:"events"`
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	hooks, err := fetchHooks(httpClient, opts.Workspace)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if len(hooks) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No webhooks found in workspace %s\n", opts.Workspace)
+		return nil
+	}
+
+	return printHooks(opts.IO, hooks)
+}
+
+func fetchHooks(client *http.Client, workspace string) ([]Webhook, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var hooks []Webhook
+	path := fmt.Sprintf("workspaces/%s/hooks?pagelen=100", workspace)
+	nextURL := ""
+	for {
+		var page struct {
+			Values []Webhook `json:"values"`
+			Next   string    `json:"next"`
+		}
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		hooks = append(hooks, page.Values...)
+		if page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+
+	return hooks, nil
+}
+
+func printHooks(io *iostreams.IOStreams, hooks []Webhook) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("UUID", "URL", "ACTIVE", "EVENTS", "DESCRIPTION"))
+
+	for _, hook := range hooks {
+		tp.AddField(hook.UUID, tableprinter.WithColor(cs.Cyan))
+		tp.AddField(hook.URL)
+		if hook.Active {
+			tp.AddField("yes", tableprinter.WithColor(cs.Green))
+		} else {
+			tp.AddField("no", tableprinter.WithColor(cs.Gray))
+		}
+		tp.AddField(strings.Join(hook.Events, ","))
+		tp.AddField(hook.Description)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,155 @@
+package projects
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ProjectsOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Workspace string
+	Limit     int
+}
+
+func NewCmdProjects(f *cmdutil.Factory, runF func(*ProjectsOptions) error) *cobra.Command {
+	opts := &ProjectsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "projects <workspace>",
+		Short: "List projects in a workspace",
+		Long: heredoc.Doc(`
+			List the projects of a Bitbucket workspace along with how many
+			repositories each one contains.
+
+			Use the project key with "bb repo list --project" to list the
+			repositories of a single project.
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace projects myworkspace
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot list projects: workspace required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Workspace = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return projectsRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of projects to list")
+
+	return cmd
+}
+
+// Project represents a Bitbucket project
+type Project struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsPrivate   bool   `json:"is_private"`
+
+	// RepoCount is filled in by a follow-up query; the projects endpoint
+	// does not report it.
+	RepoCount int `json:"-"`
+}
+
+// ProjectList represents a paginated list of projects
+type ProjectList struct {
+	Size   int       `json:"size"`
+	Next   string    `json:"next"`
+	Values []Project `json:"values"`
+}
+
+func projectsRun(opts *ProjectsOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	projects, err := fetchProjects(httpClient, opts.Workspace, opts.Limit)
+	opts.IO.StopProgressIndicator()
+
+	if err != nil {
+		return err
+	}
+
+	if len(projects) == 0 {
+		fmt.Fprintf(opts.IO.Out, "No projects found in workspace %s\n", opts.Workspace)
+		return nil
+	}
+
+	return printProjects(opts.IO, projects)
+}
+
+func fetchProjects(client *http.Client, workspace string, limit int) ([]Project, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	var projects []Project
+	path := fmt.Sprintf("workspaces/%s/projects?pagelen=%d", workspace, min(limit, 100))
+	nextURL := ""
+	for {
+		var page ProjectList
+		var err error
+		if nextURL == "" {
+			err = apiClient.Get("bitbucket.org", path, &page)
+		} else {
+			err = apiClient.RESTWithURL("GET", nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, page.Values...)
+		if len(projects) >= limit || page.Next == "" {
+			break
+		}
+		nextURL = page.Next
+	}
+	if len(projects) > limit {
+		projects = projects[:limit]
+	}
+
+	// A pagelen=1 repository query per project is the cheapest way to get a
+	// count; its size field covers the whole result set
+	for i := range projects {
+		countPath := fmt.Sprintf("repositories/%s?q=project.key=%q&pagelen=1&fields=size",
+			workspace, projects[i].Key)
+		var count struct {
+			Size int `json:"size"`
+		}
+		if err := apiClient.Get("bitbucket.org", countPath, &count); err == nil {
+			projects[i].RepoCount = count.Size
+		}
+	}
+
+	return projects, nil
+}
+
+func printProjects(io *iostreams.IOStreams, projects []Project) error {
+	cs := io.ColorScheme()
+	tp := tableprinter.New(io, tableprinter.WithHeader("KEY", "NAME", "REPOS", "DESCRIPTION"))
+
+	for _, p := range projects {
+		tp.AddField(p.Key, tableprinter.WithColor(cs.Bold))
+		tp.AddField(p.Name)
+		tp.AddField(fmt.Sprintf("%d", p.RepoCount))
+		tp.AddField(p.Description)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
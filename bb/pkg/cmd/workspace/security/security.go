@@ -0,0 +1,26 @@
+package security
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	viewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/security/view"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdSecurity(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security <command>",
+		Short: "Inspect workspace security settings",
+		Long: heredoc.Doc(`
+			Inspect the security settings of a workspace: two-step verification
+			enforcement, IP allowlisting, and related controls.
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace security view myworkspace
+		`),
+	}
+
+	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
+
+	return cmd
+}
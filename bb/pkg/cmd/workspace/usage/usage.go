@@ -0,0 +1,176 @@
+package usage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/tableprinter"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UsageOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	WorkspaceSlug string
+}
+
+// workspaceUsage aggregates the consumption figures the public API exposes
+// for a workspace.
+type workspaceUsage struct {
+	Members      int
+	Projects     int
+	Repositories int
+	StorageBytes int64
+}
+
+func NewCmdUsage(f *cmdutil.Factory, runF func(*UsageOptions) error) *cobra.Command {
+	opts := &UsageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "usage <workspace>",
+		Short: "Show workspace usage figures",
+		Long: heredoc.Doc(`
+			Report consumption figures for a workspace: members, projects,
+			repositories, and repository storage.
+
+			Bitbucket's public API does not expose plan limits, build minutes, or
+			LFS storage; only the figures that are available are reported. Output
+			is tab-separated when not attached to a terminal, for use in scripts.
+		`),
+		Example: heredoc.Doc(`
+			$ bb workspace usage myworkspace
+
+			# Extract the member count in a script
+			$ bb workspace usage myworkspace | awk -F'\t' '$1 == "members" { print $2 }'
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.WorkspaceSlug = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return usageRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func usageRun(opts *UsageOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	usage, err := fetchUsage(httpClient, opts.WorkspaceSlug)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	return printUsage(opts.IO, opts.WorkspaceSlug, usage)
+}
+
+func fetchUsage(client *http.Client, slug string) (*workspaceUsage, error) {
+	apiClient := api.NewClientFromHTTP(client)
+	usage := &workspaceUsage{}
+
+	// Verify the workspace exists before collecting the individual figures,
+	// so a typo surfaces as one clear error.
+	if err := apiClient.Get("bitbucket.org", fmt.Sprintf("workspaces/%s", slug), nil); err != nil {
+		return nil, err
+	}
+
+	var sized struct {
+		Size int `json:"size"`
+	}
+	if err := apiClient.Get("bitbucket.org", fmt.Sprintf("workspaces/%s/members?pagelen=1", slug), &sized); err == nil {
+		usage.Members = sized.Size
+	}
+	if err := apiClient.Get("bitbucket.org", fmt.Sprintf("workspaces/%s/projects?pagelen=1", slug), &sized); err == nil {
+		usage.Projects = sized.Size
+	}
+
+	// Repository storage is only reported per repository, so walk the full list.
+	requestPath := fmt.Sprintf("repositories/%s?pagelen=100&fields=%s", slug, "next,values.size")
+	nextURL := ""
+	for {
+		var page api.PaginatedResponse[api.Repository]
+		var err error
+		if nextURL == "" {
+			nextURL, err = apiClient.RESTWithNext("bitbucket.org", http.MethodGet, requestPath, nil, &page)
+		} else {
+			nextURL, err = apiClient.RESTWithNextURL(http.MethodGet, nextURL, nil, &page)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		usage.Repositories += len(page.Values)
+		for _, repo := range page.Values {
+			usage.StorageBytes += repo.Size
+		}
+
+		if nextURL == "" {
+			break
+		}
+	}
+
+	return usage, nil
+}
+
+func printUsage(io *iostreams.IOStreams, slug string, usage *workspaceUsage) error {
+	cs := io.ColorScheme()
+
+	if io.IsStdoutTTY() {
+		fmt.Fprintf(io.Out, "%s\n\n", cs.Bold(slug))
+	}
+
+	tp := tableprinter.New(io, tableprinter.NoHeader)
+
+	tp.AddField("members")
+	tp.AddField(fmt.Sprintf("%d", usage.Members))
+	tp.EndRow()
+
+	tp.AddField("projects")
+	tp.AddField(fmt.Sprintf("%d", usage.Projects))
+	tp.EndRow()
+
+	tp.AddField("repositories")
+	tp.AddField(fmt.Sprintf("%d", usage.Repositories))
+	tp.EndRow()
+
+	tp.AddField("storage")
+	if tp.IsTTY() {
+		tp.AddField(formatByteSize(usage.StorageBytes))
+	} else {
+		tp.AddField(fmt.Sprintf("%d", usage.StorageBytes))
+	}
+	tp.EndRow()
+
+	return tp.Render()
+}
+
+// formatByteSize renders a byte count with a human readable binary unit.
+func formatByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
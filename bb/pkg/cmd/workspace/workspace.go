@@ -2,7 +2,13 @@ package workspace
 
 import (
 	"github.com/MakeNowJust/heredoc"
+	auditCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/audit"
+	auditBranchesCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/audit-branches"
+	hooksCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/hooks"
 	listCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/list"
+	projectsCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/projects"
+	securityCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/security"
+	usageCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/usage"
 	viewCmd "github.com/dlbroadfoot/bitbucket-cli/pkg/cmd/workspace/view"
 	"github.com/dlbroadfoot/bitbucket-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -25,7 +31,13 @@ func NewCmdWorkspace(f *cmdutil.Factory) *cobra.Command {
 		GroupID: "core",
 	}
 
+	cmd.AddCommand(auditCmd.NewCmdAudit(f, nil))
+	cmd.AddCommand(auditBranchesCmd.NewCmdAuditBranches(f, nil))
+	cmd.AddCommand(hooksCmd.NewCmdHooks(f))
 	cmd.AddCommand(listCmd.NewCmdList(f, nil))
+	cmd.AddCommand(projectsCmd.NewCmdProjects(f, nil))
+	cmd.AddCommand(securityCmd.NewCmdSecurity(f))
+	cmd.AddCommand(usageCmd.NewCmdUsage(f, nil))
 	cmd.AddCommand(viewCmd.NewCmdView(f, nil))
 
 	return cmd
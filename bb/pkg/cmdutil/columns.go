@@ -0,0 +1,90 @@
+package cmdutil
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/spf13/cobra"
+)
+
+// ColumnSet is the registry of columns a list command knows how to render.
+// The same names double as the field list for --json, so table output and
+// JSON export stay in sync.
+type ColumnSet struct {
+	valid    []string
+	defaults []string
+}
+
+// NewColumnSet builds a ColumnSet from the columns a command shows by default
+// plus any extra columns that are only shown on request.
+func NewColumnSet(defaults []string, extras ...string) ColumnSet {
+	valid := make([]string, 0, len(defaults)+len(extras))
+	valid = append(valid, defaults...)
+	valid = append(valid, extras...)
+	return ColumnSet{valid: valid, defaults: defaults}
+}
+
+// Fields returns every column name known to the set, suitable for passing to
+// AddJSONFlags so that JSON fields mirror the available table columns.
+func (s ColumnSet) Fields() []string {
+	return slices.Clone(s.valid)
+}
+
+// AddColumnsFlag registers the --columns flag on cmd for choosing and ordering
+// the table columns rendered by a list command.
+func AddColumnsFlag(cmd *cobra.Command, p *[]string, s ColumnSet) {
+	cmd.Flags().StringSliceVar(p, "columns", nil, "Comma-separated list of `columns` to display, in order")
+
+	_ = cmd.RegisterFlagCompletionFunc("columns", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var results []string
+		var prefix string
+		if idx := strings.LastIndexByte(toComplete, ','); idx >= 0 {
+			prefix = toComplete[:idx+1]
+			toComplete = toComplete[idx+1:]
+		}
+		toComplete = strings.ToLower(toComplete)
+		for _, c := range s.valid {
+			if strings.HasPrefix(c, toComplete) {
+				results = append(results, prefix+c)
+			}
+		}
+		sort.Strings(results)
+		return results, cobra.ShellCompDirectiveNoSpace
+	})
+}
+
+// ResolveColumns picks the columns a list command should render. An explicit
+// --columns flag wins, then a per-command config default stored under
+// configKey (e.g. pr_list_columns), then the set's built-in defaults.
+func ResolveColumns(cfg gh.Config, configKey string, flagValues []string, s ColumnSet) ([]string, error) {
+	columns := flagValues
+	if len(columns) == 0 && cfg != nil {
+		if entry, ok := cfg.GetOrDefault("", configKey).Value(); ok && entry.Value != "" {
+			columns = strings.Split(entry.Value, ",")
+		}
+	}
+	if len(columns) == 0 {
+		return slices.Clone(s.defaults), nil
+	}
+
+	resolved := make([]string, 0, len(columns))
+	for _, c := range columns {
+		name := strings.ToLower(strings.TrimSpace(c))
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(s.valid, name) {
+			available := s.Fields()
+			sort.Strings(available)
+			return nil, fmt.Errorf("unknown column %q\nAvailable columns:\n  %s", name, strings.Join(available, "\n  "))
+		}
+		resolved = append(resolved, name)
+	}
+	if len(resolved) == 0 {
+		return nil, fmt.Errorf("at least one column must be specified")
+	}
+	return resolved, nil
+}
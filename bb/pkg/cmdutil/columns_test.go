@@ -0,0 +1,73 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveColumns(t *testing.T) {
+	columns := NewColumnSet([]string{"id", "title", "state"}, "created", "updated")
+
+	tests := []struct {
+		name        string
+		flagValues  []string
+		configValue string
+		want        []string
+		wantErr     string
+	}{
+		{
+			name: "defaults when nothing specified",
+			want: []string{"id", "title", "state"},
+		},
+		{
+			name:       "flag overrides defaults",
+			flagValues: []string{"title", "id"},
+			want:       []string{"title", "id"},
+		},
+		{
+			name:        "config default used when flag absent",
+			configValue: "id, created",
+			want:        []string{"id", "created"},
+		},
+		{
+			name:        "flag overrides config default",
+			flagValues:  []string{"state"},
+			configValue: "id,created",
+			want:        []string{"state"},
+		},
+		{
+			name:       "names are case-insensitive",
+			flagValues: []string{"TITLE", "Updated"},
+			want:       []string{"title", "updated"},
+		},
+		{
+			name:       "unknown column",
+			flagValues: []string{"id", "bogus"},
+			wantErr:    `unknown column "bogus"`,
+		},
+		{
+			name:       "only empty names",
+			flagValues: []string{"", " "},
+			wantErr:    "at least one column must be specified",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewBlankConfig()
+			if tt.configValue != "" {
+				cfg.Set("", "test_list_columns", tt.configValue)
+			}
+
+			got, err := ResolveColumns(cfg, "test_list_columns", tt.flagValues, columns)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package cmdutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+)
+
+// DefaultConcurrency bounds how many HTTP requests a fan-out command keeps in
+// flight at once when neither the --concurrency flag nor the concurrency
+// config key overrides it. The default stays comfortably under Bitbucket's
+// rate limits.
+const DefaultConcurrency = 5
+
+// Concurrency resolves the request concurrency for commands that fan out:
+// the --concurrency flag value wins when positive, then the concurrency
+// config key, then DefaultConcurrency.
+func Concurrency(flagValue int, cfg gh.Config) int {
+	if flagValue > 0 {
+		return flagValue
+	}
+	if cfg != nil {
+		if entry, ok := cfg.GetOrDefault("", "concurrency").Value(); ok {
+			if v, err := strconv.Atoi(strings.TrimSpace(entry.Value)); err == nil && v > 0 {
+				return v
+			}
+		}
+	}
+	return DefaultConcurrency
+}
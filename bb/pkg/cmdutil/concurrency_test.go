@@ -0,0 +1,67 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrency(t *testing.T) {
+	tests := []struct {
+		name      string
+		flagValue int
+		cfg       func() gh.Config
+		want      int
+	}{
+		{
+			name:      "flag wins",
+			flagValue: 12,
+			cfg: func() gh.Config {
+				cfg := config.NewBlankConfig()
+				cfg.Set("", "concurrency", "3")
+				return cfg
+			},
+			want: 12,
+		},
+		{
+			name:      "config value",
+			flagValue: 0,
+			cfg: func() gh.Config {
+				cfg := config.NewBlankConfig()
+				cfg.Set("", "concurrency", "3")
+				return cfg
+			},
+			want: 3,
+		},
+		{
+			name:      "default",
+			flagValue: 0,
+			cfg:       func() gh.Config { return config.NewBlankConfig() },
+			want:      DefaultConcurrency,
+		},
+		{
+			name:      "invalid config value falls back to default",
+			flagValue: 0,
+			cfg: func() gh.Config {
+				cfg := config.NewBlankConfig()
+				cfg.Set("", "concurrency", "lots")
+				return cfg
+			},
+			want: DefaultConcurrency,
+		},
+		{
+			name:      "nil config",
+			flagValue: 0,
+			cfg:       func() gh.Config { return nil },
+			want:      DefaultConcurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Concurrency(tt.flagValue, tt.cfg()))
+		})
+	}
+}
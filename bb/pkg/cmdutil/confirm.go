@@ -0,0 +1,44 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// ConfirmDestructive decides whether a destructive command may proceed,
+// honoring the confirm_destructive config setting:
+//
+//   - prompt (the default): confirm interactively unless --yes was given
+//   - never: skip confirmation entirely
+//   - always: confirm interactively even when --yes was given
+//
+// The confirm callback performs the command's own prompt and returns an error
+// (typically CancelError) when the user declines. Commands without a --yes
+// flag pass skipRequested=true so the prompt policy keeps their existing
+// behavior.
+func ConfirmDestructive(cfg gh.Config, io *iostreams.IOStreams, skipRequested bool, confirm func() error) error {
+	policy := "prompt"
+	if cfg != nil {
+		policy = cfg.ConfirmDestructive("").Value
+	}
+
+	switch policy {
+	case "never":
+		return nil
+	case "always":
+		if !io.CanPrompt() {
+			return fmt.Errorf("confirm_destructive is set to %q but prompts are disabled", policy)
+		}
+		return confirm()
+	default:
+		if skipRequested {
+			return nil
+		}
+		if !io.CanPrompt() {
+			return FlagErrorf("--yes required when not running interactively")
+		}
+		return confirm()
+	}
+}
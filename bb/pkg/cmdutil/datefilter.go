@@ -0,0 +1,86 @@
+package cmdutil
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateFilterRE matches an optional comparison operator followed by a value,
+// e.g. ">2024-01-01", "<=7d", "2024-06-01".
+var dateFilterRE = regexp.MustCompile(`^(>=|<=|>|<)?\s*(.+)$`)
+
+// relativeDurationRE matches relative durations like "7d", "2w", "12h".
+var relativeDurationRE = regexp.MustCompile(`^(\d+)([hdwmy])$`)
+
+// BuildDateQuery translates a --created/--updated style filter expression into
+// a BBQL date comparison on field (e.g. created_on or updated_on).
+//
+// Expressions take an optional comparison operator (>, >=, <, <=; default >=)
+// followed by either an absolute date (2024-01-01 or RFC 3339) or a relative
+// duration (12h, 7d, 2w, 3m, 1y). Relative durations compare against age, so
+// "<7d" means "within the last 7 days" and ">30d" means "older than 30 days".
+func BuildDateQuery(field, expr string) (string, error) {
+	matches := dateFilterRE.FindStringSubmatch(strings.TrimSpace(expr))
+	if matches == nil {
+		return "", fmt.Errorf("invalid date filter: %q", expr)
+	}
+
+	op := matches[1]
+	if op == "" {
+		op = ">="
+	}
+	value := strings.TrimSpace(matches[2])
+
+	if dm := relativeDurationRE.FindStringSubmatch(value); dm != nil {
+		n, err := strconv.Atoi(dm[1])
+		if err != nil {
+			return "", fmt.Errorf("invalid date filter: %q", expr)
+		}
+
+		var d time.Duration
+		switch dm[2] {
+		case "h":
+			d = time.Duration(n) * time.Hour
+		case "d":
+			d = time.Duration(n) * 24 * time.Hour
+		case "w":
+			d = time.Duration(n) * 7 * 24 * time.Hour
+		case "m":
+			d = time.Duration(n) * 30 * 24 * time.Hour
+		case "y":
+			d = time.Duration(n) * 365 * 24 * time.Hour
+		}
+
+		// A comparison on age inverts to the opposite comparison on the
+		// timestamp: "<7d" (younger than 7 days) means created_on > now-7d.
+		cutoff := time.Now().UTC().Add(-d)
+		return fmt.Sprintf(`%s %s "%s"`, field, invertOperator(op), cutoff.Format(time.RFC3339)), nil
+	}
+
+	var t time.Time
+	var err error
+	if t, err = time.Parse(time.RFC3339, value); err != nil {
+		if t, err = time.Parse("2006-01-02", value); err != nil {
+			return "", fmt.Errorf("invalid date filter %q: expected a date (2006-01-02), timestamp (RFC 3339), or duration (e.g. 7d)", expr)
+		}
+	}
+
+	return fmt.Sprintf(`%s %s "%s"`, field, op, t.UTC().Format(time.RFC3339)), nil
+}
+
+func invertOperator(op string) string {
+	switch op {
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	}
+	return op
+}
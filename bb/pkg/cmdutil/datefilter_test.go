@@ -0,0 +1,69 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildDateQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		expr    string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "absolute date with operator",
+			field: "created_on",
+			expr:  ">2024-01-01",
+			want:  `created_on > "2024-01-01T00:00:00Z"`,
+		},
+		{
+			name:  "absolute date defaults to >=",
+			field: "created_on",
+			expr:  "2024-01-01",
+			want:  `created_on >= "2024-01-01T00:00:00Z"`,
+		},
+		{
+			name:  "rfc3339 timestamp",
+			field: "updated_on",
+			expr:  "<=2024-06-01T12:00:00Z",
+			want:  `updated_on <= "2024-06-01T12:00:00Z"`,
+		},
+		{
+			name:    "garbage input",
+			field:   "created_on",
+			expr:    ">yesterday",
+			wantErr: "invalid date filter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildDateQuery(tt.field, tt.expr)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildDateQueryRelative(t *testing.T) {
+	// "<7d" means within the last 7 days, which inverts to updated_on > cutoff
+	got, err := BuildDateQuery("updated_on", "<7d")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(got, `updated_on > "`), got)
+
+	cutoff, err := time.Parse(time.RFC3339, strings.Trim(strings.TrimPrefix(got, "updated_on > "), `"`))
+	assert.NoError(t, err)
+	want := time.Now().UTC().Add(-7 * 24 * time.Hour)
+	assert.WithinDuration(t, want, cutoff, time.Minute, fmt.Sprintf("cutoff %s not near %s", cutoff, want))
+}
@@ -3,14 +3,26 @@ package cmdutil
 import (
 	"errors"
 	"fmt"
+	"os/exec"
 
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
 )
 
+// ExternalCommandExitError wraps a child process's exit error so the calling
+// command's exit status can be propagated as bb's own.
+type ExternalCommandExitError struct {
+	*exec.ExitError
+}
+
 // FlagErrorf returns a new FlagError that wraps an error produced by
-// fmt.Errorf(format, args...).
+// fmt.Errorf(format, args...). The format string doubles as an i18n catalog
+// key, so every flag error in the CLI is translatable without per-command
+// plumbing.
 func FlagErrorf(format string, args ...interface{}) error {
-	return FlagErrorWrap(fmt.Errorf(format, args...))
+	return FlagErrorWrap(fmt.Errorf(i18n.T(format), args...))
 }
 
 // FlagErrorWrap returns a new FlagError that wraps the specified error.
@@ -68,3 +80,15 @@ func (e NoResultsError) Error() string {
 func NewNoResultsError(message string) NoResultsError {
 	return NoResultsError{message: message}
 }
+
+// WrapWriteError adds context to a 403 from a call that writes to a
+// repository. Bitbucket reports writes to archived or read-only repositories
+// with the same bare 403 it uses for missing permissions, which reads like a
+// credentials problem. Other errors pass through unchanged.
+func WrapWriteError(err error, repo bbrepo.Interface) error {
+	if err == nil || !api.IsForbiddenError(err) {
+		return err
+	}
+	return fmt.Errorf("%w\n"+i18n.T("%s does not accept writes from your account; the repository may be archived or read-only, or you may lack write access. Check its status with `bb repo view %s`"),
+		err, bbrepo.FullName(repo), bbrepo.FullName(repo))
+}
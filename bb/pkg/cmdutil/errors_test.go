@@ -0,0 +1,32 @@
+package cmdutil
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/api"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/bbrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapWriteError(t *testing.T) {
+	repo, err := bbrepo.FromFullName("ws/repo")
+	require.NoError(t, err)
+
+	assert.NoError(t, WrapWriteError(nil, repo))
+
+	// non-403 errors pass through unchanged
+	plain := errors.New("boom")
+	assert.Equal(t, plain, WrapWriteError(plain, repo))
+	notFound := api.HTTPError{StatusCode: http.StatusNotFound, Message: "not found"}
+	assert.Equal(t, error(notFound), WrapWriteError(notFound, repo))
+
+	forbidden := api.HTTPError{StatusCode: http.StatusForbidden, Message: "Forbidden"}
+	wrapped := WrapWriteError(forbidden, repo)
+	assert.ErrorContains(t, wrapped, "ws/repo does not accept writes")
+	assert.ErrorContains(t, wrapped, "archived or read-only")
+	// the original error stays inspectable
+	assert.True(t, api.IsForbiddenError(wrapped))
+}
@@ -0,0 +1,129 @@
+package cmdutil
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// AddExportFormatFlags registers a --format flag that serializes the same
+// fields the --json flag exports as CSV, TSV, or YAML, for commands whose
+// output feeds spreadsheets and other tools. It is meant to be added
+// alongside AddJSONFlags with the same field list.
+func AddExportFormatFlags(cmd *cobra.Command, exportTarget *Exporter, fields []string) {
+	var format string
+	StringEnumFlag(cmd, &format, "format", "", "", []string{"csv", "tsv", "yaml"}, "Output format for the exported fields")
+
+	oldPreRun := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if oldPreRun != nil {
+			if err := oldPreRun(c, args); err != nil {
+				return err
+			}
+		}
+
+		if !c.Flags().Changed("format") {
+			return nil
+		}
+		if c.Flags().Changed("json") {
+			return FlagErrorf("cannot use `--json` with `--format`")
+		}
+
+		*exportTarget = &formatExporter{format: format, fields: fields}
+		return nil
+	}
+}
+
+// formatExporter renders exportable data as CSV, TSV, or YAML. It reuses the
+// jsonExporter reflection to turn the data into records, so any type usable
+// with --json works here too.
+type formatExporter struct {
+	format string
+	fields []string
+}
+
+func (e *formatExporter) Fields() []string {
+	return e.fields
+}
+
+func (e *formatExporter) Write(ios *iostreams.IOStreams, data interface{}) error {
+	je := &jsonExporter{fields: e.fields}
+	exported := je.exportData(reflect.ValueOf(data))
+
+	if e.format == "yaml" {
+		encoded, err := yaml.Marshal(exported)
+		if err != nil {
+			return err
+		}
+		_, err = ios.Out.Write(encoded)
+		return err
+	}
+
+	w := csv.NewWriter(ios.Out)
+	if e.format == "tsv" {
+		w.Comma = '\t'
+	}
+
+	if err := w.Write(e.fields); err != nil {
+		return err
+	}
+	for _, record := range exportRecords(exported) {
+		row := make([]string, len(e.fields))
+		for i, f := range e.fields {
+			row[i] = cellString(record[f])
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// exportRecords normalizes exported data into a list of records: a slice
+// yields one record per element, a single object yields one record.
+func exportRecords(exported interface{}) []map[string]interface{} {
+	switch v := exported.(type) {
+	case []interface{}:
+		records := make([]map[string]interface{}, 0, len(v))
+		for _, item := range v {
+			if record, ok := item.(map[string]interface{}); ok {
+				records = append(records, record)
+			}
+		}
+		return records
+	case map[string]interface{}:
+		return []map[string]interface{}{v}
+	}
+	return nil
+}
+
+// cellString flattens a field value into one cell: scalars print as-is,
+// nested objects and lists fall back to compact JSON.
+func cellString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr:
+		if rv.IsNil() {
+			return ""
+		}
+		fallthrough
+	case reflect.Array, reflect.Struct:
+		if encoded, err := json.Marshal(v); err == nil {
+			return string(encoded)
+		}
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,71 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+type formatFixture struct {
+	ID    int
+	Name  string
+	Label map[string]string
+}
+
+func (f *formatFixture) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, field := range fields {
+		switch field {
+		case "id":
+			data[field] = f.ID
+		case "name":
+			data[field] = f.Name
+		case "label":
+			data[field] = f.Label
+		}
+	}
+	return data
+}
+
+func TestFormatExporterWrite(t *testing.T) {
+	data := []formatFixture{
+		{ID: 1, Name: "first, with comma", Label: map[string]string{"kind": "bug"}},
+		{ID: 2, Name: "second"},
+	}
+	fields := []string{"id", "name", "label"}
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: "csv",
+			want: "id,name,label\n" +
+				"1,\"first, with comma\",\"{\"\"kind\"\":\"\"bug\"\"}\"\n" +
+				"2,second,\n",
+		},
+		{
+			format: "tsv",
+			want: "id\tname\tlabel\n" +
+				"1\tfirst, with comma\t\"{\"\"kind\"\":\"\"bug\"\"}\"\n" +
+				"2\tsecond\t\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			e := &formatExporter{format: tt.format, fields: fields}
+			assert.NoError(t, e.Write(ios, data))
+			assert.Equal(t, tt.want, stdout.String())
+		})
+	}
+}
+
+func TestFormatExporterWriteYAML(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	e := &formatExporter{format: "yaml", fields: []string{"id", "name"}}
+	assert.NoError(t, e.Write(ios, []formatFixture{{ID: 1, Name: "first"}}))
+	assert.Equal(t, "- id: 1\n  name: first\n", stdout.String())
+}
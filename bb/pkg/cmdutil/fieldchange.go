@@ -0,0 +1,45 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// FieldChange records one field's value on a resource before and after a
+// mutating command.
+type FieldChange struct {
+	Name   string
+	Before string
+	After  string
+}
+
+// PrintFieldChanges prints the fields whose value actually changed as
+// indented "name: before → after" lines, so users can verify exactly what a
+// mutating command modified. Values are flattened to a single line and
+// truncated; an empty value prints as (none).
+func PrintFieldChanges(io *iostreams.IOStreams, changes []FieldChange) {
+	cs := io.ColorScheme()
+	for _, c := range changes {
+		if c.Before == c.After {
+			continue
+		}
+		fmt.Fprintf(io.Out, "  %s: %s → %s\n", c.Name, cs.Muted(fieldValue(c.Before)), cs.Bold(fieldValue(c.After)))
+	}
+}
+
+// fieldValueLimit caps how much of a value (e.g. a long description) is shown
+// in a change line.
+const fieldValueLimit = 40
+
+func fieldValue(v string) string {
+	v = strings.Join(strings.Fields(v), " ")
+	if v == "" {
+		return "(none)"
+	}
+	if len(v) > fieldValueLimit {
+		v = v[:fieldValueLimit-3] + "..."
+	}
+	return v
+}
@@ -0,0 +1,27 @@
+package cmdutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintFieldChanges(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+
+	PrintFieldChanges(ios, []FieldChange{
+		{Name: "title", Before: "Old title", After: "New title"},
+		{Name: "state", Before: "open", After: "open"},
+		{Name: "assignee", Before: "", After: "monalisa"},
+		{Name: "body", Before: "one\nline", After: strings.Repeat("long ", 20)},
+	})
+
+	out := stdout.String()
+	assert.Contains(t, out, "title: Old title → New title")
+	assert.NotContains(t, out, "state")
+	assert.Contains(t, out, "assignee: (none) → monalisa")
+	assert.Contains(t, out, "body: one line → long long")
+	assert.Contains(t, out, "...")
+}
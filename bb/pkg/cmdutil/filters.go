@@ -0,0 +1,102 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// AddFilterFlags registers the saved-filter flags on a list command.
+func AddFilterFlags(cmd *cobra.Command, filter, saveAs *string) {
+	cmd.Flags().StringVar(filter, "filter", "", "Apply the saved filter `name`")
+	cmd.Flags().StringVar(saveAs, "save-as", "", "Save the given filter flags as `name` for reuse with --filter")
+}
+
+// filterScope identifies the command a filter belongs to, e.g. "pr list".
+func filterScope(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
+// SaveFilter persists the flags explicitly set on cmd under name, so the same
+// query can be replayed later with --filter.
+func SaveFilter(cfg gh.Config, cmd *cobra.Command, name string) error {
+	parts := []string{filterScope(cmd)}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		switch f.Name {
+		case "filter", "save-as":
+			return
+		}
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			for _, item := range sv.GetSlice() {
+				parts = append(parts, filterArg(f.Name, item))
+			}
+			return
+		}
+		parts = append(parts, filterArg(f.Name, f.Value.String()))
+	})
+	if len(parts) == 1 {
+		return FlagErrorf("no filter flags given to save as %q", name)
+	}
+
+	cfg.Filters().Add(name, strings.Join(parts, " "))
+	return cfg.Write()
+}
+
+func filterArg(name, value string) string {
+	if strings.ContainsAny(value, " \t'\"\\") {
+		value = strconv.Quote(value)
+	}
+	return fmt.Sprintf("--%s=%s", name, value)
+}
+
+// ApplyFilter loads the saved filter named name and applies its flag values to
+// cmd. Flags given explicitly on the command line keep their values.
+func ApplyFilter(cfg gh.Config, cmd *cobra.Command, name string) error {
+	expansion, err := cfg.Filters().Get(name)
+	if err != nil {
+		return FlagErrorf("no saved filter named %q", name)
+	}
+
+	scope := filterScope(cmd)
+	if expansion != scope && !strings.HasPrefix(expansion, scope+" ") {
+		return FlagErrorf("saved filter %q was saved from a different command", name)
+	}
+
+	args, err := shlex.Split(strings.TrimPrefix(expansion, scope))
+	if err != nil {
+		return fmt.Errorf("malformed saved filter %q: %w", name, err)
+	}
+
+	flags := cmd.Flags()
+
+	// Remember which flags were set on the command line before applying the
+	// saved values, since setting a flag below also marks it as changed.
+	setByUser := map[string]bool{}
+	flags.Visit(func(f *pflag.Flag) {
+		setByUser[f.Name] = true
+	})
+
+	for _, arg := range args {
+		flagName, value, ok := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !strings.HasPrefix(arg, "--") || !ok {
+			return fmt.Errorf("malformed saved filter %q: %q", name, arg)
+		}
+		flag := flags.Lookup(flagName)
+		if flag == nil {
+			return fmt.Errorf("saved filter %q uses unknown flag --%s", name, flagName)
+		}
+		if setByUser[flagName] {
+			continue
+		}
+		if err := flags.Set(flagName, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
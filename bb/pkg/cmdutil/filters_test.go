@@ -0,0 +1,95 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/config"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFilterTestCommand builds a "bb pr list" command tree with a couple of
+// filter-style flags and returns the leaf command.
+func newFilterTestCommand() *cobra.Command {
+	root := &cobra.Command{Use: "bb"}
+	pr := &cobra.Command{Use: "pr"}
+	list := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+	list.Flags().String("state", "open", "")
+	list.Flags().String("author", "", "")
+	list.Flags().StringSlice("columns", nil, "")
+	list.Flags().String("filter", "", "")
+	list.Flags().String("save-as", "", "")
+	pr.AddCommand(list)
+	root.AddCommand(pr)
+	return list
+}
+
+func TestSaveAndApplyFilter(t *testing.T) {
+	config.StubWriteConfig(t)
+	cfg := config.NewBlankConfig()
+
+	saveCmd := newFilterTestCommand()
+	require.NoError(t, saveCmd.Flags().Parse([]string{"--state=merged", "--author", "@me", "--columns", "id,title"}))
+	require.NoError(t, SaveFilter(cfg, saveCmd, "my-merged"))
+
+	expansion, err := cfg.Filters().Get("my-merged")
+	require.NoError(t, err)
+	assert.Equal(t, "pr list --author=@me --columns=id --columns=title --state=merged", expansion)
+
+	applyCmd := newFilterTestCommand()
+	require.NoError(t, ApplyFilter(cfg, applyCmd, "my-merged"))
+	state, _ := applyCmd.Flags().GetString("state")
+	author, _ := applyCmd.Flags().GetString("author")
+	columns, _ := applyCmd.Flags().GetStringSlice("columns")
+	assert.Equal(t, "merged", state)
+	assert.Equal(t, "@me", author)
+	assert.Equal(t, []string{"id", "title"}, columns)
+}
+
+func TestApplyFilterCommandLineWins(t *testing.T) {
+	config.StubWriteConfig(t)
+	cfg := config.NewBlankConfig()
+
+	saveCmd := newFilterTestCommand()
+	require.NoError(t, saveCmd.Flags().Parse([]string{"--state=merged", "--author=alice"}))
+	require.NoError(t, SaveFilter(cfg, saveCmd, "my-merged"))
+
+	applyCmd := newFilterTestCommand()
+	require.NoError(t, applyCmd.Flags().Parse([]string{"--author=bob"}))
+	require.NoError(t, ApplyFilter(cfg, applyCmd, "my-merged"))
+	state, _ := applyCmd.Flags().GetString("state")
+	author, _ := applyCmd.Flags().GetString("author")
+	assert.Equal(t, "merged", state)
+	assert.Equal(t, "bob", author)
+}
+
+func TestApplyFilterErrors(t *testing.T) {
+	config.StubWriteConfig(t)
+	cfg := config.NewBlankConfig()
+
+	saveCmd := newFilterTestCommand()
+	require.NoError(t, saveCmd.Flags().Parse([]string{"--state=merged"}))
+	require.NoError(t, SaveFilter(cfg, saveCmd, "my-merged"))
+
+	t.Run("unknown filter", func(t *testing.T) {
+		assert.EqualError(t, ApplyFilter(cfg, newFilterTestCommand(), "nope"), `no saved filter named "nope"`)
+	})
+
+	t.Run("different command", func(t *testing.T) {
+		root := &cobra.Command{Use: "bb"}
+		issue := &cobra.Command{Use: "issue"}
+		list := &cobra.Command{Use: "list"}
+		issue.AddCommand(list)
+		root.AddCommand(issue)
+		assert.EqualError(t, ApplyFilter(cfg, list, "my-merged"), `saved filter "my-merged" was saved from a different command`)
+	})
+}
+
+func TestSaveFilterRequiresFlags(t *testing.T) {
+	config.StubWriteConfig(t)
+	cfg := config.NewBlankConfig()
+
+	err := SaveFilter(cfg, newFilterTestCommand(), "empty")
+	assert.EqualError(t, err, `no filter flags given to save as "empty"`)
+}
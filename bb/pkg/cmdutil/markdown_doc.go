@@ -0,0 +1,75 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/gh"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/surveyext"
+	"gopkg.in/yaml.v3"
+)
+
+// MarkdownDocField is one front-matter entry of an editable markdown
+// document. Fields keep the order they are given in.
+type MarkdownDocField struct {
+	Name  string
+	Value string
+}
+
+// RenderMarkdownDoc lays out an editable markdown document: YAML front
+// matter for structured fields, the title as a heading, and the body below.
+func RenderMarkdownDoc(fields []MarkdownDocField, title, body string) string {
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	for _, field := range fields {
+		sb.WriteString(fmt.Sprintf("%s: %s\n", field.Name, field.Value))
+	}
+	sb.WriteString("---\n")
+	sb.WriteString("# " + title + "\n\n")
+	sb.WriteString(body)
+	if !strings.HasSuffix(body, "\n") {
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// ParseMarkdownDoc is the inverse of RenderMarkdownDoc: it splits an edited
+// document back into front-matter fields, title, and body.
+func ParseMarkdownDoc(doc string) (fields map[string]string, title, body string, err error) {
+	rest, ok := strings.CutPrefix(doc, "---\n")
+	if !ok {
+		return nil, "", "", fmt.Errorf("document must start with a `---` front matter block")
+	}
+	front, rest, ok := strings.Cut(rest, "\n---\n")
+	if !ok {
+		return nil, "", "", fmt.Errorf("front matter block is not closed with `---`")
+	}
+
+	fields = map[string]string{}
+	if err := yaml.Unmarshal([]byte(front), &fields); err != nil {
+		return nil, "", "", fmt.Errorf("invalid front matter: %w", err)
+	}
+
+	rest = strings.TrimLeft(rest, "\n")
+	if heading, remainder, ok := strings.Cut(rest, "\n"); ok && strings.HasPrefix(heading, "# ") {
+		title = strings.TrimSpace(strings.TrimPrefix(heading, "# "))
+		body = strings.TrimLeft(remainder, "\n")
+	} else if strings.HasPrefix(rest, "# ") {
+		title = strings.TrimSpace(strings.TrimPrefix(rest, "# "))
+	} else {
+		return nil, "", "", fmt.Errorf("document must contain a `# <title>` heading after the front matter")
+	}
+
+	return fields, title, strings.TrimRight(body, "\n"), nil
+}
+
+// EditMarkdownDoc opens the document in the user's editor and returns the
+// edited text. The editor resolution matches other editing commands.
+func EditMarkdownDoc(io *iostreams.IOStreams, cf func() (gh.Config, error), initial string) (string, error) {
+	editor, err := DetermineEditor(cf)
+	if err != nil {
+		return "", err
+	}
+	return surveyext.Edit(editor, "bb-edit*.md", initial, io.In, io.Out, io.ErrOut)
+}
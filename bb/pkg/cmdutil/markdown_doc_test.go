@@ -0,0 +1,40 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarkdownDocRoundTrip(t *testing.T) {
+	fields := []MarkdownDocField{
+		{Name: "state", Value: "open"},
+		{Name: "priority", Value: "major"},
+	}
+	doc := RenderMarkdownDoc(fields, "Fix the login form", "It drops the username.\n\nSteps to reproduce...")
+
+	parsed, title, body, err := ParseMarkdownDoc(doc)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"state": "open", "priority": "major"}, parsed)
+	assert.Equal(t, "Fix the login form", title)
+	assert.Equal(t, "It drops the username.\n\nSteps to reproduce...", body)
+}
+
+func TestParseMarkdownDocErrors(t *testing.T) {
+	_, _, _, err := ParseMarkdownDoc("no front matter")
+	assert.EqualError(t, err, "document must start with a `---` front matter block")
+
+	_, _, _, err = ParseMarkdownDoc("---\nstate: open\nno closing")
+	assert.EqualError(t, err, "front matter block is not closed with `---`")
+
+	_, _, _, err = ParseMarkdownDoc("---\nstate: open\n---\nbody without heading\n")
+	assert.EqualError(t, err, "document must contain a `# <title>` heading after the front matter")
+}
+
+func TestParseMarkdownDocTitleOnly(t *testing.T) {
+	parsed, title, body, err := ParseMarkdownDoc("---\nkind: bug\n---\n# Just a title")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"kind": "bug"}, parsed)
+	assert.Equal(t, "Just a title", title)
+	assert.Equal(t, "", body)
+}
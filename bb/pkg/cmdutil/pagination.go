@@ -0,0 +1,35 @@
+package cmdutil
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// LoadMore asks whether a list command should keep fetching pages after
+// showing a batch of results. It reports false when the output is not an
+// interactive terminal, on EOF, or when the user answers q; anything else
+// loads the next batch. The prompt goes to stderr so piped output stays
+// clean even when detection misfires.
+func LoadMore(io *iostreams.IOStreams, shown, total int) bool {
+	if !io.IsStdoutTTY() || !io.IsStdinTTY() {
+		return false
+	}
+
+	if total > shown {
+		fmt.Fprint(io.ErrOut, i18n.T("Showing %d of %d — press Enter to load more / q to quit ", shown, total))
+	} else {
+		fmt.Fprint(io.ErrOut, i18n.T("Showing %d — press Enter to load more / q to quit ", shown))
+	}
+
+	line, err := bufio.NewReader(io.In).ReadString('\n')
+	if err != nil {
+		fmt.Fprintln(io.ErrOut)
+		return false
+	}
+
+	return !strings.EqualFold(strings.TrimSpace(line), "q")
+}
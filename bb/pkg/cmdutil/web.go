@@ -0,0 +1,39 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"github.com/atotto/clipboard"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/browser"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/text"
+	"github.com/dlbroadfoot/bitbucket-cli/pkg/iostreams"
+)
+
+// OpenInBrowser opens url in the user's web browser. When noBrowser is set,
+// the URL is printed to stdout instead. This backs the consistent --no-browser
+// behavior across commands that open a browser.
+func OpenInBrowser(io *iostreams.IOStreams, b browser.Browser, url string, noBrowser bool) error {
+	if noBrowser {
+		_, err := fmt.Fprintln(io.Out, url)
+		return err
+	}
+	if io.IsStdoutTTY() {
+		fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+	}
+	return b.Browse(url)
+}
+
+// CopyURLToClipboard places url on the system clipboard and prints it.
+func CopyURLToClipboard(io *iostreams.IOStreams, url string) error {
+	if err := clipboard.WriteAll(url); err != nil {
+		return fmt.Errorf("failed to copy URL to clipboard: %w", err)
+	}
+	if _, err := fmt.Fprintln(io.Out, url); err != nil {
+		return err
+	}
+	if io.IsStdoutTTY() {
+		cs := io.ColorScheme()
+		fmt.Fprintf(io.ErrOut, "%s Copied URL to clipboard\n", cs.SuccessIcon())
+	}
+	return nil
+}
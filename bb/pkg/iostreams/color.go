@@ -51,10 +51,61 @@ type ColorScheme struct {
 	TrueColor bool
 	// Accessible is whether colors must be base 16 colors that users can customize in terminal preferences.
 	Accessible bool
+	// AccessibleOutput is whether icons are rendered as words for screen readers.
+	AccessibleOutput bool
 	// ColorLabels is whether labels are colored based on their truecolor RGB hex color.
 	ColorLabels bool
 	// Theme is the terminal background color theme used to contextually color text for light, dark, or none at all.
 	Theme string
+	// Overrides maps color roles like "state_open" or "accent" to user-configured ANSI style specs.
+	Overrides map[string]string
+}
+
+// AccessibleHighContrastTheme maps color roles to bold, high-intensity styles
+// for users who need stronger contrast than the default palette. It is applied
+// when the theme section of the config names the "accessible" theme.
+func AccessibleHighContrastTheme() map[string]string {
+	return map[string]string{
+		"accent":            "cyan+b",
+		"success":           "green+b",
+		"warning":           "yellow+b",
+		"failure":           "red+b",
+		"state_open":        "green+b",
+		"state_merged":      "magenta+b",
+		"state_declined":    "red+b",
+		"state_closed":      "magenta+b",
+		"state_pending":     "yellow+b",
+		"priority_major":    "yellow+b",
+		"priority_critical": "red+b",
+	}
+}
+
+// RoleColor returns the color function for a named UI role such as
+// "state_open" or "accent", preferring any theme override from config over
+// the built-in fallback.
+func (c *ColorScheme) RoleColor(role string, fallback func(string) string) func(string) string {
+	spec, ok := c.Overrides[role]
+	if !ok || spec == "" {
+		return fallback
+	}
+
+	fn := ansi.ColorFunc(spec)
+	return func(t string) string {
+		if !c.Enabled {
+			return t
+		}
+		return fn(t)
+	}
+}
+
+// Accent returns text in the accent color used for emphasis, cyan unless
+// overridden by the theme.
+func (c *ColorScheme) Accent(t string) string {
+	return c.RoleColor("accent", c.Cyan)(t)
+}
+
+func (c *ColorScheme) Accentf(t string, args ...interface{}) string {
+	return c.Accent(fmt.Sprintf(t, args...))
 }
 
 func (c *ColorScheme) Bold(t string) string {
@@ -190,25 +241,44 @@ func (c *ColorScheme) Bluef(t string, args ...interface{}) string {
 }
 
 func (c *ColorScheme) SuccessIcon() string {
-	return c.SuccessIconWithColor(c.Green)
+	return c.SuccessIconWithColor(c.RoleColor("success", c.Green))
 }
 
 func (c *ColorScheme) SuccessIconWithColor(colo func(string) string) string {
+	if c.AccessibleOutput {
+		return "Success:"
+	}
 	return colo("✓")
 }
 
 func (c *ColorScheme) WarningIcon() string {
-	return c.Yellow("!")
+	if c.AccessibleOutput {
+		return "Warning:"
+	}
+	return c.RoleColor("warning", c.Yellow)("!")
 }
 
 func (c *ColorScheme) FailureIcon() string {
-	return c.FailureIconWithColor(c.Red)
+	return c.FailureIconWithColor(c.RoleColor("failure", c.Red))
 }
 
 func (c *ColorScheme) FailureIconWithColor(colo func(string) string) string {
+	if c.AccessibleOutput {
+		return "Failed:"
+	}
 	return colo("X")
 }
 
+// WordedIcon returns icon, or word when icons are rendered as words for
+// screen readers. Callers supply both forms so states without a dedicated
+// helper — check glyphs like "✗" or "●" — still honor accessible output.
+func (c *ColorScheme) WordedIcon(icon, word string) string {
+	if c.AccessibleOutput {
+		return word
+	}
+	return icon
+}
+
 func (c *ColorScheme) HighlightStart() string {
 	if !c.Enabled {
 		return ""
@@ -294,3 +294,84 @@ func TestMuted(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleColor(t *testing.T) {
+	reset := "\x1b[0m"
+
+	tests := []struct {
+		name     string
+		cs       *ColorScheme
+		role     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "without an override, the fallback color is used",
+			cs:       &ColorScheme{Enabled: true},
+			role:     "state_open",
+			input:    "Open",
+			expected: fmt.Sprintf("\x1b[0;32m%s%s", "Open", reset),
+		},
+		{
+			name: "an override replaces the fallback color",
+			cs: &ColorScheme{
+				Enabled:   true,
+				Overrides: map[string]string{"state_open": "cyan"},
+			},
+			role:     "state_open",
+			input:    "Open",
+			expected: fmt.Sprintf("\x1b[0;36m%s%s", "Open", reset),
+		},
+		{
+			name: "overrides are ignored when color is disabled",
+			cs: &ColorScheme{
+				Overrides: map[string]string{"state_open": "cyan"},
+			},
+			role:     "state_open",
+			input:    "Open",
+			expected: "Open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn := tt.cs.RoleColor(tt.role, tt.cs.Green)
+			assert.Equal(t, tt.expected, fn(tt.input))
+		})
+	}
+}
+
+func TestEnvColorDisabled(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR", "")
+	assert.False(t, EnvColorDisabled())
+
+	t.Setenv("NO_COLOR", "1")
+	assert.True(t, EnvColorDisabled())
+
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("CLICOLOR", "0")
+	assert.True(t, EnvColorDisabled())
+}
+
+func TestEnvColorForced(t *testing.T) {
+	t.Setenv("CLICOLOR_FORCE", "0")
+	assert.False(t, EnvColorForced())
+
+	t.Setenv("CLICOLOR_FORCE", "1")
+	assert.True(t, EnvColorForced())
+}
+
+func TestAccessibleOutputIcons(t *testing.T) {
+	cs := &ColorScheme{Enabled: false, AccessibleOutput: true}
+	assert.Equal(t, "Success:", cs.SuccessIcon())
+	assert.Equal(t, "Warning:", cs.WarningIcon())
+	assert.Equal(t, "Failed:", cs.FailureIcon())
+	assert.Equal(t, "Pending:", cs.WordedIcon("\u25cf", "Pending:"))
+
+	cs = &ColorScheme{Enabled: false}
+	assert.Equal(t, "\u2713", cs.SuccessIcon())
+	assert.Equal(t, "!", cs.WarningIcon())
+	assert.Equal(t, "X", cs.FailureIcon())
+	assert.Equal(t, "\u25cf", cs.WordedIcon("\u25cf", "Pending:"))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/briandowns/spinner"
 	ghTerm "github.com/cli/go-gh/v2/pkg/term"
 	"github.com/cli/safeexec"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/i18n"
 	"github.com/google/shlex"
 	"github.com/mattn/go-colorable"
 	"github.com/mattn/go-isatty"
@@ -74,6 +75,7 @@ type IOStreams struct {
 	colorOverride           bool
 	colorEnabled            bool
 	colorLabels             bool
+	colorOverrides          map[string]string
 	accessibleColorsEnabled bool
 
 	pagerCommand string
@@ -82,6 +84,10 @@ type IOStreams struct {
 	neverPrompt               bool
 	accessiblePrompterEnabled bool
 
+	// accessibleOutputEnabled replaces spinners with plain status lines and
+	// icons with words, for screen readers.
+	accessibleOutputEnabled bool
+
 	TempFileOverride *os.File
 }
 
@@ -92,6 +98,19 @@ func (s *IOStreams) ColorEnabled() bool {
 	return s.term.IsColorEnabled()
 }
 
+// EnvColorDisabled reports whether the environment demands colorless output:
+// NO_COLOR set to a non-empty value, or CLICOLOR set to "0".
+func EnvColorDisabled() bool {
+	return os.Getenv("NO_COLOR") != "" || os.Getenv("CLICOLOR") == "0"
+}
+
+// EnvColorForced reports whether CLICOLOR_FORCE requests color output even
+// when stdout is not a terminal. A disabling variable always wins over it.
+func EnvColorForced() bool {
+	force, ok := os.LookupEnv("CLICOLOR_FORCE")
+	return ok && force != "0"
+}
+
 func (s *IOStreams) ColorSupport256() bool {
 	if s.colorOverride {
 		return s.colorEnabled
@@ -292,7 +311,10 @@ func (s *IOStreams) StartProgressIndicatorWithLabel(label string) {
 		return
 	}
 
-	if s.spinnerDisabled {
+	// Translating here covers every caller's label centrally
+	label = i18n.T(label)
+
+	if s.spinnerDisabled || s.accessibleOutputEnabled {
 		// If the spinner is disabled, simply print a
 		// textual progress indicator and return.
 		// This means that s.ProgressIndicator will be nil.
@@ -332,7 +354,7 @@ func (s *IOStreams) startTextualProgressIndicator(label string) {
 
 	// Default label when spinner disabled is "Working..."
 	if label == "" {
-		label = "Working..."
+		label = i18n.T("Working...")
 	}
 
 	// Add an ellipsis to the label if it doesn't already have one.
@@ -419,15 +441,25 @@ func (s *IOStreams) TerminalWidth() int {
 
 func (s *IOStreams) ColorScheme() *ColorScheme {
 	return &ColorScheme{
-		Enabled:       s.ColorEnabled(),
-		EightBitColor: s.ColorSupport256(),
-		TrueColor:     s.HasTrueColor(),
-		Accessible:    s.AccessibleColorsEnabled(),
-		ColorLabels:   s.ColorLabels(),
-		Theme:         s.TerminalTheme(),
+		// Accessible output relies on words rather than color for state, so
+		// color is switched off with it.
+		Enabled:          s.ColorEnabled() && !s.accessibleOutputEnabled,
+		EightBitColor:    s.ColorSupport256(),
+		TrueColor:        s.HasTrueColor(),
+		Accessible:       s.AccessibleColorsEnabled(),
+		AccessibleOutput: s.accessibleOutputEnabled,
+		ColorLabels:      s.ColorLabels(),
+		Theme:            s.TerminalTheme(),
+		Overrides:        s.colorOverrides,
 	}
 }
 
+// SetColorOverrides installs user-configured color role mappings that the
+// color scheme consults before its built-in palette.
+func (s *IOStreams) SetColorOverrides(overrides map[string]string) {
+	s.colorOverrides = overrides
+}
+
 func (s *IOStreams) ReadUserFile(fn string) ([]byte, error) {
 	var r io.ReadCloser
 	if fn == "-" {
@@ -458,6 +490,14 @@ func (s *IOStreams) AccessibleColorsEnabled() bool {
 	return s.accessibleColorsEnabled
 }
 
+func (s *IOStreams) SetAccessibleOutputEnabled(enabled bool) {
+	s.accessibleOutputEnabled = enabled
+}
+
+func (s *IOStreams) AccessibleOutputEnabled() bool {
+	return s.accessibleOutputEnabled
+}
+
 func (s *IOStreams) SetAccessiblePrompterEnabled(enabled bool) {
 	s.accessiblePrompterEnabled = enabled
 }
@@ -499,6 +539,15 @@ func System() *IOStreams {
 		term:         &terminal,
 	}
 
+	// The terminal detection above already takes color environment variables
+	// into account, but apply them explicitly so NO_COLOR, CLICOLOR, and
+	// CLICOLOR_FORCE are always honored regardless of terminal heuristics.
+	if EnvColorDisabled() {
+		io.SetColorEnabled(false)
+	} else if EnvColorForced() {
+		io.SetColorEnabled(true)
+	}
+
 	stdoutIsTTY := io.IsStdoutTTY()
 	stderrIsTTY := io.IsStderrTTY()
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/charmbracelet/glamour"
 	ghMarkdown "github.com/cli/go-gh/v2/pkg/markdown"
+	"github.com/dlbroadfoot/bitbucket-cli/internal/profile"
 )
 
 func WithoutIndentation() glamour.TermRendererOption {
@@ -36,5 +37,6 @@ func WithBaseURL(u string) glamour.TermRendererOption {
 }
 
 func Render(text string, opts ...glamour.TermRendererOption) (string, error) {
+	defer profile.Track("render markdown")()
 	return ghMarkdown.Render(text, opts...)
 }